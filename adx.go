@@ -0,0 +1,93 @@
+package techindicators
+
+import (
+	"math"
+	"time"
+)
+
+// ADXResult represents Average Directional Index calculation result
+type ADXResult struct {
+	Timestamp time.Time `json:"timestamp"`
+	PlusDI    float64   `json:"plus_di"`  // +DI, 0-100
+	MinusDI   float64   `json:"minus_di"` // -DI, 0-100
+	ADX       float64   `json:"adx"`      // Trend strength regardless of direction, 0-100
+}
+
+// CalculateADX calculates Wilder's Average Directional Index with the directional indicators.
+// The first value needs 2*period candles: period to smooth the true range and directional movement,
+// and period more to average the directional index.
+func CalculateADX(dataset []OHLCV, period int) ([]ADXResult, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	if len(dataset) < 2*period {
+		return nil, ErrInsufficientData{Need: 2 * period, Have: len(dataset)}
+	}
+
+	results := make([]ADXResult, 0, len(dataset)-2*period+1)
+	var trSum, plusSum, minusSum, dxSum, adx float64
+	n := float64(period)
+
+	for i := 1; i < len(dataset); i++ {
+		current, prev := dataset[i], dataset[i-1]
+
+		trueRange := math.Max(current.High-current.Low, math.Max(math.Abs(current.High-prev.Close), math.Abs(current.Low-prev.Close)))
+		up, down := current.High-prev.High, prev.Low-current.Low
+		plusDM, minusDM := 0.0, 0.0
+		if up > down && up > 0 {
+			plusDM = up
+		}
+		if down > up && down > 0 {
+			minusDM = down
+		}
+
+		// Wilder smoothing: a plain sum for the first period, then sum - sum/period + value
+		if i <= period {
+			trSum += trueRange
+			plusSum += plusDM
+			minusSum += minusDM
+		} else {
+			trSum += trueRange - trSum/n
+			plusSum += plusDM - plusSum/n
+			minusSum += minusDM - minusSum/n
+		}
+		if i < period {
+			continue
+		}
+
+		plusDI, minusDI := 0.0, 0.0
+		if trSum > 0 {
+			plusDI = 100 * plusSum / trSum
+			minusDI = 100 * minusSum / trSum
+		}
+		dx := 0.0
+		if sum := plusDI + minusDI; sum > 0 {
+			dx = 100 * math.Abs(plusDI-minusDI) / sum
+		}
+
+		// The first ADX is the mean of period DX values, then Wilder's moving average
+		switch {
+		case i < 2*period-1:
+			dxSum += dx
+			continue
+		case i == 2*period-1:
+			adx = (dxSum + dx) / n
+		default:
+			adx = (adx*(n-1) + dx) / n
+		}
+
+		results = append(results, ADXResult{
+			Timestamp: current.Timestamp,
+			PlusDI:    plusDI,
+			MinusDI:   minusDI,
+			ADX:       adx,
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,688 @@
+package techindicators
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Operand is a value an alert condition compares, e.g. the close price, RSI(14), or a constant
+type Operand interface {
+	String() string
+	// Latest returns the value on the latest candle of the dataset and on the one before it; previous is NaN
+	// when the operand has a single value, so a crossing cannot be judged yet
+	Latest(dataset []OHLCV) (current, previous float64, err error)
+}
+
+// Const returns an operand with a fixed value, e.g. the 25 of "RSI(14) < 25"
+func Const(value float64) Operand { return constOperand(value) }
+
+// Price returns the operand of a candle price
+func Price(priceType PriceType) Operand { return priceOperand(priceType) }
+
+// Volume returns the operand of the candle volume
+func Volume() Operand { return volumeOperand{} }
+
+// VolumeRatio returns the operand of the candle volume divided by its period moving average
+func VolumeRatio(period int) Operand { return volumeOperand{ratioPeriod: period} }
+
+// IndicatorValue returns the operand of an indicator's primary value
+func IndicatorValue(indicator Indicator) Operand { return indicatorOperand{indicator: indicator} }
+
+// IndicatorComponent returns the operand of one of an indicator's components, e.g. the upper Bollinger band
+func IndicatorComponent(indicator Indicator, component string) Operand {
+	return indicatorOperand{indicator: indicator, component: component}
+}
+
+type constOperand float64
+
+func (o constOperand) String() string { return strconv.FormatFloat(float64(o), 'g', -1, 64) }
+
+func (o constOperand) Latest([]OHLCV) (float64, float64, error) {
+	return float64(o), float64(o), nil
+}
+
+type priceOperand PriceType
+
+// priceNames names the price types in alert expressions
+var priceNames = map[string]PriceType{
+	"price":    ClosePrice,
+	"close":    ClosePrice,
+	"open":     OpenPrice,
+	"high":     HighPrice,
+	"low":      LowPrice,
+	"typical":  TypicalPrice,
+	"weighted": WeightedPrice,
+	"median":   MedianPrice,
+	"ohlc4":    OHLC4Price,
+}
+
+func (o priceOperand) String() string {
+	for _, name := range []string{"price", "open", "high", "low", "typical", "weighted", "median", "ohlc4"} {
+		if priceNames[name] == PriceType(o) {
+			return name
+		}
+	}
+	return fmt.Sprintf("price(%d)", int(o))
+}
+
+func (o priceOperand) Latest(dataset []OHLCV) (float64, float64, error) {
+	return latestValues(dataset, func(c OHLCV) float64 { return c.ExtractPrice(PriceType(o)) })
+}
+
+type volumeOperand struct {
+	ratioPeriod int // Zero for the raw volume
+}
+
+func (o volumeOperand) String() string {
+	if o.ratioPeriod == 0 {
+		return "volume"
+	}
+	return fmt.Sprintf("volume_ratio(%d)", o.ratioPeriod)
+}
+
+func (o volumeOperand) Latest(dataset []OHLCV) (float64, float64, error) {
+	if o.ratioPeriod == 0 {
+		return latestValues(dataset, func(c OHLCV) float64 { return c.Volume })
+	}
+	results, err := CalculateVolumeAnalysis(dataset, o.ratioPeriod, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	return latestValues(results, func(r VolumeResult) float64 {
+		if r.VMA == 0 {
+			return 0
+		}
+		return r.Volume / r.VMA
+	})
+}
+
+type indicatorOperand struct {
+	indicator Indicator
+	component string // Empty for the primary value
+}
+
+func (o indicatorOperand) String() string {
+	if o.component == "" {
+		return o.indicator.Name()
+	}
+	return o.indicator.Name() + "." + o.component
+}
+
+func (o indicatorOperand) Latest(dataset []OHLCV) (float64, float64, error) {
+	points, err := o.indicator.Compute(dataset)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: %w", o.indicator.Name(), err)
+	}
+	if o.component == "" {
+		return latestValues(points, func(p Point) float64 { return p.Value })
+	}
+	if len(points) > 0 {
+		if _, ok := points[len(points)-1].Components[o.component]; !ok {
+			return 0, 0, invalidParameter("%s has no component %q", o.indicator.Name(), o.component)
+		}
+	}
+	return latestValues(points, func(p Point) float64 {
+		if v, ok := p.Components[o.component]; ok {
+			return v
+		}
+		return math.NaN()
+	})
+}
+
+// latestValues returns the value of the last item and of the one before it, NaN when there is none
+func latestValues[T any](items []T, value func(T) float64) (current, previous float64, err error) {
+	if len(items) == 0 {
+		return 0, 0, ErrInsufficientData{Need: 1, Have: 0}
+	}
+	previous = math.NaN()
+	if len(items) > 1 {
+		previous = value(items[len(items)-2])
+	}
+	return value(items[len(items)-1]), previous, nil
+}
+
+// Condition is a test of the latest candle of a dataset
+type Condition interface {
+	String() string
+	Holds(dataset []OHLCV) (bool, error)
+}
+
+// Above holds while a is above b
+func Above(a, b Operand) Condition { return comparison{a: a, b: b, op: ">"} }
+
+// Below holds while a is below b
+func Below(a, b Operand) Condition { return comparison{a: a, b: b, op: "<"} }
+
+// AtLeast holds while a is at or above b
+func AtLeast(a, b Operand) Condition { return comparison{a: a, b: b, op: ">="} }
+
+// AtMost holds while a is at or below b
+func AtMost(a, b Operand) Condition { return comparison{a: a, b: b, op: "<="} }
+
+// CrossesAbove holds on the candle a crosses above b, judged like CrossAbove
+func CrossesAbove(a, b Operand) Condition { return comparison{a: a, b: b, op: "crosses above"} }
+
+// CrossesBelow holds on the candle a crosses below b, judged like CrossBelow
+func CrossesBelow(a, b Operand) Condition { return comparison{a: a, b: b, op: "crosses below"} }
+
+// And holds when every condition holds; conditions after the first failing one are not evaluated. A
+// condition still warming up does not decide the result: And fails with its ErrInsufficientData only when
+// no other condition fails.
+func And(conditions ...Condition) Condition { return logical{conditions: conditions, and: true} }
+
+// Or holds when any condition holds; conditions after the first holding one are not evaluated. A condition
+// still warming up does not decide the result: Or fails with its ErrInsufficientData only when no other
+// condition holds.
+func Or(conditions ...Condition) Condition { return logical{conditions: conditions} }
+
+// Not holds when the condition does not
+func Not(condition Condition) Condition { return negation{condition} }
+
+type comparison struct {
+	a, b Operand
+	op   string
+}
+
+func (c comparison) String() string { return c.a.String() + " " + c.op + " " + c.b.String() }
+
+func (c comparison) Holds(dataset []OHLCV) (bool, error) {
+	a, aPrevious, err := c.a.Latest(dataset)
+	if err != nil {
+		return false, err
+	}
+	b, bPrevious, err := c.b.Latest(dataset)
+	if err != nil {
+		return false, err
+	}
+
+	switch c.op {
+	case ">":
+		return a > b, nil
+	case "<":
+		return a < b, nil
+	case ">=":
+		return a >= b, nil
+	case "<=":
+		return a <= b, nil
+	case "crosses above":
+		return crossedAbove(aPrevious, a, bPrevious, b), nil
+	case "crosses below":
+		return crossedBelow(aPrevious, a, bPrevious, b), nil
+	}
+	return false, invalidParameter("unknown comparison %q", c.op)
+}
+
+type logical struct {
+	conditions []Condition
+	and        bool
+}
+
+func (l logical) String() string {
+	parts := make([]string, len(l.conditions))
+	for i, condition := range l.conditions {
+		parts[i] = condition.String()
+		if inner, ok := condition.(logical); ok && inner.and != l.and {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	if l.and {
+		return strings.Join(parts, " AND ")
+	}
+	return strings.Join(parts, " OR ")
+}
+
+func (l logical) Holds(dataset []OHLCV) (bool, error) {
+	if len(l.conditions) == 0 {
+		return false, invalidParameter("AND and OR need at least one condition")
+	}
+	var warmUp error // First ErrInsufficientData of a branch that could not decide the result
+	for _, condition := range l.conditions {
+		holds, err := condition.Holds(dataset)
+		switch {
+		case errors.Is(err, ErrInsufficientData{}):
+			if warmUp == nil {
+				warmUp = err
+			}
+		case err != nil:
+			return false, err
+		case holds != l.and:
+			return holds, nil
+		}
+	}
+	if warmUp != nil {
+		return false, warmUp
+	}
+	return l.and, nil
+}
+
+type negation struct {
+	condition Condition
+}
+
+func (n negation) String() string {
+	if _, ok := n.condition.(logical); ok {
+		return "NOT (" + n.condition.String() + ")"
+	}
+	return "NOT " + n.condition.String()
+}
+
+func (n negation) Holds(dataset []OHLCV) (bool, error) {
+	holds, err := n.condition.Holds(dataset)
+	if err != nil {
+		return false, err
+	}
+	return !holds, nil
+}
+
+// ParseCondition parses an alert expression such as "RSI(14) < 25 AND volume_ratio > 2 AND price crosses
+// above SMA(20)". Comparisons use <, <=, >, >=, "crosses above", and "crosses below" and combine with AND,
+// OR, NOT, and parentheses; AND binds tighter than OR, and keywords are case-insensitive. Operands are:
+//   - numbers
+//   - price (the close), open, high, low, typical, weighted, median, and ohlc4
+//   - volume, and volume_ratio or volume_ratio(n): the volume over its n-candle average [20]
+//   - registered indicators by name, e.g. rsi, sma(50), bollinger(period=20, multiplier=2.5), with an
+//     optional component: bollinger(20).upper. A lone positional argument sets the period (or window).
+func ParseCondition(expression string) (Condition, error) {
+	tokens, err := tokenizeCondition(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &conditionParser{tokens: tokens}
+	condition, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, invalidParameter("unexpected %q in alert expression", p.tokens[p.pos])
+	}
+	return condition, nil
+}
+
+// tokenizeCondition splits an alert expression into identifiers, numbers, and symbols
+func tokenizeCondition(expression string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || strings.ContainsRune("_.-", runes[i])) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		case r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, string(runes[i:i+2]))
+				i += 2
+			} else {
+				tokens = append(tokens, string(r))
+				i++
+			}
+		case strings.ContainsRune("(),=", r):
+			tokens = append(tokens, string(r))
+			i++
+		default:
+			return nil, invalidParameter("unexpected %q in alert expression", r)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, invalidParameter("alert expression is empty")
+	}
+	return tokens, nil
+}
+
+// conditionParser is a recursive descent parser over the tokens of an alert expression
+type conditionParser struct {
+	tokens []string
+	pos    int
+}
+
+// peek returns the next token, or "" at the end
+func (p *conditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// keyword consumes the next token when it is the given keyword
+func (p *conditionParser) keyword(word string) bool {
+	if strings.EqualFold(p.peek(), word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the next token, failing unless it is want
+func (p *conditionParser) expect(want string) error {
+	if p.peek() != want {
+		return invalidParameter("expected %q in alert expression, got %q", want, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+func (p *conditionParser) or() (Condition, error) {
+	return p.chain("OR", p.and, Or)
+}
+
+func (p *conditionParser) and() (Condition, error) {
+	return p.chain("AND", p.unary, And)
+}
+
+// chain parses operands joined by a keyword, folding two or more of them with combine
+func (p *conditionParser) chain(word string, operand func() (Condition, error), combine func(...Condition) Condition) (Condition, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	conditions := []Condition{first}
+	for p.keyword(word) {
+		next, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, next)
+	}
+	if len(conditions) == 1 {
+		return first, nil
+	}
+	return combine(conditions...), nil
+}
+
+func (p *conditionParser) unary() (Condition, error) {
+	if p.keyword("NOT") {
+		condition, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return Not(condition), nil
+	}
+	if p.peek() == "(" {
+		p.pos++
+		condition, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		return condition, p.expect(")")
+	}
+	return p.comparison()
+}
+
+func (p *conditionParser) comparison() (Condition, error) {
+	a, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	var build func(a, b Operand) Condition
+	switch op := p.peek(); {
+	case op == ">":
+		build = Above
+	case op == "<":
+		build = Below
+	case op == ">=":
+		build = AtLeast
+	case op == "<=":
+		build = AtMost
+	case strings.EqualFold(op, "crosses"):
+		p.pos++
+		switch {
+		case strings.EqualFold(p.peek(), "above"):
+			build = CrossesAbove
+		case strings.EqualFold(p.peek(), "below"):
+			build = CrossesBelow
+		default:
+			return nil, invalidParameter("expected above or below after crosses, got %q", p.peek())
+		}
+	default:
+		return nil, invalidParameter("expected a comparison after %s, got %q", a, op)
+	}
+	p.pos++
+
+	b, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	return build(a, b), nil
+}
+
+func (p *conditionParser) operand() (Operand, error) {
+	token := p.peek()
+	if token == "" || strings.ContainsAny(token[:1], "(),=<>") {
+		return nil, invalidParameter("expected an operand in alert expression, got %q", token)
+	}
+	p.pos++
+
+	if value, err := strconv.ParseFloat(token, 64); err == nil {
+		return Const(value), nil
+	}
+
+	name, component, _ := strings.Cut(token, ".")
+	component = strings.ToLower(component)
+	var params IndicatorParams
+	if p.peek() == "(" {
+		var err error
+		if params, err = p.arguments(); err != nil {
+			return nil, err
+		}
+		if p.peek() != "" && p.peek()[0] == '.' {
+			component = strings.ToLower(p.peek()[1:])
+			p.pos++
+		}
+	}
+
+	lower := strings.ToLower(name)
+	if priceType, ok := priceNames[lower]; ok && params == nil && component == "" {
+		return Price(priceType), nil
+	}
+	switch lower {
+	case "volume":
+		if params == nil && component == "" {
+			return Volume(), nil
+		}
+	case "volume_ratio":
+		if component == "" {
+			return VolumeRatio(params.Int("", params.Int("period", 20))), nil
+		}
+	}
+
+	registration, ok := LookupIndicator(name)
+	if !ok {
+		name = lower
+		if registration, ok = LookupIndicator(name); !ok {
+			return nil, invalidParameter("unknown operand %q in alert expression", token)
+		}
+	}
+	if positional, ok := params[""]; ok {
+		delete(params, "")
+		key := "period"
+		if _, hasPeriod := registration.Params["period"]; !hasPeriod {
+			if _, hasWindow := registration.Params["window"]; hasWindow {
+				key = "window"
+			}
+		}
+		params[key] = positional
+	}
+	indicator, err := NewIndicator(name, params)
+	if err != nil {
+		return nil, err
+	}
+	if component != "" {
+		return IndicatorComponent(indicator, component), nil
+	}
+	return IndicatorValue(indicator), nil
+}
+
+// arguments parses "(20)" or "(period=20, multiplier=2.5)"; a positional argument is stored under ""
+func (p *conditionParser) arguments() (IndicatorParams, error) {
+	p.pos++ // (
+	params := IndicatorParams{}
+	for p.peek() != ")" {
+		if len(params) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		key, token := "", p.peek()
+		if p.pos+1 < len(p.tokens) && p.tokens[p.pos+1] == "=" {
+			key = strings.ToLower(token)
+			p.pos += 2
+			token = p.peek()
+		}
+		value, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			return nil, invalidParameter("expected a number in alert expression, got %q", token)
+		}
+		if _, ok := params[key]; ok {
+			return nil, invalidParameter("argument %q is set twice in alert expression", key)
+		}
+		params[key] = value
+		p.pos++
+	}
+	p.pos++ // )
+	return params, nil
+}
+
+// AlertRule is a named condition watched by an AlertEngine
+type AlertRule struct {
+	Name      string
+	Condition Condition
+	Signal    Signal // Optional signal carried by the events, e.g. SignalBuy
+	Repeat    bool   // Fire on every candle the condition holds instead of only when it starts holding
+}
+
+// ParseAlertRule builds a rule from an expression parsed with ParseCondition
+func ParseAlertRule(name, expression string) (AlertRule, error) {
+	condition, err := ParseCondition(expression)
+	if err != nil {
+		return AlertRule{}, fmt.Errorf("alert rule %q: %w", name, err)
+	}
+	return AlertRule{Name: name, Condition: condition}, nil
+}
+
+// AlertEvent is one firing of an alert rule
+type AlertEvent struct {
+	Rule      string    `json:"rule"`
+	Condition string    `json:"condition"` // The rule's condition, e.g. "RSI(14) < 25"
+	Signal    Signal    `json:"signal,omitempty"`
+	Timestamp time.Time `json:"timestamp"` // Candle the rule fired on
+	Price     float64   `json:"price"`     // Close of that candle
+}
+
+// AlertEngine evaluates alert rules on each new candle. A rule fires when its condition starts holding,
+// so a level held for many candles alerts once, unless the rule repeats. Conditions that lack the candles
+// for their indicators do not hold. It is not safe for concurrent use.
+type AlertEngine struct {
+	rules   []AlertRule
+	holding []bool       // Whether each rule's condition held on the last evaluated candle
+	history *OHLCVBuffer // Candles kept for Update
+	last    time.Time    // Timestamp of the last evaluated candle
+}
+
+// NewAlertEngine creates an engine for the rules. history is the number of candles Update keeps; size it
+// for the largest indicator period plus one so crossings can be judged.
+func NewAlertEngine(history int, rules ...AlertRule) (*AlertEngine, error) {
+	buffer, err := NewOHLCVBuffer(history)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		switch {
+		case rule.Name == "":
+			return nil, invalidParameter("alert rule name is required")
+		case rule.Condition == nil:
+			return nil, invalidParameter("alert rule %q has no condition", rule.Name)
+		case names[rule.Name]:
+			return nil, invalidParameter("duplicate alert rule %q", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+	return &AlertEngine{rules: rules, holding: make([]bool, len(rules)), history: buffer}, nil
+}
+
+// Update appends a closed candle to the engine's history and evaluates the rules on it. A candle with
+// the timestamp of the last one replaces it without evaluating the rules again, and an older candle is
+// ignored, so repeated or out-of-order deliveries cannot shift the history.
+func (e *AlertEngine) Update(candle OHLCV) ([]AlertEvent, error) {
+	if !e.last.IsZero() {
+		switch {
+		case candle.Timestamp.Equal(e.last):
+			e.history.ReplaceLast(candle)
+			return nil, nil
+		case candle.Timestamp.Before(e.last):
+			return nil, nil
+		}
+	}
+	e.history.Append(candle)
+	return e.Evaluate(e.history.Candles())
+}
+
+// Evaluate evaluates the rules on the latest candle of the dataset, for callers that keep their own
+// history. A candle already evaluated, by timestamp, returns no events.
+func (e *AlertEngine) Evaluate(dataset []OHLCV) ([]AlertEvent, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	latest := dataset[len(dataset)-1]
+	if !e.last.IsZero() && !latest.Timestamp.After(e.last) {
+		return nil, nil
+	}
+
+	var events []AlertEvent
+	for i, rule := range e.rules {
+		holds, err := rule.Condition.Holds(dataset)
+		if err != nil {
+			if !errors.Is(err, ErrInsufficientData{}) {
+				return nil, fmt.Errorf("alert rule %q: %w", rule.Name, err)
+			}
+			holds = false
+		}
+		if holds && (rule.Repeat || !e.holding[i]) {
+			events = append(events, AlertEvent{
+				Rule:      rule.Name,
+				Condition: rule.Condition.String(),
+				Signal:    rule.Signal,
+				Timestamp: latest.Timestamp,
+				Price:     latest.Close,
+			})
+		}
+		e.holding[i] = holds
+	}
+	e.last = latest.Timestamp
+	return events, nil
+}
+
+// Reset clears the history and the state of the rules
+func (e *AlertEngine) Reset() {
+	e.history.Reset()
+	clear(e.holding)
+	e.last = time.Time{}
+}
+
+// FeedAlerts returns a LiveStream handler that updates the alert engine with each candle and passes the
+// events to emit
+func FeedAlerts(engine *AlertEngine, emit func(AlertEvent) error) func(OHLCV) error {
+	return func(candle OHLCV) error {
+		events, err := engine.Update(candle)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := emit(event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
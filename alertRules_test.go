@@ -0,0 +1,126 @@
+package techindicators
+
+import (
+	"errors"
+	"testing"
+)
+
+// warmUpAlertDataset returns candles too few for SMA(200), with a volume spike on the last one
+func warmUpAlertDataset(t *testing.T) []OHLCV {
+	t.Helper()
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range dataset {
+		dataset[i].Volume = 100
+	}
+	dataset[len(dataset)-1].Volume = 1000
+	return dataset
+}
+
+func TestAlertOrDuringWarmUp(t *testing.T) {
+	dataset := warmUpAlertDataset(t)
+
+	condition, err := ParseCondition("SMA(200) > close OR volume_ratio > 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	holds, err := condition.Holds(dataset)
+	if err != nil || !holds {
+		t.Fatalf("Holds = %v, %v; want true, nil", holds, err)
+	}
+
+	condition, err = ParseCondition("SMA(200) > close OR volume_ratio > 30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := condition.Holds(dataset); !errors.Is(err, ErrInsufficientData{}) {
+		t.Fatalf("undecided OR error = %v; want ErrInsufficientData", err)
+	}
+
+	condition, err = ParseCondition("SMA(200) > close AND volume_ratio > 30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if holds, err := condition.Holds(dataset); err != nil || holds {
+		t.Fatalf("AND with a failing branch = %v, %v; want false, nil", holds, err)
+	}
+
+	rule, err := ParseAlertRule("spike", "SMA(200) > close OR volume_ratio > 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewAlertEngine(len(dataset), rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := engine.Evaluate(dataset)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("Evaluate = %v, %v; want one event", events, err)
+	}
+}
+
+func TestAlertNotDuringWarmUp(t *testing.T) {
+	dataset := warmUpAlertDataset(t)
+
+	rule, err := ParseAlertRule("not overbought", "NOT RSI(14) > 70")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewAlertEngine(len(dataset), rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, candle := range dataset {
+		events, err := engine.Update(candle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i < 14 && len(events) > 0 {
+			t.Fatalf("rule fired on warm-up candle %d: %v", i, events)
+		}
+	}
+
+	if _, err := Not(Below(IndicatorValue(SMAIndicator{Period: 200}), Const(1))).Holds(dataset); !errors.Is(err, ErrInsufficientData{}) {
+		t.Fatalf("NOT error = %v; want ErrInsufficientData", err)
+	}
+}
+
+func TestAlertUpdateIgnoresStaleCandles(t *testing.T) {
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule, err := ParseAlertRule("cross", "price crosses above SMA(5)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewAlertEngine(len(dataset), rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, candle := range dataset {
+		if _, err := engine.Update(candle); err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 {
+			if _, err := engine.Update(dataset[i-1]); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := engine.Update(candle); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	history := engine.history.Candles()
+	if len(history) != len(dataset) {
+		t.Fatalf("history has %d candles; want %d", len(history), len(dataset))
+	}
+	for i := range history {
+		if !history[i].Timestamp.Equal(dataset[i].Timestamp) {
+			t.Fatalf("history[%d] = %v; want %v", i, history[i].Timestamp, dataset[i].Timestamp)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package techindicators
+
+import (
+	"fmt"
+	"sync"
+)
+
+// analysisCache memoizes indicator series computed over one dataset so that strategy sub-checks
+// needing the same series share a single pass over the data. It is safe for concurrent use.
+type analysisCache struct {
+	dataset    []OHLCV
+	arithmetic Arithmetic         // Backend for the SMA and Bollinger series
+	missing    MissingValuePolicy // Handling of degenerate candles in the volume series
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry holds one memoized series; once guarantees it is computed a single time
+type cacheEntry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+// newAnalysisCache creates an empty float64 cache for the dataset
+func newAnalysisCache(dataset []OHLCV) *analysisCache {
+	return &analysisCache{
+		dataset:    dataset,
+		arithmetic: Float64Arithmetic,
+		missing:    SkipMissing,
+		entries:    make(map[string]*cacheEntry),
+	}
+}
+
+// newConfiguredCache creates a cache using the configuration's arithmetic and missing value policy.
+// The policy is applied to the dataset first, so every cached series sees the same candles.
+func newConfiguredCache(dataset []OHLCV, config AnalysisConfig) (*analysisCache, error) {
+	cleaned, err := ApplyMissingValuePolicy(dataset, config.MissingValues)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := newAnalysisCache(cleaned)
+	if config.Arithmetic != "" {
+		cache.arithmetic = config.Arithmetic
+	}
+	if config.MissingValues != "" {
+		cache.missing = config.MissingValues
+	}
+	return cache, nil
+}
+
+// memo returns the cached result for key, computing it on first use.
+// Concurrent callers asking for the same key wait for the first computation instead of repeating it.
+func (c *analysisCache) memo(key string, compute func() (any, error)) (any, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = compute()
+	})
+	return entry.value, entry.err
+}
+
+// sma returns the memoized CalculateSMA series
+func (c *analysisCache) sma(period int, priceType PriceType) ([]SMAResult, error) {
+	value, err := c.memo(fmt.Sprintf("sma:%d:%d", period, priceType), func() (any, error) {
+		if c.arithmetic == BigFloatArithmetic {
+			return CalculateSMAPrecise(c.dataset, period, priceType)
+		}
+		return CalculateSMA(c.dataset, period, priceType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]SMAResult), nil
+}
+
+// ema returns the memoized CalculateEMA series
+func (c *analysisCache) ema(period int, priceType PriceType) ([]SMAResult, error) {
+	value, err := c.memo(fmt.Sprintf("ema:%d:%d", period, priceType), func() (any, error) {
+		return CalculateEMA(c.dataset, period, priceType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]SMAResult), nil
+}
+
+// rsi returns the memoized CalculateRSI series
+func (c *analysisCache) rsi(period int, priceType PriceType) ([]RSIResult, error) {
+	value, err := c.memo(fmt.Sprintf("rsi:%d:%d", period, priceType), func() (any, error) {
+		return CalculateRSI(c.dataset, period, priceType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]RSIResult), nil
+}
+
+// bollinger returns the memoized CalculateBollingerBands series
+func (c *analysisCache) bollinger(period int, multiplier float64, priceType PriceType) ([]BollingerBands, error) {
+	value, err := c.memo(fmt.Sprintf("bb:%d:%g:%d", period, multiplier, priceType), func() (any, error) {
+		if c.arithmetic == BigFloatArithmetic {
+			return CalculateBollingerBandsPrecise(c.dataset, period, multiplier, priceType)
+		}
+		return CalculateBollingerBands(c.dataset, period, multiplier, priceType)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]BollingerBands), nil
+}
+
+// volume returns the memoized CalculateVolumeAnalysis series
+func (c *analysisCache) volume(vmaPeriod, vrocPeriod int) ([]VolumeResult, error) {
+	value, err := c.memo(fmt.Sprintf("volume:%d:%d", vmaPeriod, vrocPeriod), func() (any, error) {
+		return CalculateVolumeAnalysisWithPolicy(c.dataset, vmaPeriod, vrocPeriod, c.missing)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]VolumeResult), nil
+}
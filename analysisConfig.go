@@ -0,0 +1,435 @@
+package techindicators
+
+import "time"
+
+// AnalysisConfig configures ComprehensiveAnalysisWithConfig and UltimateAnalysisWithConfig.
+// Zero-valued fields fall back to the defaults from DefaultAnalysisConfig.
+type AnalysisConfig struct {
+	SMAPeriod    int       `json:"sma_period"`
+	BBPeriod     int       `json:"bb_period"`
+	BBMultiplier float64   `json:"bb_multiplier"`
+	RSIPeriod    int       `json:"rsi_period"`
+	VMAPeriod    int       `json:"vma_period"`
+	VROCPeriod   int       `json:"vroc_period"`
+	PriceType    PriceType `json:"price_type"`
+
+	// Numeric backend for SMA and Bollinger Bands (default float64)
+	Arithmetic Arithmetic `json:"arithmetic"`
+
+	// Handling of candles with missing prices or degenerate volume terms (default skip)
+	MissingValues MissingValuePolicy `json:"missing_values"`
+
+	// Number of agreeing votes (out of all voters) needed for BUY/SELL and STRONG BUY/STRONG SELL.
+	// With custom weights the same proportion is applied to the weighted vote share.
+	// Defaults to a simple majority and to unanimity respectively.
+	BuyVotes    int `json:"buy_votes"`
+	StrongVotes int `json:"strong_votes"`
+
+	// Vote weights of the built-in indicators (default 1 each)
+	SMAWeight       float64 `json:"sma_weight"`
+	BollingerWeight float64 `json:"bollinger_weight"`
+	RSIWeight       float64 `json:"rsi_weight"`
+
+	// Adapt the votes to the market regime from ClassifyRegime: trend-following in trends, mean reversion
+	// in ranges. Off by default; Regime configures the classifier.
+	RegimeSwitching bool         `json:"regime_switching"`
+	Regime          RegimeConfig `json:"regime"`
+
+	// Perpetual swap funding and open interest, adding a contrarian vote from AnalyzeDerivatives when set.
+	// Samples after the latest candle are ignored; DerivativesConfig sets the thresholds and vote weight.
+	Derivatives       *DerivativesData  `json:"derivatives,omitempty"`
+	DerivativesConfig DerivativesConfig `json:"derivatives_config"`
+
+	// Additional indicator votes combined with the built-in ones
+	ExtraVotes []IndicatorVote `json:"-"`
+
+	// Ultimate analysis only: shrink the periods of datasets shorter than UltimateMinCandles, down to a
+	// few candles, and downgrade the confidence instead of failing with insufficient data
+	YoungToken bool `json:"young_token"`
+
+	// Ultimate analysis only: higher timeframes (e.g. 1h, 4h) whose combined bias must agree with the signal
+	ConfirmTimeframes []time.Duration `json:"confirm_timeframes,omitempty"`
+
+	// Ultimate analysis only: candles of a higher timeframe fetched separately, in time order, confirming
+	// the signal alongside ConfirmTimeframes; candles that have not closed by the latest candle's close
+	// are ignored, so the full history can be passed to Backtest and WalkForward
+	HigherTimeframe []OHLCV `json:"higher_timeframe,omitempty"`
+
+	// Ultimate analysis only: thresholds of the rug pull risk model (default DefaultRugPullRiskConfig)
+	RugPull RugPullRiskConfig `json:"rug_pull"`
+
+	// Ultimate analysis only: thresholds of the honeypot and illiquidity checks (default DefaultTradabilityConfig)
+	Tradability TradabilityConfig `json:"tradability"`
+
+	// Ultimate analysis only: thresholds of the liquidation cascade check (default DefaultLiquidationConfig)
+	Liquidation LiquidationConfig `json:"liquidation"`
+
+	// Ultimate analysis only: thresholds of the thin-book manipulation check (default DefaultManipulationConfig)
+	Manipulation ManipulationConfig `json:"manipulation"`
+
+	// Ultimate analysis only: pool TVL or order book depth series whose sudden fall within
+	// RugPull.LiquidityRemovalWindow of the latest candle raises the rug pull risk
+	Liquidity []LiquiditySample `json:"liquidity,omitempty"`
+
+	// Ultimate analysis only: holder data that raises the risk level and rug pull score when set
+	Fundamentals *TokenFundamentals `json:"fundamentals,omitempty"`
+
+	// Indicators to leave out of the analysis
+	SkipSMA       bool `json:"skip_sma"`
+	SkipBollinger bool `json:"skip_bollinger"`
+	SkipRSI       bool `json:"skip_rsi"`
+	SkipVolume    bool `json:"skip_volume"` // Ultimate analysis only: no volume confirmation or rug pull check
+}
+
+// IndicatorVote is a user-registered vote in the comprehensive analysis
+type IndicatorVote struct {
+	Name   string
+	Weight float64
+	Vote   func(dataset []OHLCV) (Signal, error) // Bullish/bearish signals count toward the final decision
+}
+
+// DefaultAnalysisConfig returns the standard configuration (SMA-20, BB-20/2.0, RSI-14, VMA-20, VROC-5)
+func DefaultAnalysisConfig() AnalysisConfig {
+	return AnalysisConfig{
+		SMAPeriod:    20,
+		BBPeriod:     20,
+		BBMultiplier: 2.0,
+		RSIPeriod:    14,
+		VMAPeriod:    20,
+		VROCPeriod:   5,
+		PriceType:    ClosePrice,
+
+		SMAWeight:       1,
+		BollingerWeight: 1,
+		RSIWeight:       1,
+	}
+}
+
+// withDefaults fills zero-valued fields from DefaultAnalysisConfig
+func (c AnalysisConfig) withDefaults() AnalysisConfig {
+	defaults := DefaultAnalysisConfig()
+
+	if c.SMAPeriod == 0 {
+		c.SMAPeriod = defaults.SMAPeriod
+	}
+	if c.BBPeriod == 0 {
+		c.BBPeriod = defaults.BBPeriod
+	}
+	if c.BBMultiplier == 0 {
+		c.BBMultiplier = defaults.BBMultiplier
+	}
+	if c.RSIPeriod == 0 {
+		c.RSIPeriod = defaults.RSIPeriod
+	}
+	if c.VMAPeriod == 0 {
+		c.VMAPeriod = defaults.VMAPeriod
+	}
+	if c.VROCPeriod == 0 {
+		c.VROCPeriod = defaults.VROCPeriod
+	}
+
+	if c.SMAWeight == 0 {
+		c.SMAWeight = defaults.SMAWeight
+	}
+	if c.BollingerWeight == 0 {
+		c.BollingerWeight = defaults.BollingerWeight
+	}
+	if c.RSIWeight == 0 {
+		c.RSIWeight = defaults.RSIWeight
+	}
+
+	voters := c.technicalIndicatorCount() + len(c.ExtraVotes)
+	if c.Derivatives != nil {
+		voters++
+	}
+	if c.StrongVotes == 0 {
+		c.StrongVotes = voters
+	}
+	if c.BuyVotes == 0 {
+		c.BuyVotes = voters/2 + 1
+	}
+
+	return c
+}
+
+// technicalIndicatorCount returns the number of voting technical indicators
+func (c AnalysisConfig) technicalIndicatorCount() int {
+	count := 0
+	for _, skip := range []bool{c.SkipSMA, c.SkipBollinger, c.SkipRSI} {
+		if !skip {
+			count++
+		}
+	}
+	return count
+}
+
+// MinCandles returns the number of candles ComprehensiveAnalysisWithConfig needs for every included
+// indicator to contribute a signal. Shorter datasets still produce a result, with the affected indicators
+// holding, so callers can use this to reject feeds up front.
+func (c AnalysisConfig) MinCandles() int {
+	c = c.withDefaults()
+
+	required := 0
+	if !c.SkipSMA {
+		required = max(required, c.SMAPeriod+1) // Crossover compares the last two SMA values
+	}
+	if !c.SkipBollinger {
+		required = max(required, c.BBPeriod+9) // Squeeze averages the last 10 band widths
+	}
+	if !c.SkipRSI {
+		required = max(required, c.RSIPeriod+1)
+	}
+	return required
+}
+
+// UltimateMinCandles returns the number of candles UltimateAnalysisWithConfig needs: the technical
+// indicators plus, unless volume is skipped, the volume strategy
+func (c AnalysisConfig) UltimateMinCandles() int {
+	c = c.withDefaults()
+
+	required := c.MinCandles()
+	if !c.SkipVolume {
+		required = max(required, volumeStrategyMinCandles(c.VMAPeriod, c.VROCPeriod))
+	}
+	return required
+}
+
+// CheckCandles returns ErrInsufficientData if a dataset of n candles is too short for UltimateMinCandles
+func (c AnalysisConfig) CheckCandles(n int) error {
+	if need := c.UltimateMinCandles(); n < need {
+		return ErrInsufficientData{Need: need, Have: n}
+	}
+	return nil
+}
+
+// validate checks that the configuration can produce an analysis
+func (c AnalysisConfig) validate() error {
+	if c.technicalIndicatorCount() == 0 {
+		return invalidParameter("at least one technical indicator must be included")
+	}
+
+	if c.SMAPeriod < 0 || c.BBPeriod < 0 || c.RSIPeriod < 0 || c.VMAPeriod < 0 || c.VROCPeriod < 0 {
+		return invalidPeriod("periods must be greater than 0")
+	}
+
+	if c.Arithmetic != "" && c.Arithmetic != Float64Arithmetic && c.Arithmetic != BigFloatArithmetic {
+		return invalidParameter("unknown arithmetic: %s", c.Arithmetic)
+	}
+
+	if err := c.MissingValues.validate(); err != nil {
+		return err
+	}
+
+	if c.BBMultiplier < 0 {
+		return invalidParameter("multiplier must be greater than 0")
+	}
+
+	if c.BuyVotes < 0 || c.StrongVotes < 0 {
+		return invalidParameter("vote thresholds must not be negative")
+	}
+
+	if c.SMAWeight < 0 || c.BollingerWeight < 0 || c.RSIWeight < 0 {
+		return invalidParameter("indicator weights must not be negative")
+	}
+
+	if err := c.Regime.withDefaults().validate(); err != nil {
+		return err
+	}
+
+	if err := c.DerivativesConfig.withDefaults().validate(); err != nil {
+		return err
+	}
+	if c.Derivatives != nil {
+		if err := c.Derivatives.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.RugPull.withDefaults().validate(); err != nil {
+		return err
+	}
+	if err := c.Tradability.withDefaults().validate(); err != nil {
+		return err
+	}
+	if err := c.Liquidation.withDefaults().validate(); err != nil {
+		return err
+	}
+	if err := c.Manipulation.withDefaults().validate(); err != nil {
+		return err
+	}
+	if err := validateLiquidity(c.Liquidity); err != nil {
+		return err
+	}
+	if c.Fundamentals != nil {
+		if err := c.Fundamentals.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, interval := range c.ConfirmTimeframes {
+		if interval <= 0 {
+			return invalidParameter("confirmation timeframes must be greater than 0")
+		}
+	}
+
+	for i := 1; i < len(c.HigherTimeframe); i++ {
+		if !c.HigherTimeframe[i].Timestamp.After(c.HigherTimeframe[i-1].Timestamp) {
+			return invalidParameter("higher timeframe candles must be in increasing time order")
+		}
+	}
+
+	for _, vote := range c.ExtraVotes {
+		if vote.Vote == nil {
+			return invalidParameter("vote %q has no function", vote.Name)
+		}
+		if vote.Weight < 0 {
+			return invalidParameter("vote %q has a negative weight", vote.Name)
+		}
+	}
+
+	return nil
+}
+
+// AnalysisOption configures an AnalysisConfig
+type AnalysisOption func(*AnalysisConfig)
+
+// NewAnalysisConfig builds a configuration from the defaults and the given options
+func NewAnalysisConfig(opts ...AnalysisOption) AnalysisConfig {
+	config := DefaultAnalysisConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// WithSMAPeriod sets the SMA period (the crossover uses half of it as the fast period)
+func WithSMAPeriod(period int) AnalysisOption {
+	return func(c *AnalysisConfig) { c.SMAPeriod = period }
+}
+
+// WithBollinger sets the Bollinger Bands period and standard deviation multiplier
+func WithBollinger(period int, multiplier float64) AnalysisOption {
+	return func(c *AnalysisConfig) {
+		c.BBPeriod = period
+		c.BBMultiplier = multiplier
+	}
+}
+
+// WithRSIPeriod sets the RSI period
+func WithRSIPeriod(period int) AnalysisOption {
+	return func(c *AnalysisConfig) { c.RSIPeriod = period }
+}
+
+// WithVolumePeriods sets the volume moving average and volume rate of change periods
+func WithVolumePeriods(vmaPeriod, vrocPeriod int) AnalysisOption {
+	return func(c *AnalysisConfig) {
+		c.VMAPeriod = vmaPeriod
+		c.VROCPeriod = vrocPeriod
+	}
+}
+
+// WithPriceType sets the price used by the technical indicators
+func WithPriceType(priceType PriceType) AnalysisOption {
+	return func(c *AnalysisConfig) { c.PriceType = priceType }
+}
+
+// WithArithmetic selects the numeric backend for the SMA and Bollinger Bands calculations
+func WithArithmetic(arithmetic Arithmetic) AnalysisOption {
+	return func(c *AnalysisConfig) { c.Arithmetic = arithmetic }
+}
+
+// WithMissingValuePolicy sets how candles with missing prices or degenerate volume terms are handled
+func WithMissingValuePolicy(policy MissingValuePolicy) AnalysisOption {
+	return func(c *AnalysisConfig) { c.MissingValues = policy }
+}
+
+// WithTimeframeConfirmation makes the ultimate analysis require agreement from the dataset resampled to these intervals
+func WithTimeframeConfirmation(intervals ...time.Duration) AnalysisOption {
+	return func(c *AnalysisConfig) { c.ConfirmTimeframes = intervals }
+}
+
+// WithHigherTimeframe confirms the ultimate analysis with the candles of a higher timeframe, e.g. 4h
+// candles for a 15m dataset
+func WithHigherTimeframe(candles []OHLCV) AnalysisOption {
+	return func(c *AnalysisConfig) { c.HigherTimeframe = candles }
+}
+
+// WithVoteThresholds sets how many agreeing votes are needed for regular and strong signals
+func WithVoteThresholds(buyVotes, strongVotes int) AnalysisOption {
+	return func(c *AnalysisConfig) {
+		c.BuyVotes = buyVotes
+		c.StrongVotes = strongVotes
+	}
+}
+
+// WithDerivatives adds perpetual swap funding and open interest as a contrarian vote
+func WithDerivatives(data DerivativesData) AnalysisOption {
+	return func(c *AnalysisConfig) { c.Derivatives = &data }
+}
+
+// WithLiquidity sets the pool TVL or order book depth series checked for sudden removals
+func WithLiquidity(samples []LiquiditySample) AnalysisOption {
+	return func(c *AnalysisConfig) { c.Liquidity = samples }
+}
+
+// WithYoungTokenMode lets the ultimate analysis shrink its periods to fit a short history
+func WithYoungTokenMode() AnalysisOption {
+	return func(c *AnalysisConfig) { c.YoungToken = true }
+}
+
+// WithoutSMA excludes the SMA vote from the analysis
+func WithoutSMA() AnalysisOption {
+	return func(c *AnalysisConfig) { c.SkipSMA = true }
+}
+
+// WithoutBollinger excludes the Bollinger Bands vote from the analysis
+func WithoutBollinger() AnalysisOption {
+	return func(c *AnalysisConfig) { c.SkipBollinger = true }
+}
+
+// WithoutRSI excludes the RSI vote from the analysis
+func WithoutRSI() AnalysisOption {
+	return func(c *AnalysisConfig) { c.SkipRSI = true }
+}
+
+// WithoutVolume excludes volume confirmation and rug pull assessment from the ultimate analysis
+func WithoutVolume() AnalysisOption {
+	return func(c *AnalysisConfig) { c.SkipVolume = true }
+}
+
+// WithWeights sets the vote weights of the SMA, Bollinger Bands, and RSI indicators
+func WithWeights(sma, bollinger, rsi float64) AnalysisOption {
+	return func(c *AnalysisConfig) {
+		c.SMAWeight = sma
+		c.BollingerWeight = bollinger
+		c.RSIWeight = rsi
+	}
+}
+
+// WithVote registers an additional weighted indicator vote
+func WithVote(name string, weight float64, vote func(dataset []OHLCV) (Signal, error)) AnalysisOption {
+	return func(c *AnalysisConfig) {
+		c.ExtraVotes = append(c.ExtraVotes, IndicatorVote{Name: name, Weight: weight, Vote: vote})
+	}
+}
+
+// WithIndicatorVote adds a SignalIndicator (e.g. one created with NewIndicator) as a weighted vote
+func WithIndicatorVote(indicator SignalIndicator, weight float64) AnalysisOption {
+	return WithVote(indicator.Name(), weight, indicator.Signal)
+}
+
+// WithRegisteredVote adds a registered indicator as a weighted vote. The indicator must implement
+// SignalIndicator; otherwise the vote fails with ErrInvalidParameter when the analysis runs.
+func WithRegisteredVote(name string, params IndicatorParams, weight float64) AnalysisOption {
+	indicator, err := NewIndicator(name, params)
+	if err != nil {
+		return WithVote(name, weight, func([]OHLCV) (Signal, error) { return "", err })
+	}
+	voter, ok := indicator.(SignalIndicator)
+	if !ok {
+		return WithVote(name, weight, func([]OHLCV) (Signal, error) {
+			return "", invalidParameter("indicator %q does not implement SignalIndicator", name)
+		})
+	}
+	return WithIndicatorVote(voter, weight)
+}
@@ -0,0 +1,227 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// AnomalyKind identifies what was abnormal about a candle
+type AnomalyKind string
+
+const (
+	PriceAnomaly  AnomalyKind = "price_move"   // Close-to-close log return far outside the recent distribution
+	VolumeAnomaly AnomalyKind = "volume_spike" // Log volume far above the recent distribution
+)
+
+// AnomalyMethod selects the rolling statistic of DetectAnomalies
+type AnomalyMethod string
+
+const (
+	ZScoreAnomalies AnomalyMethod = "zscore" // Mean and standard deviation
+	MADAnomalies    AnomalyMethod = "mad"    // Median and median absolute deviation, robust to earlier outliers
+)
+
+// AnomalySeverity grades an anomaly by how far its score exceeds the threshold
+type AnomalySeverity string
+
+const (
+	ModerateAnomaly AnomalySeverity = "moderate" // Score at or above the threshold
+	HighAnomaly     AnomalySeverity = "high"     // At least 1.5 times the threshold
+	ExtremeAnomaly  AnomalySeverity = "extreme"  // At least twice the threshold
+)
+
+// Anomaly is one abnormal candle found by DetectAnomalies
+type Anomaly struct {
+	Index     int             `json:"index"`
+	Timestamp time.Time       `json:"timestamp"`
+	Kind      AnomalyKind     `json:"kind"`
+	Value     float64         `json:"value"` // Log return or volume of the candle
+	Score     float64         `json:"score"` // Standardized distance from the window center, signed for price moves
+	Severity  AnomalySeverity `json:"severity"`
+}
+
+// minAnomalySamples is the number of preceding values a candle needs to be scored
+const minAnomalySamples = 5
+
+// AnomalyConfig configures DetectAnomalies. Zero fields take the defaults in brackets.
+type AnomalyConfig struct {
+	Method    AnomalyMethod `json:"method"`    // [mad]
+	Window    int           `json:"window"`    // Preceding candles each candle is compared against [30]
+	Threshold float64       `json:"threshold"` // Score at which a candle is anomalous [3.5]
+	PriceType PriceType     `json:"price_type"`
+}
+
+// withDefaults fills zero fields
+func (c AnomalyConfig) withDefaults() AnomalyConfig {
+	if c.Method == "" {
+		c.Method = MADAnomalies
+	}
+	if c.Window == 0 {
+		c.Window = 30
+	}
+	if c.Threshold == 0 {
+		c.Threshold = 3.5
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c AnomalyConfig) validate() error {
+	if c.Method != ZScoreAnomalies && c.Method != MADAnomalies {
+		return invalidParameter("unknown anomaly method %q", c.Method)
+	}
+	if c.Window < minAnomalySamples {
+		return invalidPeriod("anomaly window must be at least %d, got %d", minAnomalySamples, c.Window)
+	}
+	if c.Threshold <= 0 {
+		return invalidParameter("anomaly threshold must be greater than 0, got %v", c.Threshold)
+	}
+	return nil
+}
+
+// DetectAnomalies flags candles whose price move or volume is abnormal relative to the preceding Window
+// candles, using a rolling z-score or the robust MAD score (0.6745 * (x - median) / MAD). Price moves are
+// flagged in both directions, volume only when it spikes. Early candles are compared against the candles
+// before them once there are at least five, so anomalies inside an indicator's warm-up are found too.
+// A candle can produce one anomaly of each kind; results are in dataset order. Candles with missing prices
+// or volume are skipped.
+func DetectAnomalies(dataset []OHLCV, config AnomalyConfig) ([]Anomaly, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if len(dataset) < minAnomalySamples+2 {
+		return nil, ErrInsufficientData{Need: minAnomalySamples + 2, Have: len(dataset)}
+	}
+
+	// Log returns (index i is the move into candle i) and log volumes; NaN where undefined
+	returns := make([]float64, len(dataset))
+	volumes := make([]float64, len(dataset))
+	returns[0] = math.NaN()
+	for i, candle := range dataset {
+		volumes[i] = math.NaN()
+		if candle.Volume > 0 {
+			volumes[i] = math.Log(candle.Volume)
+		}
+		if i > 0 {
+			prev, price := dataset[i-1].ExtractPrice(config.PriceType), candle.ExtractPrice(config.PriceType)
+			returns[i] = math.NaN()
+			if prev > 0 && price > 0 {
+				returns[i] = math.Log(price / prev)
+			}
+		}
+	}
+
+	var anomalies []Anomaly
+	window := make([]float64, 0, config.Window)
+	for i := 1; i < len(dataset); i++ {
+		for _, series := range []struct {
+			kind   AnomalyKind
+			values []float64
+		}{{PriceAnomaly, returns}, {VolumeAnomaly, volumes}} {
+			value := series.values[i]
+			if math.IsNaN(value) {
+				continue
+			}
+
+			window = window[:0]
+			for _, v := range series.values[max(0, i-config.Window):i] {
+				if !math.IsNaN(v) {
+					window = append(window, v)
+				}
+			}
+			score, ok := anomalyScore(window, value, config.Method)
+			if !ok {
+				continue
+			}
+
+			magnitude := math.Abs(score)
+			if series.kind == VolumeAnomaly {
+				magnitude = score // Only spikes count
+			}
+			if magnitude < config.Threshold {
+				continue
+			}
+
+			severity := ModerateAnomaly
+			switch {
+			case magnitude >= 2*config.Threshold:
+				severity = ExtremeAnomaly
+			case magnitude >= 1.5*config.Threshold:
+				severity = HighAnomaly
+			}
+
+			recorded := value
+			if series.kind == VolumeAnomaly {
+				recorded = dataset[i].Volume
+			}
+			anomalies = append(anomalies, Anomaly{
+				Index:     i,
+				Timestamp: dataset[i].Timestamp,
+				Kind:      series.kind,
+				Value:     recorded,
+				Score:     score,
+				Severity:  severity,
+			})
+		}
+	}
+	return anomalies, nil
+}
+
+// anomalyScore standardizes value against the window; ok is false when the window has too few values or
+// no spread
+func anomalyScore(window []float64, value float64, method AnomalyMethod) (score float64, ok bool) {
+	if len(window) < minAnomalySamples {
+		return 0, false
+	}
+
+	if method == ZScoreAnomalies {
+		mean := average(window)
+		sd := stdDev(window, mean)
+		if sd == 0 {
+			return 0, false
+		}
+		return (value - mean) / sd, true
+	}
+
+	sorted := append([]float64(nil), window...)
+	sort.Float64s(sorted)
+	median := percentile(sorted, 50)
+	for i, v := range sorted {
+		sorted[i] = math.Abs(v - median)
+	}
+	sort.Float64s(sorted)
+	mad := percentile(sorted, 50)
+	if mad == 0 {
+		return 0, false
+	}
+	return 0.6745 * (value - median) / mad, true
+}
+
+// ExcludeAnomalies returns the dataset without the anomalous candles among its first warmup candles, so
+// indicators seed their averages from ordinary data while later anomalies stay visible to the signals.
+// A warmup of 0 or less removes anomalies everywhere. The dataset is not modified.
+func ExcludeAnomalies(dataset []OHLCV, anomalies []Anomaly, warmup int) []OHLCV {
+	if warmup <= 0 {
+		warmup = len(dataset)
+	}
+
+	excluded := make(map[int]bool, len(anomalies))
+	for _, anomaly := range anomalies {
+		if anomaly.Index >= 0 && anomaly.Index < min(warmup, len(dataset)) {
+			excluded[anomaly.Index] = true
+		}
+	}
+
+	cleaned := make([]OHLCV, 0, len(dataset)-len(excluded))
+	for i, candle := range dataset {
+		if !excluded[i] {
+			cleaned = append(cleaned, candle)
+		}
+	}
+	return cleaned
+}
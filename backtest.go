@@ -0,0 +1,146 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// Strategy returns the signal for the most recent candle of the history it is given
+type Strategy func(history []OHLCV) (Signal, error)
+
+// ComprehensiveStrategy trades the final signal of ComprehensiveAnalysisWithConfig
+func ComprehensiveStrategy(config AnalysisConfig) Strategy {
+	return func(history []OHLCV) (Signal, error) {
+		analysis, err := ComprehensiveAnalysisWithConfig(history, config)
+		if err != nil {
+			return "", err
+		}
+		return analysis.FinalSignal, nil
+	}
+}
+
+// BacktestConfig controls a backtest run
+type BacktestConfig struct {
+	Start   int     // First candle that may trade; earlier candles are warm-up history for the strategy
+	FeeRate float64 // Fraction of equity charged on every entry and exit (0.001 = 0.1%)
+}
+
+// BacktestTrade represents one completed long trade
+type BacktestTrade struct {
+	EntryTime  time.Time `json:"entry_time"`
+	ExitTime   time.Time `json:"exit_time"`
+	EntryPrice float64   `json:"entry_price"`
+	ExitPrice  float64   `json:"exit_price"`
+	Return     float64   `json:"return"` // Net of fees
+}
+
+// BacktestResult represents the performance of a strategy over a dataset
+type BacktestResult struct {
+	Trades      []BacktestTrade `json:"trades"`
+	EquityCurve []EquityPoint   `json:"equity_curve"` // Growth of 1 unit from the Start candle
+	TotalReturn float64         `json:"total_return"`
+	MaxDrawdown float64         `json:"max_drawdown"` // Deepest equity decline (0.3 = 30%)
+	SharpeRatio float64         `json:"sharpe_ratio"` // Per-candle, not annualized
+	WinRate     float64         `json:"win_rate"`     // Share of trades with a positive return
+	Exposure    float64         `json:"exposure"`     // Share of candles spent in a position
+}
+
+// Backtest runs a long-only strategy over the dataset: a bullish signal opens a position at the candle's
+// close and a bearish signal closes it. A position still open at the end is closed at the last close.
+func Backtest(dataset []OHLCV, strategy Strategy, config BacktestConfig) (BacktestResult, error) {
+	return BacktestContext(context.Background(), dataset, strategy, config)
+}
+
+// BacktestContext is Backtest with cancellation, checked before every candle.
+// Strategy errors wrapping ErrInsufficientData count as no signal so warm-up candles are skipped.
+func BacktestContext(ctx context.Context, dataset []OHLCV, strategy Strategy, config BacktestConfig) (BacktestResult, error) {
+	if len(dataset) == 0 {
+		return BacktestResult{}, ErrEmptyDataset
+	}
+	if strategy == nil {
+		return BacktestResult{}, invalidParameter("strategy is required")
+	}
+	if config.Start < 0 || config.Start >= len(dataset) {
+		return BacktestResult{}, invalidParameter("start must be between 0 and %d", len(dataset)-1)
+	}
+	if config.FeeRate < 0 || config.FeeRate >= 1 {
+		return BacktestResult{}, invalidParameter("fee rate must be between 0 and 1")
+	}
+
+	var result BacktestResult
+	equity, peak := 1.0, 1.0
+	inPosition := false
+	var entry OHLCV
+	var returns []float64
+	candlesInPosition := 0
+
+	closeTrade := func(exit OHLCV) {
+		equity *= 1 - config.FeeRate
+		result.Trades = append(result.Trades, BacktestTrade{
+			EntryTime:  entry.Timestamp,
+			ExitTime:   exit.Timestamp,
+			EntryPrice: entry.Close,
+			ExitPrice:  exit.Close,
+			Return:     exit.Close/entry.Close*(1-config.FeeRate)*(1-config.FeeRate) - 1,
+		})
+		inPosition = false
+	}
+
+	for i := config.Start; i < len(dataset); i++ {
+		if err := ctx.Err(); err != nil {
+			return BacktestResult{}, err
+		}
+
+		previousEquity := equity
+		if inPosition && i > config.Start {
+			equity *= dataset[i].Close / dataset[i-1].Close
+			candlesInPosition++
+		}
+
+		signal, err := strategy(dataset[:i+1])
+		if err != nil && !errors.Is(err, ErrInsufficientData{}) {
+			return BacktestResult{}, err
+		}
+
+		switch {
+		case !inPosition && signal.IsBullish():
+			equity *= 1 - config.FeeRate
+			entry = dataset[i]
+			inPosition = true
+		case inPosition && signal.IsBearish():
+			closeTrade(dataset[i])
+		}
+
+		if i == len(dataset)-1 && inPosition {
+			closeTrade(dataset[i])
+		}
+
+		if i > config.Start {
+			returns = append(returns, equity/previousEquity-1)
+		}
+		peak = math.Max(peak, equity)
+		result.MaxDrawdown = math.Max(result.MaxDrawdown, (peak-equity)/peak)
+		result.EquityCurve = append(result.EquityCurve, EquityPoint{Timestamp: dataset[i].Timestamp, Value: equity})
+	}
+
+	result.TotalReturn = equity - 1
+	if len(returns) >= 2 {
+		_, _, result.SharpeRatio, _ = sharpeStats(returns, 0)
+	}
+	if len(result.Trades) > 0 {
+		wins := 0
+		for _, trade := range result.Trades {
+			if trade.Return > 0 {
+				wins++
+			}
+		}
+		result.WinRate = float64(wins) / float64(len(result.Trades))
+	}
+	if len(returns) > 0 {
+		result.Exposure = float64(candlesInPosition) / float64(len(returns))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package techindicators
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// BatchResult is the outcome of analyzing one symbol in a batch
+type BatchResult[T any] struct {
+	Symbol string  `json:"symbol"`
+	Result T       `json:"result"`
+	Score  float64 `json:"score"`           // Ranking score, higher first
+	Err    error   `json:"-"`               // Set when this symbol failed; the batch continues
+	Error  string  `json:"error,omitempty"` // Err as text for serialization
+}
+
+// RunBatch runs analyze on every symbol with at most workers concurrent calls (0 means the number of CPUs)
+// and returns the results ranked by score, highest first, with failed symbols last.
+// Per-symbol errors are reported in the results; only cancellation stops the batch.
+func RunBatch[T any](ctx context.Context, assets map[string][]OHLCV, workers int,
+	analyze func(ctx context.Context, dataset []OHLCV) (T, error), score func(T) float64) ([]BatchResult[T], error) {
+
+	if len(assets) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if analyze == nil || score == nil {
+		return nil, invalidParameter("analyze and score functions are required")
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	symbols := make([]string, 0, len(assets))
+	for symbol := range assets {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	results := make([]BatchResult[T], len(symbols))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, len(symbols)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				result := BatchResult[T]{Symbol: symbols[i]}
+				result.Result, result.Err = analyze(ctx, assets[symbols[i]])
+				if result.Err != nil {
+					result.Error = result.Err.Error()
+				} else {
+					result.Score = score(result.Result)
+				}
+				results[i] = result
+			}
+		}()
+	}
+
+feed:
+	for i := range symbols {
+		select {
+		case <-ctx.Done():
+			break feed
+		case indices <- i:
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		if (results[a].Err == nil) != (results[b].Err == nil) {
+			return results[a].Err == nil
+		}
+		return results[a].Score > results[b].Score
+	})
+	return results, nil
+}
+
+// AnalyzeBatch runs UltimateAnalysisWithConfig on every symbol concurrently and ranks the results,
+// strongest buy setups first and strongest sell setups last
+func AnalyzeBatch(assets map[string][]OHLCV, config AnalysisConfig) ([]BatchResult[UltimateMemecoinAnalysis], error) {
+	return AnalyzeBatchContext(context.Background(), assets, config, 0)
+}
+
+// AnalyzeBatchContext is AnalyzeBatch with cancellation and a worker limit (0 means the number of CPUs)
+func AnalyzeBatchContext(ctx context.Context, assets map[string][]OHLCV, config AnalysisConfig, workers int) ([]BatchResult[UltimateMemecoinAnalysis], error) {
+	if err := config.withDefaults().validate(); err != nil {
+		return nil, err
+	}
+
+	return RunBatch(ctx, assets, workers,
+		func(ctx context.Context, dataset []OHLCV) (UltimateMemecoinAnalysis, error) {
+			return UltimateAnalysisContext(ctx, dataset, config)
+		},
+		ultimateBatchScore)
+}
+
+// ultimateBatchScore ranks by signal direction and strength, weighted by confidence and discounted by risk
+func ultimateBatchScore(analysis UltimateMemecoinAnalysis) float64 {
+	strength := float64(analysis.FinalSignal.Direction())
+	if analysis.FinalSignal.IsStrong() {
+		strength *= 2
+	}
+	return strength * analysis.ConfidenceScore * (1 - analysis.RiskScore/2)
+}
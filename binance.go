@@ -0,0 +1,140 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// binancePageSize is the largest number of klines Binance returns per request
+const binancePageSize = 1000
+
+// binanceIntervals maps candle intervals to Binance kline interval names
+var binanceIntervals = map[time.Duration]string{
+	time.Minute:        "1m",
+	3 * time.Minute:    "3m",
+	5 * time.Minute:    "5m",
+	15 * time.Minute:   "15m",
+	30 * time.Minute:   "30m",
+	time.Hour:          "1h",
+	2 * time.Hour:      "2h",
+	4 * time.Hour:      "4h",
+	6 * time.Hour:      "6h",
+	8 * time.Hour:      "8h",
+	12 * time.Hour:     "12h",
+	24 * time.Hour:     "1d",
+	3 * 24 * time.Hour: "3d",
+	7 * 24 * time.Hour: "1w",
+}
+
+// BinanceClient fetches klines from the Binance spot REST API
+type BinanceClient struct {
+	BaseURL    string       // Defaults to https://api.binance.com
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+}
+
+// NewBinanceClient creates a Binance client for the public spot API
+func NewBinanceClient() *BinanceClient {
+	return &BinanceClient{BaseURL: "https://api.binance.com"}
+}
+
+// Name returns the data source name
+func (c *BinanceClient) Name() string {
+	return "binance"
+}
+
+// FetchOHLCV downloads klines for a symbol such as "BTCUSDT". Without a Start it pages backwards from End
+// until Limit candles are collected; with a Start it pages forwards until End or Limit is reached.
+func (c *BinanceClient) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	if req.Symbol == "" {
+		return nil, invalidParameter("symbol is required")
+	}
+	interval, err := sourceInterval(c.Name(), req.Interval, binanceIntervals)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = binancePageSize
+	}
+
+	var candles []OHLCV
+	if req.Start.IsZero() {
+		end := req.End
+		for len(candles) < limit {
+			size := min(limit-len(candles), binancePageSize)
+			page, err := c.klines(ctx, req.Symbol, interval, time.Time{}, end, size)
+			if err != nil {
+				return nil, err
+			}
+			candles = append(page, candles...)
+			if len(page) < size {
+				break
+			}
+			end = page[0].Timestamp.Add(-time.Millisecond)
+		}
+		if len(candles) > limit {
+			candles = candles[len(candles)-limit:]
+		}
+		return candles, nil
+	}
+
+	start := req.Start
+	for len(candles) < limit {
+		size := min(limit-len(candles), binancePageSize)
+		page, err := c.klines(ctx, req.Symbol, interval, start, req.End, size)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+		if len(page) < size {
+			break
+		}
+		start = page[len(page)-1].Timestamp.Add(time.Millisecond)
+	}
+	if len(candles) > limit {
+		candles = candles[:limit]
+	}
+	return candles, nil
+}
+
+// FetchBinanceKlines is a shorthand for fetching the most recent klines with a default client
+func FetchBinanceKlines(ctx context.Context, symbol string, interval time.Duration, limit int) ([]OHLCV, error) {
+	return NewBinanceClient().FetchOHLCV(ctx, FetchRequest{Symbol: symbol, Interval: interval, Limit: limit})
+}
+
+// klines requests one page of klines
+func (c *BinanceClient) klines(ctx context.Context, symbol, interval string, start, end time.Time, limit int) ([]OHLCV, error) {
+	query := url.Values{}
+	query.Set("symbol", strings.ToUpper(symbol))
+	query.Set("interval", interval)
+	query.Set("limit", strconv.Itoa(limit))
+	if !start.IsZero() {
+		query.Set("startTime", strconv.FormatInt(start.UnixMilli(), 10))
+	}
+	if !end.IsZero() {
+		query.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+	}
+
+	var rows [][]json.RawMessage
+	if err := getJSON(ctx, c.HTTPClient, c.Name(), strings.TrimRight(c.BaseURL, "/")+"/api/v3/klines?"+query.Encode(), nil, &rows); err != nil {
+		return nil, err
+	}
+
+	// Each kline is [open time, open, high, low, close, volume, close time, quote volume, trades, ...] with prices as strings
+	candles := make([]OHLCV, len(rows))
+	for i, row := range rows {
+		candle, err := parseJSONRow(row, [6]int{0, 1, 2, 3, 4, 5}, time.Millisecond)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name(), err)
+		}
+		candle.Trades = jsonCount(row, 8)
+		candles[i] = candle
+	}
+	return candles, nil
+}
@@ -0,0 +1,169 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// birdeyePageSize is the largest number of candles Birdeye returns per request
+const birdeyePageSize = 1000
+
+// birdeyeIntervals maps candle intervals to Birdeye OHLCV type names
+var birdeyeIntervals = map[time.Duration]string{
+	time.Minute:        "1m",
+	3 * time.Minute:    "3m",
+	5 * time.Minute:    "5m",
+	15 * time.Minute:   "15m",
+	30 * time.Minute:   "30m",
+	time.Hour:          "1H",
+	2 * time.Hour:      "2H",
+	4 * time.Hour:      "4H",
+	6 * time.Hour:      "6H",
+	8 * time.Hour:      "8H",
+	12 * time.Hour:     "12H",
+	24 * time.Hour:     "1D",
+	3 * 24 * time.Hour: "3D",
+	7 * 24 * time.Hour: "1W",
+}
+
+// BirdeyeClient fetches Solana token candles and market data from the Birdeye API
+type BirdeyeClient struct {
+	BaseURL    string       // Defaults to https://public-api.birdeye.so
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+	APIKey     string       // Sent as X-API-KEY; required by Birdeye
+	Chain      string       // Defaults to "solana"
+}
+
+// NewBirdeyeClient creates a Birdeye client for Solana tokens
+func NewBirdeyeClient(apiKey string) *BirdeyeClient {
+	return &BirdeyeClient{BaseURL: "https://public-api.birdeye.so", APIKey: apiKey, Chain: "solana"}
+}
+
+// Name returns the data source name
+func (c *BirdeyeClient) Name() string {
+	return "birdeye"
+}
+
+// FetchOHLCV downloads USD candles for a token keyed by its mint address, split into windows of 1000 candles
+func (c *BirdeyeClient) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	if req.Symbol == "" {
+		return nil, invalidParameter("mint address is required")
+	}
+	interval, err := sourceInterval(c.Name(), req.Interval, birdeyeIntervals)
+	if err != nil {
+		return nil, err
+	}
+
+	return fetchWindows(req, birdeyePageSize, func(start, end time.Time) ([]OHLCV, error) {
+		return c.ohlcv(ctx, req.Symbol, interval, start, end)
+	})
+}
+
+// TokenOverview fetches liquidity, 24h volume, trade counts, and holders for a token, the liquidity
+// context used by ApplyMarketContext
+func (c *BirdeyeClient) TokenOverview(ctx context.Context, mint string) (TokenMarketData, error) {
+	if mint == "" {
+		return TokenMarketData{}, invalidParameter("mint address is required")
+	}
+
+	var resp struct {
+		Data struct {
+			Price       float64 `json:"price"`
+			Liquidity   float64 `json:"liquidity"`
+			Volume24h   float64 `json:"v24hUSD"`
+			Trades24h   int     `json:"trade24h"`
+			Buys24h     int     `json:"buy24h"`
+			Sells24h    int     `json:"sell24h"`
+			Holders     int     `json:"holder"`
+			MarketCap   float64 `json:"marketCap"`
+			LastTradeAt int64   `json:"lastTradeUnixTime"`
+		} `json:"data"`
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+	if err := c.get(ctx, "/defi/token_overview", url.Values{"address": {mint}}, &resp); err != nil {
+		return TokenMarketData{}, err
+	}
+	if !resp.Success {
+		return TokenMarketData{}, fmt.Errorf("%s: %s", c.Name(), resp.Message)
+	}
+
+	data := resp.Data
+	market := TokenMarketData{
+		Address:      mint,
+		Price:        data.Price,
+		LiquidityUSD: data.Liquidity,
+		Volume24hUSD: data.Volume24h,
+		MarketCapUSD: data.MarketCap,
+		Trades24h:    data.Trades24h,
+		Buys24h:      data.Buys24h,
+		Sells24h:     data.Sells24h,
+		Holders:      data.Holders,
+	}
+	if data.LastTradeAt > 0 {
+		market.LastTrade = time.Unix(data.LastTradeAt, 0).UTC()
+	}
+	return market, nil
+}
+
+// ohlcv requests the candles of one window
+func (c *BirdeyeClient) ohlcv(ctx context.Context, mint, interval string, start, end time.Time) ([]OHLCV, error) {
+	query := url.Values{}
+	query.Set("address", mint)
+	query.Set("type", interval)
+	query.Set("time_from", strconv.FormatInt(start.Unix(), 10))
+	query.Set("time_to", strconv.FormatInt(end.Unix(), 10))
+
+	var resp struct {
+		Data struct {
+			Items []struct {
+				UnixTime json.RawMessage `json:"unixTime"`
+				Open     json.RawMessage `json:"o"`
+				High     json.RawMessage `json:"h"`
+				Low      json.RawMessage `json:"l"`
+				Close    json.RawMessage `json:"c"`
+				Volume   json.RawMessage `json:"v"`
+			} `json:"items"`
+		} `json:"data"`
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+	if err := c.get(ctx, "/defi/ohlcv", query, &resp); err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("%s: %s", c.Name(), resp.Message)
+	}
+
+	candles := make([]OHLCV, len(resp.Data.Items))
+	for i, item := range resp.Data.Items {
+		candle, err := parseJSONRow([]json.RawMessage{item.UnixTime, item.Open, item.High, item.Low, item.Close, item.Volume}, [6]int{0, 1, 2, 3, 4, 5}, time.Second)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name(), err)
+		}
+		candles[i] = candle
+	}
+	return candles, nil
+}
+
+// get performs an authenticated Birdeye request
+func (c *BirdeyeClient) get(ctx context.Context, path string, query url.Values, out any) error {
+	if c.APIKey == "" {
+		return invalidParameter("birdeye API key is required")
+	}
+	chain := c.Chain
+	if chain == "" {
+		chain = "solana"
+	}
+	header := http.Header{}
+	header.Set("X-API-KEY", c.APIKey)
+	header.Set("x-chain", chain)
+
+	return getJSON(ctx, c.HTTPClient, c.Name(), strings.TrimRight(c.BaseURL, "/")+path+"?"+query.Encode(), header, out)
+}
@@ -1,62 +1,68 @@
 package techindicators
 
 import (
-	"errors"
-	"fmt"
 	"math"
+	"slices"
+	"time"
 )
 
 // BollingerBands represents Bollinger Bands values
 type BollingerBands struct {
-	Timestamp  string  `json:"timestamp"`
-	UpperBand  float64 `json:"upper_band"`
-	MiddleBand float64 `json:"middle_band"` // This is the SMA
-	LowerBand  float64 `json:"lower_band"`
-	BandWidth  float64 `json:"band_width"` // (Upper - Lower) / Middle
+	Timestamp  time.Time `json:"timestamp"`
+	UpperBand  float64   `json:"upper_band"`
+	MiddleBand float64   `json:"middle_band"` // This is the SMA
+	LowerBand  float64   `json:"lower_band"`
+	BandWidth  float64   `json:"band_width"` // (Upper - Lower) / Middle
 }
 
 // CalculateBollingerBands calculates Bollinger Bands for the given dataset
 func CalculateBollingerBands(dataset []OHLCV, period int, multiplier float64, priceType PriceType) ([]BollingerBands, error) {
+	return AppendBollingerBands(nil, dataset, period, multiplier, priceType)
+}
+
+// AppendBollingerBands appends the Bollinger Bands series for the dataset to dst and returns the extended slice
+func AppendBollingerBands(dst []BollingerBands, dataset []OHLCV, period int, multiplier float64, priceType PriceType) ([]BollingerBands, error) {
 	if len(dataset) == 0 {
-		return nil, errors.New("dataset is empty")
+		return dst, ErrEmptyDataset
 	}
 
 	if period <= 0 {
-		return nil, errors.New("period must be greater than 0")
+		return dst, invalidPeriod("period must be greater than 0")
 	}
 
 	if period > len(dataset) {
-		return nil, fmt.Errorf("period (%d) cannot be greater than dataset length (%d)", period, len(dataset))
+		return dst, ErrInsufficientData{Need: period, Have: len(dataset)}
 	}
 
 	if multiplier <= 0 {
-		return nil, errors.New("multiplier must be greater than 0")
+		return dst, invalidParameter("multiplier must be greater than 0")
 	}
 
-	var results []BollingerBands
+	results := slices.Grow(dst, len(dataset)-period+1)
+
+	// Sliding-window Welford update: mean and sum of squared deviations (m2) are adjusted as one price
+	// enters and one leaves the window, so each band costs O(1) instead of O(period)
+	mean, m2 := 0.0, 0.0
 
 	// Calculate Bollinger Bands for each possible position
 	for i := period - 1; i < len(dataset); i++ {
-		var prices []float64
-		sum := 0.0
-
-		// Collect prices for the period
-		for j := i - period + 1; j <= i; j++ {
-			price := dataset[j].ExtractPrice(priceType)
-			prices = append(prices, price)
-			sum += price
+		if start := i - period + 1; rebuildWindow(start, period) || nonFinite(dataset[start-1].ExtractPrice(priceType)) {
+			mean, m2 = windowMeanM2(dataset[start:i+1], priceType)
+		} else {
+			// Replace the oldest price with the newest
+			price := dataset[i].ExtractPrice(priceType)
+			old := dataset[start-1].ExtractPrice(priceType)
+			prevMean := mean
+			mean += (price - old) / float64(period)
+			m2 += (price - old) * (price - mean + old - prevMean)
+			if m2 < 0 {
+				m2 = 0 // Guard against rounding drift
+			}
 		}
 
-		// Calculate SMA (middle band)
-		sma := sum / float64(period)
-
-		// Calculate standard deviation
-		varianceSum := 0.0
-		for _, price := range prices {
-			diff := price - sma
-			varianceSum += diff * diff
-		}
-		stdDev := math.Sqrt(varianceSum / float64(period))
+		// Middle band and population standard deviation
+		sma := mean
+		stdDev := math.Sqrt(m2 / float64(period))
 
 		// Calculate bands
 		upperBand := sma + (multiplier * stdDev)
@@ -69,7 +75,7 @@ func CalculateBollingerBands(dataset []OHLCV, period int, multiplier float64, pr
 		}
 
 		results = append(results, BollingerBands{
-			Timestamp:  dataset[i].Timestamp.Format("2006-01-02T15:04:05Z"),
+			Timestamp:  dataset[i].Timestamp,
 			UpperBand:  upperBand,
 			MiddleBand: sma,
 			LowerBand:  lowerBand,
@@ -80,6 +86,22 @@ func CalculateBollingerBands(dataset []OHLCV, period int, multiplier float64, pr
 	return results, nil
 }
 
+// windowMeanM2 returns the mean and sum of squared deviations of the window's prices
+func windowMeanM2(window []OHLCV, priceType PriceType) (float64, float64) {
+	sum := 0.0
+	for _, candle := range window {
+		sum += candle.ExtractPrice(priceType)
+	}
+	mean := sum / float64(len(window))
+
+	m2 := 0.0
+	for _, candle := range window {
+		diff := candle.ExtractPrice(priceType) - mean
+		m2 += diff * diff
+	}
+	return mean, m2
+}
+
 // GetLatestBollingerBands returns the most recent Bollinger Bands values
 func GetLatestBollingerBands(dataset []OHLCV, period int, multiplier float64, priceType PriceType) (BollingerBands, error) {
 	bands, err := CalculateBollingerBands(dataset, period, multiplier, priceType)
@@ -88,7 +110,7 @@ func GetLatestBollingerBands(dataset []OHLCV, period int, multiplier float64, pr
 	}
 
 	if len(bands) == 0 {
-		return BollingerBands{}, errors.New("no Bollinger Bands calculated")
+		return BollingerBands{}, noResults("Bollinger Bands")
 	}
 
 	return bands[len(bands)-1], nil
@@ -108,7 +130,7 @@ const (
 // GetPricePosition determines where current price is relative to Bollinger Bands
 func GetPricePosition(dataset []OHLCV, period int, multiplier float64, priceType PriceType, tolerance float64) (BollingerPosition, error) {
 	if len(dataset) == 0 {
-		return "", errors.New("dataset is empty")
+		return "", ErrEmptyDataset
 	}
 
 	// Get latest Bollinger Bands
@@ -120,22 +142,27 @@ func GetPricePosition(dataset []OHLCV, period int, multiplier float64, priceType
 	// Get current price
 	currentPrice := dataset[len(dataset)-1].ExtractPrice(ClosePrice)
 
+	return bandPosition(currentPrice, bands, tolerance), nil
+}
+
+// bandPosition classifies a price against one set of bands, treating prices within tolerance of a band as touching it
+func bandPosition(price float64, bands BollingerBands, tolerance float64) BollingerPosition {
 	// Calculate tolerance ranges
 	upperTolerance := bands.UpperBand * (1 - tolerance)
 	lowerTolerance := bands.LowerBand * (1 + tolerance)
 
 	// Determine position
-	if currentPrice > bands.UpperBand {
-		return AboveUpperBand, nil
-	} else if currentPrice < bands.LowerBand {
-		return BelowLowerBand, nil
-	} else if currentPrice >= upperTolerance {
-		return TouchingUpper, nil
-	} else if currentPrice <= lowerTolerance {
-		return TouchingLower, nil
+	if price > bands.UpperBand {
+		return AboveUpperBand
+	} else if price < bands.LowerBand {
+		return BelowLowerBand
+	} else if price >= upperTolerance {
+		return TouchingUpper
+	} else if price <= lowerTolerance {
+		return TouchingLower
 	}
 
-	return BetweenBands, nil
+	return BetweenBands
 }
 
 // BollingerSqueeze detects if bands are in a squeeze (low volatility)
@@ -145,8 +172,17 @@ func BollingerSqueeze(dataset []OHLCV, period int, multiplier float64, priceType
 		return false, err
 	}
 
+	return squeezeFromBands(bands, period, lookback, len(dataset), squeezeFactor)
+}
+
+// squeezeFactor is the share of the average band width below which the bands are in a squeeze
+const squeezeFactor = 0.7 // 30% below average indicates squeeze
+
+// squeezeFromBands reports whether the latest band width is below factor times its average over the last
+// lookback bands
+func squeezeFromBands(bands []BollingerBands, period, lookback, candles int, factor float64) (bool, error) {
 	if len(bands) < lookback {
-		return false, errors.New("insufficient data for squeeze analysis")
+		return false, ErrInsufficientData{Need: period + lookback - 1, Have: candles}
 	}
 
 	// Get recent band widths
@@ -163,13 +199,41 @@ func BollingerSqueeze(dataset []OHLCV, period int, multiplier float64, priceType
 	currentWidth := bands[len(bands)-1].BandWidth
 
 	// Squeeze detected if current width is significantly below average
-	return currentWidth < avgWidth*0.7, nil // 30% below average indicates squeeze
+	return currentWidth < avgWidth*factor, nil
+}
+
+// BollingerSqueezeRegimeAware detects a squeeze using a contraction factor chosen by the current volatility regime.
+// In high-volatility regimes band width swings more, so a deeper contraction is required to call a squeeze.
+func BollingerSqueezeRegimeAware(dataset []OHLCV, period int, multiplier float64, priceType PriceType, lookback int) (bool, VolatilityRegime, error) {
+	regime, err := GetCurrentVolatilityRegime(dataset, period, lookback)
+	if err != nil {
+		return false, "", err
+	}
+
+	bands, err := CalculateBollingerBands(dataset, period, multiplier, priceType)
+	if err != nil {
+		return false, "", err
+	}
+
+	factor := squeezeFactor
+	switch regime.Regime {
+	case LowVolatility:
+		factor = 0.8
+	case HighVolatility:
+		factor = 0.6
+	}
+
+	squeeze, err := squeezeFromBands(bands, period, lookback, len(dataset), factor)
+	if err != nil {
+		return false, "", err
+	}
+	return squeeze, regime.Regime, nil
 }
 
 // BollingerBreakout detects potential breakouts from Bollinger Bands
-func BollingerBreakout(dataset []OHLCV, period int, multiplier float64, priceType PriceType) (string, error) {
+func BollingerBreakout(dataset []OHLCV, period int, multiplier float64, priceType PriceType) (Signal, error) {
 	if len(dataset) < 2 {
-		return "insufficient_data", nil
+		return SignalInsufficientData, nil
 	}
 
 	// Get current and previous positions
@@ -181,7 +245,7 @@ func BollingerBreakout(dataset []OHLCV, period int, multiplier float64, priceTyp
 	// Check previous candle position
 	prevDataset := dataset[:len(dataset)-1]
 	if len(prevDataset) < period {
-		return "insufficient_data", nil
+		return SignalInsufficientData, nil
 	}
 
 	prevPos, err := GetPricePosition(prevDataset, period, multiplier, priceType, 0.02)
@@ -189,68 +253,86 @@ func BollingerBreakout(dataset []OHLCV, period int, multiplier float64, priceTyp
 		return "", err
 	}
 
-	// Detect breakouts
+	return breakoutSignal(prevPos, currentPos), nil
+}
+
+// breakoutSignal detects a breakout from the previous and current band positions
+func breakoutSignal(prevPos, currentPos BollingerPosition) Signal {
 	if prevPos == BetweenBands && currentPos == AboveUpperBand {
-		return "bullish_breakout", nil
+		return SignalBullishBreakout
 	} else if prevPos == BetweenBands && currentPos == BelowLowerBand {
-		return "bearish_breakout", nil
+		return SignalBearishBreakout
 	} else if prevPos == TouchingUpper && currentPos == AboveUpperBand {
-		return "bullish_breakout", nil
+		return SignalBullishBreakout
 	} else if prevPos == TouchingLower && currentPos == BelowLowerBand {
-		return "bearish_breakout", nil
+		return SignalBearishBreakout
 	}
 
-	return "no_breakout", nil
+	return SignalNoBreakout
 }
 
 // BollingerStrategy provides comprehensive Bollinger Bands analysis
 type BollingerStrategy struct {
 	Position  BollingerPosition `json:"position"`
-	Breakout  string            `json:"breakout"`
+	Breakout  Signal            `json:"breakout"`
 	Squeeze   bool              `json:"squeeze"`
 	BandWidth float64           `json:"band_width"`
-	Signal    string            `json:"signal"`
+	Signal    Signal            `json:"signal"`
 }
 
 // AnalyzeBollingerStrategy provides complete Bollinger Bands analysis for trading decisions
 func AnalyzeBollingerStrategy(dataset []OHLCV, period int, multiplier float64, priceType PriceType) (BollingerStrategy, error) {
-	position, err := GetPricePosition(dataset, period, multiplier, priceType, 0.02)
-	if err != nil {
-		return BollingerStrategy{}, err
+	return analyzeBollingerStrategy(newAnalysisCache(dataset), period, multiplier, priceType)
+}
+
+// analyzeBollingerStrategy runs the Bollinger strategy on the cached band series.
+// The previous candle's bands are the second-to-last entry, so the breakout check needs no second calculation.
+func analyzeBollingerStrategy(cache *analysisCache, period int, multiplier float64, priceType PriceType) (BollingerStrategy, error) {
+	dataset := cache.dataset
+	if len(dataset) == 0 {
+		return BollingerStrategy{}, ErrEmptyDataset
 	}
 
-	breakout, err := BollingerBreakout(dataset, period, multiplier, priceType)
+	allBands, err := cache.bollinger(period, multiplier, priceType)
 	if err != nil {
 		return BollingerStrategy{}, err
 	}
 
-	squeeze, err := BollingerSqueeze(dataset, period, multiplier, priceType, 10)
-	if err != nil {
-		return BollingerStrategy{}, err
+	if len(allBands) == 0 {
+		return BollingerStrategy{}, noResults("Bollinger Bands")
 	}
 
-	bands, err := GetLatestBollingerBands(dataset, period, multiplier, priceType)
+	bands := allBands[len(allBands)-1]
+	position := bandPosition(dataset[len(dataset)-1].ExtractPrice(ClosePrice), bands, 0.02)
+
+	breakout := SignalInsufficientData
+	if len(dataset) >= 2 && len(dataset)-1 >= period {
+		prevPos := bandPosition(dataset[len(dataset)-2].ExtractPrice(ClosePrice), allBands[len(allBands)-2], 0.02)
+		breakout = breakoutSignal(prevPos, position)
+	}
+
+	squeeze, err := squeezeFromBands(allBands, period, 10, len(dataset), squeezeFactor)
 	if err != nil {
 		return BollingerStrategy{}, err
 	}
 
 	// Generate trading signal
-	signal := "hold"
+	signal := SignalHold
 	switch {
-	case breakout == "bullish_breakout" && !squeeze:
-		signal = "strong_buy"
-	case breakout == "bearish_breakout" && !squeeze:
-		signal = "strong_sell"
+	case breakout == SignalBullishBreakout && !squeeze:
+		signal = SignalStrongBuy
+	case breakout == SignalBearishBreakout && !squeeze:
+		signal = SignalStrongSell
 	case position == BelowLowerBand && squeeze:
-		signal = "buy" // Oversold in low volatility
+		signal = SignalBuy // Oversold in low volatility
 	case position == AboveUpperBand && squeeze:
-		signal = "sell" // Overbought in low volatility
+		signal = SignalSell // Overbought in low volatility
 	case squeeze && position == BetweenBands:
-		signal = "wait_for_breakout"
+		signal = SignalWaitForBreakout
 	case position == TouchingLower:
-		signal = "buy_signal"
+		signal = SignalBuySetup
 	case position == TouchingUpper:
-		signal = "sell_signal"
+		signal = SignalSellSetup
 	}
 
 	return BollingerStrategy{
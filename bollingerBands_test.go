@@ -0,0 +1,102 @@
+package techindicators
+
+import (
+	"math"
+	"testing"
+)
+
+// naiveBollingerBands recomputes the mean and deviation of every window from scratch
+func naiveBollingerBands(dataset []OHLCV, period int, multiplier float64) []BollingerBands {
+	bands := make([]BollingerBands, 0, len(dataset)-period+1)
+	for i := period - 1; i < len(dataset); i++ {
+		mean, m2 := windowMeanM2(dataset[i-period+1:i+1], ClosePrice)
+		stdDev := math.Sqrt(m2 / float64(period))
+		bands = append(bands, BollingerBands{
+			UpperBand:  mean + multiplier*stdDev,
+			MiddleBand: mean,
+			LowerBand:  mean - multiplier*stdDev,
+		})
+	}
+	return bands
+}
+
+func TestCalculateBollingerBandsMatchesNaive(t *testing.T) {
+	dataset := syntheticCandles(t, benchmarkCandles)
+	for _, period := range []int{2, 20, 100} {
+		bands, err := CalculateBollingerBands(dataset, period, 2, ClosePrice)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, want := range naiveBollingerBands(dataset, period, 2) {
+			assertClose(t, "middle band", i, bands[i].MiddleBand, want.MiddleBand, want.MiddleBand)
+			assertClose(t, "upper band", i, bands[i].UpperBand, want.UpperBand, want.MiddleBand)
+			assertClose(t, "lower band", i, bands[i].LowerBand, want.LowerBand, want.MiddleBand)
+		}
+	}
+}
+
+func BenchmarkCalculateBollingerBands(b *testing.B) {
+	dataset := syntheticCandles(b, benchmarkCandles)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := CalculateBollingerBands(dataset, 20, 2, ClosePrice); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNaiveBollingerBands(b *testing.B) {
+	dataset := syntheticCandles(b, benchmarkCandles)
+	b.ResetTimer()
+	for range b.N {
+		naiveBollingerBands(dataset, 20, 2)
+	}
+}
+
+func TestCalculateBollingerBandsPropagateNaNOnlyInAffectedWindows(t *testing.T) {
+	dataset := nanCandles(t)
+	const period = 10
+	bands, err := CalculateBollingerBands(dataset, period, 2, ClosePrice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	naive := naiveBollingerBands(dataset, period, 2)
+	for i, b := range bands {
+		end := i + period - 1
+		affected := windowHoldsNaN(end, period)
+		if got := math.IsNaN(b.MiddleBand) || math.IsNaN(b.UpperBand) || math.IsNaN(b.LowerBand); got != affected {
+			t.Fatalf("bands at candle %d are NaN = %v; want %v", end, got, affected)
+		}
+		if !affected {
+			assertClose(t, "middle band", i, b.MiddleBand, naive[i].MiddleBand, naive[i].MiddleBand)
+			assertClose(t, "upper band", i, b.UpperBand, naive[i].UpperBand, naive[i].MiddleBand)
+		}
+	}
+}
+
+// In a normal-volatility regime the regime-aware squeeze uses the default factor of BollingerSqueeze
+func TestBollingerSqueezeRegimeAwareNormalMatchesSqueeze(t *testing.T) {
+	dataset := syntheticCandles(t, 300)
+	checked := 0
+	for end := 60; end <= len(dataset); end++ {
+		window := dataset[:end]
+		squeeze, regime, err := BollingerSqueezeRegimeAware(window, 20, 2, ClosePrice, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if regime != NormalVolatility {
+			continue
+		}
+		want, err := BollingerSqueeze(window, 20, 2, ClosePrice, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if squeeze != want {
+			t.Fatalf("candle %d: regime-aware squeeze %v; BollingerSqueeze %v", end-1, squeeze, want)
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Fatal("no window was in a normal-volatility regime")
+	}
+}
@@ -0,0 +1,27 @@
+package techindicators
+
+import "sync"
+
+// floatPool recycles the scratch float64 buffers used inside indicator calculations
+var floatPool = sync.Pool{
+	New: func() any {
+		buf := make([]float64, 0, 256)
+		return &buf
+	},
+}
+
+// getFloats returns a pooled scratch buffer of length n; its contents are not zeroed
+func getFloats(n int) *[]float64 {
+	buf := floatPool.Get().(*[]float64)
+	if cap(*buf) < n {
+		*buf = make([]float64, n)
+	}
+	*buf = (*buf)[:n]
+	return buf
+}
+
+// putFloats returns a scratch buffer to the pool; the caller must not keep references to it
+func putFloats(buf *[]float64) {
+	*buf = (*buf)[:0]
+	floatPool.Put(buf)
+}
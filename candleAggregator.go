@@ -0,0 +1,165 @@
+package techindicators
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CandleAggregator builds candles of a fixed interval from ticks or finer candles ingested from any
+// number of goroutines, keeps a rolling window of completed candles, and notifies subscribers when a
+// candle completes. A candle completes when data for a later interval arrives or Flush is called.
+type CandleAggregator struct {
+	interval time.Duration
+
+	mu        sync.Mutex
+	current   *OHLCV
+	window    *OHLCVBuffer
+	callbacks []func(candle OHLCV, window []OHLCV)
+	channels  []chan OHLCV
+	closed    bool
+}
+
+// NewCandleAggregator creates an aggregator for the interval that keeps up to maxWindow completed candles
+func NewCandleAggregator(interval time.Duration, maxWindow int) (*CandleAggregator, error) {
+	if interval <= 0 {
+		return nil, invalidParameter("interval must be greater than 0")
+	}
+	window, err := NewOHLCVBuffer(maxWindow)
+	if err != nil {
+		return nil, err
+	}
+	return &CandleAggregator{interval: interval, window: window}, nil
+}
+
+// AddTick ingests a trade
+func (a *CandleAggregator) AddTick(timestamp time.Time, price, volume float64) error {
+	return a.AddCandle(OHLCV{Timestamp: timestamp, Open: price, High: price, Low: price, Close: price, Volume: volume})
+}
+
+// AddCandle ingests a candle of the aggregator's interval or finer. Candles falling in the in-progress
+// interval are merged into it; data for an interval older than the in-progress one is rejected.
+// Every call adds its volume, so send each tick or finer candle once.
+func (a *CandleAggregator) AddCandle(candle OHLCV) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return invalidParameter("aggregator is closed")
+	}
+
+	bucket := candle.Timestamp.UTC().Truncate(a.interval)
+	if a.current != nil {
+		switch {
+		case bucket.Before(a.current.Timestamp):
+			return fmt.Errorf("%w: candle at %s is older than the in-progress candle at %s",
+				ErrInvalidDataset, candle.Timestamp, a.current.Timestamp)
+		case bucket.Equal(a.current.Timestamp):
+			a.current.High = max(a.current.High, candle.High)
+			a.current.Low = min(a.current.Low, candle.Low)
+			a.current.Close = candle.Close
+			a.current.Volume += candle.Volume
+			return nil
+		default:
+			a.complete()
+		}
+	} else if last, ok := a.window.Last(); ok && !bucket.After(last.Timestamp) {
+		return fmt.Errorf("%w: candle at %s is not after the last completed candle", ErrInvalidDataset, candle.Timestamp)
+	}
+
+	candle.Timestamp = bucket
+	a.current = &candle
+	return nil
+}
+
+// Flush completes the in-progress candle, e.g. from a timer at the end of each interval
+func (a *CandleAggregator) Flush() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.current != nil {
+		a.complete()
+	}
+}
+
+// complete moves the in-progress candle to the window and notifies subscribers; a.mu must be held
+func (a *CandleAggregator) complete() {
+	candle := *a.current
+	a.current = nil
+
+	a.window.Append(candle)
+
+	if len(a.callbacks) > 0 {
+		window := a.window.Candles()
+		for _, callback := range a.callbacks {
+			callback(candle, window)
+		}
+	}
+	for _, ch := range a.channels {
+		ch <- candle
+	}
+}
+
+// Subscribe registers a callback run for each completed candle with a copy of the window.
+// Callbacks run synchronously in ingestion order and must not call back into the aggregator.
+func (a *CandleAggregator) Subscribe(callback func(candle OHLCV, window []OHLCV)) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.callbacks = append(a.callbacks, callback)
+}
+
+// SubscribeIndicator feeds every completed candle to a streaming indicator
+func (a *CandleAggregator) SubscribeIndicator(indicator StreamingIndicator) {
+	a.Subscribe(func(candle OHLCV, _ []OHLCV) { indicator.Update(candle) })
+}
+
+// SubscribeChannel returns a channel receiving each completed candle. Sends block, so the channel
+// must be drained; buffer sets its capacity. The channel is closed by Close.
+func (a *CandleAggregator) SubscribeChannel(buffer int) <-chan OHLCV {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch := make(chan OHLCV, max(buffer, 0))
+	if a.closed {
+		close(ch)
+		return ch
+	}
+	a.channels = append(a.channels, ch)
+	return ch
+}
+
+// Window returns a copy of the completed candles, oldest first
+func (a *CandleAggregator) Window() []OHLCV {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.window.Candles()
+}
+
+// Current returns the in-progress candle, if any
+func (a *CandleAggregator) Current() (OHLCV, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.current == nil {
+		return OHLCV{}, false
+	}
+	return *a.current, true
+}
+
+// Close flushes the in-progress candle, closes subscriber channels, and rejects further data
+func (a *CandleAggregator) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return
+	}
+	if a.current != nil {
+		a.complete()
+	}
+	a.closed = true
+	for _, ch := range a.channels {
+		close(ch)
+	}
+	a.channels = nil
+}
@@ -0,0 +1,241 @@
+package techindicators
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"time"
+)
+
+// ChartOptions controls RenderChart. Zero fields take the defaults noted on each field.
+type ChartOptions struct {
+	Width      int   // Image width in pixels (default 1000)
+	Height     int   // Image height in pixels (default 600)
+	MaxCandles int   // Most recent candles drawn (default 150); indicators still use the full history
+	SMAPeriods []int // Simple moving averages overlaid on price
+	EMAPeriods []int // Exponential moving averages overlaid on price
+
+	Bollinger    bool    // Overlay Bollinger Bands
+	BBPeriod     int     // Bollinger period (default 20)
+	BBMultiplier float64 // Bollinger multiplier (default 2)
+
+	HideVolume bool // Omit the volume panel below the price panel
+}
+
+// withDefaults fills in the zero fields
+func (o ChartOptions) withDefaults() ChartOptions {
+	if o.Width <= 0 {
+		o.Width = 1000
+	}
+	if o.Height <= 0 {
+		o.Height = 600
+	}
+	if o.MaxCandles <= 0 {
+		o.MaxCandles = 150
+	}
+	if o.BBPeriod <= 0 {
+		o.BBPeriod = 20
+	}
+	if o.BBMultiplier <= 0 {
+		o.BBMultiplier = 2
+	}
+	return o
+}
+
+// Chart colors, a dark theme in the style of common trading terminals
+var (
+	chartBackground = color.RGBA{0x13, 0x17, 0x22, 0xFF}
+	chartGrid       = color.RGBA{0x2A, 0x2E, 0x39, 0xFF}
+	chartUp         = color.RGBA{0x26, 0xA6, 0x9A, 0xFF}
+	chartDown       = color.RGBA{0xEF, 0x53, 0x50, 0xFF}
+	chartBands      = color.RGBA{0x29, 0x62, 0xFF, 0xFF}
+	chartLines      = []color.RGBA{
+		{0xFF, 0xB7, 0x4D, 0xFF}, // Orange
+		{0xBA, 0x68, 0xC8, 0xFF}, // Purple
+		{0x4D, 0xD0, 0xE1, 0xFF}, // Cyan
+		{0xFF, 0xF1, 0x76, 0xFF}, // Yellow
+	}
+)
+
+// chartOverlay is a line series drawn over the price panel
+type chartOverlay struct {
+	values map[time.Time]float64
+	color  color.RGBA
+}
+
+// RenderChart draws a candlestick chart with the requested indicator overlays and a volume panel as PNG
+func RenderChart(dataset []OHLCV, options ChartOptions) ([]byte, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	options = options.withDefaults()
+	if options.Width < 100 || options.Height < 100 || options.Width > 8000 || options.Height > 8000 {
+		return nil, invalidParameter("chart size must be between 100 and 8000 pixels, got %dx%d", options.Width, options.Height)
+	}
+
+	// Indicators use the full history, then only the visible candles are drawn
+	var overlays []chartOverlay
+	addSeries := func(series []SMAResult, c color.RGBA) {
+		values := make(map[time.Time]float64, len(series))
+		for _, point := range series {
+			values[point.Timestamp] = point.Value
+		}
+		overlays = append(overlays, chartOverlay{values: values, color: c})
+	}
+	for i, period := range options.SMAPeriods {
+		series, err := CalculateSMA(dataset, period, ClosePrice)
+		if err != nil {
+			return nil, err
+		}
+		addSeries(series, chartLines[i%len(chartLines)])
+	}
+	for i, period := range options.EMAPeriods {
+		series, err := CalculateEMA(dataset, period, ClosePrice)
+		if err != nil {
+			return nil, err
+		}
+		addSeries(series, chartLines[(len(options.SMAPeriods)+i)%len(chartLines)])
+	}
+	if options.Bollinger {
+		bands, err := CalculateBollingerBands(dataset, options.BBPeriod, options.BBMultiplier, ClosePrice)
+		if err != nil {
+			return nil, err
+		}
+		upper, lower := make([]SMAResult, len(bands)), make([]SMAResult, len(bands))
+		for i, band := range bands {
+			upper[i] = SMAResult{Timestamp: band.Timestamp, Value: band.UpperBand}
+			lower[i] = SMAResult{Timestamp: band.Timestamp, Value: band.LowerBand}
+		}
+		addSeries(upper, chartBands)
+		addSeries(lower, chartBands)
+	}
+
+	visible := dataset[max(0, len(dataset)-options.MaxCandles):]
+
+	// Layout: price panel on top, volume panel taking a fifth of the height below
+	const padding = 10
+	plot := image.Rect(padding, padding, options.Width-padding, options.Height-padding)
+	pricePanel, volumePanel := plot, image.Rectangle{}
+	if !options.HideVolume {
+		split := plot.Max.Y - plot.Dy()/5
+		pricePanel.Max.Y = split - padding
+		volumePanel = image.Rect(plot.Min.X, split, plot.Max.X, plot.Max.Y)
+	}
+
+	// Price scale covering candles and visible overlay values, with a 5% margin
+	low, high := math.Inf(1), math.Inf(-1)
+	maxVolume := 0.0
+	for _, candle := range visible {
+		low, high = math.Min(low, candle.Low), math.Max(high, candle.High)
+		for _, overlay := range overlays {
+			if value, ok := overlay.values[candle.Timestamp]; ok {
+				low, high = math.Min(low, value), math.Max(high, value)
+			}
+		}
+		maxVolume = math.Max(maxVolume, candle.Volume)
+	}
+	if high <= low {
+		high, low = high+1, low-1
+	}
+	margin := (high - low) * 0.05
+	low, high = low-margin, high+margin
+
+	priceY := func(price float64) int {
+		return pricePanel.Max.Y - int(math.Round((price-low)/(high-low)*float64(pricePanel.Dy())))
+	}
+	slot := float64(plot.Dx()) / float64(len(visible))
+	centerX := func(i int) int { return plot.Min.X + int(slot*(float64(i)+0.5)) }
+	bodyHalf := max(0, int(slot*0.35))
+
+	img := image.NewRGBA(image.Rect(0, 0, options.Width, options.Height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{chartBackground}, image.Point{}, draw.Src)
+
+	for i := 0; i <= 4; i++ {
+		y := pricePanel.Min.Y + i*pricePanel.Dy()/4
+		drawLine(img, pricePanel.Min.X, y, pricePanel.Max.X, y, chartGrid)
+	}
+
+	for i, candle := range visible {
+		c := chartUp
+		if candle.Close < candle.Open {
+			c = chartDown
+		}
+		x := centerX(i)
+		drawLine(img, x, priceY(candle.High), x, priceY(candle.Low), c)
+
+		top, bottom := priceY(math.Max(candle.Open, candle.Close)), priceY(math.Min(candle.Open, candle.Close))
+		fillRect(img, image.Rect(x-bodyHalf, top, x+bodyHalf+1, bottom+1), c)
+
+		if !options.HideVolume && maxVolume > 0 {
+			height := int(candle.Volume / maxVolume * float64(volumePanel.Dy()))
+			faded := color.RGBA{c.R / 2, c.G / 2, c.B / 2, 0xFF}
+			fillRect(img, image.Rect(x-bodyHalf, volumePanel.Max.Y-height, x+bodyHalf+1, volumePanel.Max.Y), faded)
+		}
+	}
+
+	for _, overlay := range overlays {
+		prevX, prevY, started := 0, 0, false
+		for i, candle := range visible {
+			value, ok := overlay.values[candle.Timestamp]
+			if !ok {
+				started = false
+				continue
+			}
+			x, y := centerX(i), priceY(value)
+			if started {
+				drawLine(img, prevX, prevY, x, y, overlay.color)
+			}
+			prevX, prevY, started = x, y, true
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// fillRect fills a rectangle clipped to the image
+func fillRect(img *image.RGBA, rect image.Rectangle, c color.RGBA) {
+	draw.Draw(img, rect.Intersect(img.Bounds()), &image.Uniform{c}, image.Point{}, draw.Src)
+}
+
+// drawLine draws a one pixel line with Bresenham's algorithm
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		img.SetRGBA(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs returns the absolute value of an int
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,308 @@
+package techindicators
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"math"
+	"time"
+)
+
+// ChartSeries is an indicator line, with optional component lines, for PlotlySpec and EChartsSpec
+type ChartSeries struct {
+	Name       string
+	Points     []Point
+	Components []string // Point components drawn as extra lines, e.g. "upper" and "lower" for Bollinger Bands
+	Separate   bool     // Draw in its own pane below the price (oscillators such as RSI) instead of over the candles
+}
+
+// IndicatorSeries computes an indicator for a chart spec, drawing the listed components next to its value
+func IndicatorSeries(dataset []OHLCV, indicator Indicator, separate bool, components ...string) (ChartSeries, error) {
+	points, err := indicator.Compute(dataset)
+	if err != nil {
+		return ChartSeries{}, err
+	}
+	return ChartSeries{Name: indicator.Name(), Points: points, Components: components, Separate: separate}, nil
+}
+
+// ChartSpecOptions controls PlotlySpec and EChartsSpec
+type ChartSpecOptions struct {
+	Title      string
+	HideVolume bool // Omit the volume pane
+}
+
+// chartPane is one vertical section of a chart spec
+type chartPane struct {
+	weight float64
+	top    float64 // Fraction of the plot height from the top
+	height float64
+}
+
+// layoutChartPanes splits the plot height between the price pane (3 shares) and each lower pane (1 share)
+func layoutChartPanes(lower int) []chartPane {
+	panes := []chartPane{{weight: 3}}
+	for range lower {
+		panes = append(panes, chartPane{weight: 1})
+	}
+
+	const gap = 0.04
+	total := 0.0
+	for _, pane := range panes {
+		total += pane.weight
+	}
+	available := 1 - gap*float64(len(panes)-1)
+	top := 0.0
+	for i := range panes {
+		panes[i].top = top
+		panes[i].height = available * panes[i].weight / total
+		top += panes[i].height + gap
+	}
+	return panes
+}
+
+// chartSpecLine is a single line of a series, aligned to the dataset's timestamps
+type chartSpecLine struct {
+	name   string
+	values []any // float64, or nil where the indicator has no value
+	pane   int
+	color  color.RGBA
+}
+
+// chartSpecLines aligns every series and component to the candles and assigns panes and colors
+func chartSpecLines(dataset []OHLCV, series []ChartSeries, firstSeparatePane int) ([]chartSpecLine, int) {
+	index := make(map[time.Time]int, len(dataset))
+	for i, candle := range dataset {
+		index[candle.Timestamp] = i
+	}
+
+	var lines []chartSpecLine
+	pane := firstSeparatePane - 1
+	for _, s := range series {
+		seriesPane := 0
+		if s.Separate {
+			pane++
+			seriesPane = pane
+		}
+
+		names := append([]string{""}, s.Components...)
+		for _, component := range names {
+			values := make([]any, len(dataset))
+			for _, point := range s.Points {
+				i, ok := index[point.Timestamp]
+				if !ok {
+					continue
+				}
+				value := point.Value
+				if component != "" {
+					var found bool
+					if value, found = point.Components[component]; !found {
+						continue
+					}
+				}
+				if !math.IsNaN(value) && !math.IsInf(value, 0) {
+					values[i] = value
+				}
+			}
+
+			name := s.Name
+			if component != "" {
+				name += " " + component
+			}
+			lines = append(lines, chartSpecLine{
+				name:   name,
+				values: values,
+				pane:   seriesPane,
+				color:  chartLines[len(lines)%len(chartLines)],
+			})
+		}
+	}
+	return lines, pane + 1
+}
+
+// chartHex formats a color as #rrggbb
+func chartHex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// PlotlySpec returns a Plotly figure ({"data": [...], "layout": {...}}) with candlesticks, the indicator
+// series, and a volume pane, ready to pass to Plotly.newPlot on a web dashboard
+func PlotlySpec(dataset []OHLCV, series []ChartSeries, opts ChartSpecOptions) ([]byte, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	firstSeparate := 1
+	if !opts.HideVolume {
+		firstSeparate = 2
+	}
+	lines, paneCount := chartSpecLines(dataset, series, firstSeparate)
+	panes := layoutChartPanes(max(paneCount, firstSeparate) - 1)
+
+	x := make([]string, len(dataset))
+	open := make([]float64, len(dataset))
+	high := make([]float64, len(dataset))
+	low := make([]float64, len(dataset))
+	closes := make([]float64, len(dataset))
+	volume := make([]float64, len(dataset))
+	volumeColors := make([]string, len(dataset))
+	for i, candle := range dataset {
+		x[i] = candle.Timestamp.UTC().Format(time.RFC3339)
+		open[i], high[i], low[i], closes[i], volume[i] = candle.Open, candle.High, candle.Low, candle.Close, candle.Volume
+		volumeColors[i] = chartHex(chartUp)
+		if candle.Close < candle.Open {
+			volumeColors[i] = chartHex(chartDown)
+		}
+	}
+
+	axis := func(pane int) string {
+		if pane == 0 {
+			return "y"
+		}
+		return fmt.Sprintf("y%d", pane+1)
+	}
+
+	data := []map[string]any{{
+		"type":       "candlestick",
+		"name":       "Price",
+		"x":          x,
+		"open":       open,
+		"high":       high,
+		"low":        low,
+		"close":      closes,
+		"increasing": map[string]any{"line": map[string]any{"color": chartHex(chartUp)}},
+		"decreasing": map[string]any{"line": map[string]any{"color": chartHex(chartDown)}},
+	}}
+	if !opts.HideVolume {
+		data = append(data, map[string]any{
+			"type":   "bar",
+			"name":   "Volume",
+			"x":      x,
+			"y":      volume,
+			"yaxis":  "y2",
+			"marker": map[string]any{"color": volumeColors},
+		})
+	}
+	for _, line := range lines {
+		data = append(data, map[string]any{
+			"type":  "scatter",
+			"mode":  "lines",
+			"name":  line.name,
+			"x":     x,
+			"y":     line.values,
+			"yaxis": axis(line.pane),
+			"line":  map[string]any{"color": chartHex(line.color), "width": 1.5},
+		})
+	}
+
+	layout := map[string]any{
+		"title":      map[string]any{"text": opts.Title},
+		"showlegend": true,
+		"hovermode":  "x unified",
+		"xaxis":      map[string]any{"type": "date", "rangeslider": map[string]any{"visible": false}, "anchor": axis(len(panes) - 1)},
+	}
+	for i, pane := range panes {
+		name := "yaxis"
+		if i > 0 {
+			name = fmt.Sprintf("yaxis%d", i+1)
+		}
+		// Plotly domains run from the bottom of the plot
+		layout[name] = map[string]any{"domain": []float64{math.Max(0, 1-pane.top-pane.height), math.Min(1, 1-pane.top)}}
+	}
+
+	return json.Marshal(map[string]any{"data": data, "layout": layout})
+}
+
+// EChartsSpec returns an Apache ECharts option object with candlesticks, the indicator series, and a volume
+// pane on linked category axes, ready to pass to chart.setOption on a web dashboard
+func EChartsSpec(dataset []OHLCV, series []ChartSeries, opts ChartSpecOptions) ([]byte, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	firstSeparate := 1
+	if !opts.HideVolume {
+		firstSeparate = 2
+	}
+	lines, paneCount := chartSpecLines(dataset, series, firstSeparate)
+	panes := layoutChartPanes(max(paneCount, firstSeparate) - 1)
+
+	categories := make([]string, len(dataset))
+	candles := make([][4]float64, len(dataset))
+	volume := make([]map[string]any, len(dataset))
+	for i, candle := range dataset {
+		categories[i] = candle.Timestamp.UTC().Format(time.RFC3339)
+		candles[i] = [4]float64{candle.Open, candle.Close, candle.Low, candle.High} // ECharts order
+		barColor := chartUp
+		if candle.Close < candle.Open {
+			barColor = chartDown
+		}
+		volume[i] = map[string]any{"value": candle.Volume, "itemStyle": map[string]any{"color": chartHex(barColor)}}
+	}
+
+	// The plot area spans 8% to 92% of the container height
+	const plotTop, plotHeight = 8.0, 84.0
+	var grids, xAxes, yAxes []map[string]any
+	axisIndexes := make([]int, len(panes))
+	for i, pane := range panes {
+		axisIndexes[i] = i
+		grids = append(grids, map[string]any{
+			"left":   "8%",
+			"right":  "8%",
+			"top":    fmt.Sprintf("%.1f%%", plotTop+pane.top*plotHeight),
+			"height": fmt.Sprintf("%.1f%%", pane.height*plotHeight),
+		})
+		xAxes = append(xAxes, map[string]any{
+			"type":      "category",
+			"gridIndex": i,
+			"data":      categories,
+			"axisLabel": map[string]any{"show": i == len(panes)-1},
+		})
+		yAxes = append(yAxes, map[string]any{"scale": true, "gridIndex": i, "splitNumber": 2})
+	}
+
+	seriesSpecs := []map[string]any{{
+		"type": "candlestick",
+		"name": "Price",
+		"data": candles,
+		"itemStyle": map[string]any{
+			"color":        chartHex(chartUp),
+			"color0":       chartHex(chartDown),
+			"borderColor":  chartHex(chartUp),
+			"borderColor0": chartHex(chartDown),
+		},
+	}}
+	if !opts.HideVolume {
+		seriesSpecs = append(seriesSpecs, map[string]any{
+			"type":       "bar",
+			"name":       "Volume",
+			"data":       volume,
+			"xAxisIndex": 1,
+			"yAxisIndex": 1,
+		})
+	}
+	for _, line := range lines {
+		seriesSpecs = append(seriesSpecs, map[string]any{
+			"type":       "line",
+			"name":       line.name,
+			"data":       line.values,
+			"xAxisIndex": line.pane,
+			"yAxisIndex": line.pane,
+			"showSymbol": false,
+			"lineStyle":  map[string]any{"width": 1.5, "color": chartHex(line.color)},
+			"itemStyle":  map[string]any{"color": chartHex(line.color)},
+		})
+	}
+
+	option := map[string]any{
+		"title":       map[string]any{"text": opts.Title},
+		"legend":      map[string]any{"top": 0},
+		"tooltip":     map[string]any{"trigger": "axis", "axisPointer": map[string]any{"type": "cross"}},
+		"axisPointer": map[string]any{"link": []map[string]any{{"xAxisIndex": "all"}}},
+		"grid":        grids,
+		"xAxis":       xAxes,
+		"yAxis":       yAxes,
+		"dataZoom":    []map[string]any{{"type": "inside", "xAxisIndex": axisIndexes}},
+		"series":      seriesSpecs,
+	}
+	return json.Marshal(option)
+}
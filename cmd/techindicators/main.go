@@ -0,0 +1,317 @@
+// Command techindicators runs an indicator or the full analysis on a candle file or a live data source.
+//
+//	techindicators -file candles.csv rsi -param period=14
+//	techindicators -source binance -symbol BTCUSDT -interval 4h -format json ultimate
+//	techindicators list
+//
+// The first argument is a registered indicator name, "comprehensive", "ultimate", or "list".
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ti "github.com/luislaredovelazquez/techindicators"
+)
+
+// paramFlags collects repeated -param key=value flags
+type paramFlags ti.IndicatorParams
+
+func (p paramFlags) String() string {
+	pairs := make([]string, 0, len(p))
+	for key, value := range p {
+		pairs = append(pairs, key+"="+strconv.FormatFloat(value, 'g', -1, 64))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (p paramFlags) Set(s string) error {
+	key, raw, ok := strings.Cut(s, "=")
+	if !ok {
+		return fmt.Errorf("expected key=value, got %q", s)
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	p[key] = value
+	return nil
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "techindicators:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("techindicators", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: techindicators [flags] <indicator|comprehensive|ultimate|list>")
+		flags.PrintDefaults()
+	}
+
+	file := flags.String("file", "", "candle file (.csv, .json, or .parquet)")
+	source := flags.String("source", "", "data source to fetch from: binance, coinbase, kraken, or geckoterminal")
+	symbol := flags.String("symbol", "", "market symbol for -source")
+	interval := flags.String("interval", "1h", "candle interval for -source, e.g. 15m, 4h, 1d")
+	limit := flags.Int("limit", 500, "number of candles to fetch with -source")
+	format := flags.String("format", "table", "output format: table or json")
+	last := flags.Int("last", 20, "indicator rows to print, newest last (0 for all)")
+
+	params := paramFlags{}
+	flags.Var(params, "param", "indicator parameter as key=value (repeatable), e.g. -param period=14")
+
+	defaults := ti.DefaultAnalysisConfig()
+	config := defaults
+	flags.IntVar(&config.SMAPeriod, "sma", defaults.SMAPeriod, "analysis SMA period")
+	flags.IntVar(&config.BBPeriod, "bb", defaults.BBPeriod, "analysis Bollinger Bands period")
+	flags.Float64Var(&config.BBMultiplier, "bb-mult", defaults.BBMultiplier, "analysis Bollinger Bands multiplier")
+	flags.IntVar(&config.RSIPeriod, "rsi", defaults.RSIPeriod, "analysis RSI period")
+	flags.IntVar(&config.VMAPeriod, "vma", defaults.VMAPeriod, "analysis volume moving average period")
+	flags.IntVar(&config.VROCPeriod, "vroc", defaults.VROCPeriod, "analysis volume rate of change period")
+	flags.IntVar(&config.BuyVotes, "buy-votes", 0, "agreeing votes needed for BUY/SELL (default simple majority)")
+	flags.IntVar(&config.StrongVotes, "strong-votes", 0, "agreeing votes needed for STRONG BUY/SELL (default unanimity)")
+
+	// Flags may come before or after the name
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return errors.New("expected an indicator or analysis name")
+	}
+	name := flags.Arg(0)
+	if err := flags.Parse(flags.Args()[1:]); err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		return fmt.Errorf("unexpected arguments after %s: %s", name, strings.Join(flags.Args(), " "))
+	}
+	if *format != "table" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if name == "list" {
+		return listIndicators(out, *format)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	dataset, err := loadDataset(ctx, *file, *source, *symbol, *interval, *limit)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "comprehensive":
+		analysis, err := ti.ComprehensiveAnalysisContext(ctx, dataset, config)
+		if err != nil {
+			return err
+		}
+		return printResult(out, *format, analysis)
+	case "ultimate":
+		analysis, err := ti.UltimateAnalysisContext(ctx, dataset, config)
+		if err != nil {
+			return err
+		}
+		return printResult(out, *format, analysis)
+	}
+
+	indicator, err := ti.NewIndicator(name, ti.IndicatorParams(params))
+	if err != nil {
+		return err
+	}
+	points, err := indicator.Compute(dataset)
+	if err != nil {
+		return err
+	}
+	if *last > 0 && len(points) > *last {
+		points = points[len(points)-*last:]
+	}
+	if *format == "json" {
+		return printJSON(out, points)
+	}
+	return printPoints(out, indicator.Name(), points)
+}
+
+// loadDataset reads the candle file or fetches from the data source
+func loadDataset(ctx context.Context, file, source, symbol, interval string, limit int) ([]ti.OHLCV, error) {
+	switch {
+	case file != "" && source != "":
+		return nil, errors.New("use either -file or -source, not both")
+	case file != "":
+		return loadFile(file)
+	case source != "":
+		if symbol == "" {
+			return nil, errors.New("-source requires -symbol")
+		}
+		dataSource, err := ti.NewDataSource(source)
+		if err != nil {
+			return nil, err
+		}
+		duration, err := ti.ParseInterval(interval)
+		if err != nil {
+			return nil, err
+		}
+		return dataSource.FetchOHLCV(ctx, ti.FetchRequest{Symbol: symbol, Interval: duration, Limit: limit})
+	default:
+		return nil, errors.New("either -file or -source is required")
+	}
+}
+
+// loadFile reads candles by file extension
+func loadFile(path string) ([]ti.OHLCV, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ti.LoadOHLCVFromCSVFile(path, ti.CSVOptions{})
+	case ".parquet":
+		return ti.LoadOHLCVFromParquetFile(path, ti.CSVColumns{})
+	case ".json":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var dataset []ti.OHLCV
+		if err := json.Unmarshal(data, &dataset); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return dataset, nil
+	default:
+		return nil, fmt.Errorf("unsupported file type %q (want .csv, .json, or .parquet)", filepath.Ext(path))
+	}
+}
+
+// listIndicators prints the registered indicators and their default parameters
+func listIndicators(out io.Writer, format string) error {
+	registrations := ti.RegisteredIndicators()
+	if format == "json" {
+		type listed struct {
+			Name        string             `json:"name"`
+			Description string             `json:"description"`
+			Params      ti.IndicatorParams `json:"params"`
+		}
+		list := make([]listed, len(registrations))
+		for i, registration := range registrations {
+			list[i] = listed{registration.Name, registration.Description, registration.Params}
+		}
+		return printJSON(out, list)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPARAMS\tDESCRIPTION")
+	for _, registration := range registrations {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", registration.Name, paramFlags(registration.Params), registration.Description)
+	}
+	fmt.Fprintln(w, "comprehensive\t-sma -bb -bb-mult -rsi\tSMA, Bollinger Bands, and RSI vote")
+	fmt.Fprintln(w, "ultimate\t-sma -bb -bb-mult -rsi -vma -vroc\tComprehensive analysis with volume confirmation and rug pull risk")
+	return w.Flush()
+}
+
+// printPoints prints an indicator series with one column per component
+func printPoints(out io.Writer, name string, points []ti.Point) error {
+	componentSet := make(map[string]bool)
+	for _, point := range points {
+		for component := range point.Components {
+			componentSet[component] = true
+		}
+	}
+	components := make([]string, 0, len(componentSet))
+	for component := range componentSet {
+		components = append(components, component)
+	}
+	sort.Strings(components)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "TIMESTAMP\t%s\t", name)
+	for _, component := range components {
+		fmt.Fprintf(w, "%s\t", strings.ToUpper(component))
+	}
+	fmt.Fprintln(w)
+
+	for _, point := range points {
+		fmt.Fprintf(w, "%s\t%s\t", point.Timestamp.UTC().Format(time.RFC3339), formatValue(point.Value))
+		for _, component := range components {
+			value, ok := point.Components[component]
+			if !ok {
+				value = math.NaN()
+			}
+			fmt.Fprintf(w, "%s\t", formatValue(value))
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Flush()
+}
+
+// printResult prints an analysis as JSON or as an indented key/value table of its JSON fields
+func printResult(out io.Writer, format string, result any) error {
+	if format == "json" {
+		return printJSON(out, result)
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	printFields(w, fields, "")
+	return w.Flush()
+}
+
+// printFields writes nested objects with their keys prefixed by the parent's
+func printFields(w io.Writer, fields map[string]any, prefix string) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch value := fields[key].(type) {
+		case map[string]any:
+			printFields(w, value, prefix+key+".")
+		case []any:
+			encoded, _ := json.Marshal(value)
+			fmt.Fprintf(w, "%s%s\t%s\n", prefix, key, encoded)
+		case float64:
+			fmt.Fprintf(w, "%s%s\t%s\n", prefix, key, formatValue(value))
+		default:
+			fmt.Fprintf(w, "%s%s\t%v\n", prefix, key, value)
+		}
+	}
+}
+
+// printJSON writes indented JSON
+func printJSON(out io.Writer, v any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// formatValue formats a number for the table, leaving NaN cells blank
+func formatValue(value float64) string {
+	if math.IsNaN(value) {
+		return ""
+	}
+	return strconv.FormatFloat(value, 'f', 6, 64)
+}
@@ -0,0 +1,100 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// coinbasePageSize is the largest number of candles Coinbase returns per request
+const coinbasePageSize = 350
+
+// coinbaseGranularities maps candle intervals to Coinbase Advanced Trade granularity names
+var coinbaseGranularities = map[time.Duration]string{
+	time.Minute:      "ONE_MINUTE",
+	5 * time.Minute:  "FIVE_MINUTE",
+	15 * time.Minute: "FIFTEEN_MINUTE",
+	30 * time.Minute: "THIRTY_MINUTE",
+	time.Hour:        "ONE_HOUR",
+	2 * time.Hour:    "TWO_HOUR",
+	4 * time.Hour:    "FOUR_HOUR",
+	6 * time.Hour:    "SIX_HOUR",
+	24 * time.Hour:   "ONE_DAY",
+}
+
+// CoinbaseClient fetches candles from the public Coinbase Advanced Trade market data API
+type CoinbaseClient struct {
+	BaseURL    string       // Defaults to https://api.coinbase.com
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+}
+
+// NewCoinbaseClient creates a Coinbase Advanced Trade client for the public market endpoints
+func NewCoinbaseClient() *CoinbaseClient {
+	return &CoinbaseClient{BaseURL: "https://api.coinbase.com"}
+}
+
+// Name returns the data source name
+func (c *CoinbaseClient) Name() string {
+	return "coinbase"
+}
+
+// FetchOHLCV downloads candles for a product such as "BTC-USD". The API only serves fixed time windows,
+// so long histories are split into windows of 350 candles.
+func (c *CoinbaseClient) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	if req.Symbol == "" {
+		return nil, invalidParameter("product id is required")
+	}
+	granularity, err := sourceInterval(c.Name(), req.Interval, coinbaseGranularities)
+	if err != nil {
+		return nil, err
+	}
+	return fetchWindows(req, coinbasePageSize, func(start, end time.Time) ([]OHLCV, error) {
+		return c.candles(ctx, req.Symbol, granularity, start, end)
+	})
+}
+
+// coinbaseCandle is one candle as returned by Coinbase, with every field as a string
+type coinbaseCandle struct {
+	Start  json.RawMessage `json:"start"`
+	Low    json.RawMessage `json:"low"`
+	High   json.RawMessage `json:"high"`
+	Open   json.RawMessage `json:"open"`
+	Close  json.RawMessage `json:"close"`
+	Volume json.RawMessage `json:"volume"`
+}
+
+// candles requests the candles of one window, returned in ascending order
+func (c *CoinbaseClient) candles(ctx context.Context, product, granularity string, start, end time.Time) ([]OHLCV, error) {
+	query := url.Values{}
+	query.Set("granularity", granularity)
+	query.Set("start", strconv.FormatInt(start.Unix(), 10))
+	query.Set("end", strconv.FormatInt(end.Unix(), 10))
+	query.Set("limit", strconv.Itoa(coinbasePageSize))
+
+	var resp struct {
+		Candles []coinbaseCandle `json:"candles"`
+	}
+	endpoint := strings.TrimRight(c.BaseURL, "/") + "/api/v3/brokerage/market/products/" + url.PathEscape(strings.ToUpper(product)) + "/candles?" + query.Encode()
+	if err := getJSON(ctx, c.HTTPClient, c.Name(), endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	candles := make([]OHLCV, len(resp.Candles))
+	for i, raw := range resp.Candles {
+		candle, err := parseJSONRow([]json.RawMessage{raw.Start, raw.Open, raw.High, raw.Low, raw.Close, raw.Volume}, [6]int{0, 1, 2, 3, 4, 5}, time.Second)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name(), err)
+		}
+		candles[i] = candle
+	}
+
+	// Coinbase returns the newest candle first
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Timestamp.Before(candles[j].Timestamp) })
+	return candles, nil
+}
@@ -0,0 +1,190 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/JulianToledano/goingecko/v3/api"
+	"github.com/JulianToledano/goingecko/v3/api/assetPlatforms"
+	"github.com/JulianToledano/goingecko/v3/api/categories"
+	"github.com/JulianToledano/goingecko/v3/api/coins"
+	"github.com/JulianToledano/goingecko/v3/api/companies"
+	"github.com/JulianToledano/goingecko/v3/api/contract"
+	"github.com/JulianToledano/goingecko/v3/api/derivatives"
+	"github.com/JulianToledano/goingecko/v3/api/exchangeRates"
+	"github.com/JulianToledano/goingecko/v3/api/exchanges"
+	"github.com/JulianToledano/goingecko/v3/api/global"
+	"github.com/JulianToledano/goingecko/v3/api/nfts"
+	"github.com/JulianToledano/goingecko/v3/api/ping"
+	"github.com/JulianToledano/goingecko/v3/api/search"
+	"github.com/JulianToledano/goingecko/v3/api/simple"
+	"github.com/JulianToledano/goingecko/v3/api/trending"
+	geckohttp "github.com/JulianToledano/goingecko/v3/http"
+)
+
+// CoinGecko request budgets per minute by plan
+const (
+	coinGeckoPublicRate = 10
+	coinGeckoDemoRate   = 30
+	coinGeckoProRate    = 500
+)
+
+// CoinGeckoConfig configures a CoinGecko API client
+type CoinGeckoConfig struct {
+	APIKey            string       // Demo or Pro API key; empty uses the keyless public API
+	Pro               bool         // The key is a Pro key: use the Pro base URL and x-cg-pro-api-key header
+	BaseURL           string       // Overrides the plan's base URL, e.g. for a proxy or a test server
+	HTTPClient        *http.Client // Used as is when set; otherwise a client throttled to RequestsPerMinute with retries
+	RequestsPerMinute int          // Default 10 public, 30 demo, 500 pro
+}
+
+// NewCoinGeckoClient returns a CoinGecko client for the configuration, for FetchOHLCVFromCoinGeckoClient
+// or SetCoinGeckoClient
+func NewCoinGeckoClient(config CoinGeckoConfig) (*api.Client, error) {
+	if config.Pro && config.APIKey == "" {
+		return nil, invalidParameter("the coingecko pro API needs an API key")
+	}
+	if config.RequestsPerMinute < 0 {
+		return nil, invalidParameter("requests per minute must not be negative, got %d", config.RequestsPerMinute)
+	}
+
+	baseURL, rate, header := api.BaseURL, coinGeckoPublicRate, ""
+	switch {
+	case config.Pro:
+		baseURL, rate, header = api.ProBaseURL, coinGeckoProRate, "x-cg-pro-api-key"
+	case config.APIKey != "":
+		rate, header = coinGeckoDemoRate, "x-cg-demo-api-key"
+	}
+	if config.BaseURL != "" {
+		baseURL = strings.TrimRight(config.BaseURL, "/")
+	}
+	if config.RequestsPerMinute > 0 {
+		rate = config.RequestsPerMinute
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		var err error
+		if httpClient, err = NewRetryingHTTPClient(rate, time.Minute); err != nil {
+			return nil, err
+		}
+	}
+	if header == "" {
+		return newGeckoClient(geckohttp.NewClient(geckohttp.WithHttpClient(httpClient)), baseURL), nil
+	}
+	setKey := func(r *http.Request) { r.Header.Set(header, config.APIKey) }
+	return newGeckoClient(geckohttp.NewClient(geckohttp.WithHttpClient(httpClient), geckohttp.WithApiHeaderFn(setKey)), baseURL), nil
+}
+
+// coinGeckoClient holds the client set with SetCoinGeckoClient
+var coinGeckoClient atomic.Pointer[api.Client]
+
+// publicCoinGeckoClient is the shared keyless client, so concurrent callers in one process share the rate budget
+var publicCoinGeckoClient = sync.OnceValue(func() *api.Client {
+	client, _ := NewCoinGeckoClient(CoinGeckoConfig{})
+	return client
+})
+
+// SetCoinGeckoClient replaces the client used by FetchOHLCVFromCoinGecko, the MCP tools, and the Sharpe
+// ratio tool, e.g. with a Pro client from NewCoinGeckoClient; nil restores the throttled public client
+func SetCoinGeckoClient(client *api.Client) {
+	coinGeckoClient.Store(client)
+}
+
+// defaultCoinGeckoClient returns the client set with SetCoinGeckoClient or the public client
+func defaultCoinGeckoClient() *api.Client {
+	if client := coinGeckoClient.Load(); client != nil {
+		return client
+	}
+	return publicCoinGeckoClient()
+}
+
+// newGeckoClient assembles an API client on the given transport and base URL, like the library's own
+// constructors but without fixing the HTTP client
+func newGeckoClient(c *geckohttp.Client, url string) *api.Client {
+	return &api.Client{
+		PingClient:           ping.NewClient(c, url),
+		SimpleClient:         simple.NewClient(c, url),
+		CoinsClient:          coins.NewClient(c, url),
+		ContractClient:       contract.NewClient(c, url),
+		AssetPlatformsClient: assetPlatforms.NewClient(c, url),
+		CategoriesClient:     categories.NewClient(c, url),
+		ExchangesClient:      exchanges.NewClient(c, url),
+		DerivativesClient:    derivatives.NewClient(c, url),
+		NftsClient:           nfts.NewClient(c, url),
+		ExchangeRatesClient:  exchangeRates.NewClient(c, url),
+		SearchClient:         search.NewClient(c, url),
+		TrendingClient:       trending.NewClient(c, url),
+		GlobalClient:         global.NewClient(c, url),
+		CompaniesClient:      companies.NewClient(c, url),
+	}
+}
+
+// coinGeckoError converts the library's status errors to ErrHTTPStatus, so callers can branch on
+// rate limiting (429) the same way as for the other sources
+func coinGeckoError(err error) error {
+	var apiErr *geckohttp.APIError
+	if errors.As(err, &apiErr) {
+		body := apiErr.Body
+		if len(body) > maxErrorBody {
+			body = body[:maxErrorBody]
+		}
+		return ErrHTTPStatus{Source: "coingecko", StatusCode: apiErr.StatusCode, Body: string(body)}
+	}
+	return fmt.Errorf("coingecko: %w", err)
+}
+
+// FetchOHLCVFromCoinGecko fetches candles from the CoinGecko /coins/{id}/ohlc endpoint with the client set by
+// SetCoinGeckoClient, by default the public API throttled and retried on 429 and 5xx responses.
+// days is 1, 7, 14, 30, 90, 180, 365, or "max"; CoinGecko picks the candle size (30 minutes up to 2 days,
+// 4 hours up to 30 days, 4 days beyond). The endpoint has no volume, so Volume is 0: run the ultimate
+// analysis WithoutVolume on this data.
+func FetchOHLCVFromCoinGecko(ctx context.Context, coinID, vsCurrency, days string) ([]OHLCV, error) {
+	return FetchOHLCVFromCoinGeckoClient(ctx, defaultCoinGeckoClient(), coinID, vsCurrency, days)
+}
+
+// FetchOHLCVFromCoinGeckoClient is FetchOHLCVFromCoinGecko with a configured client, e.g. from NewCoinGeckoClient
+func FetchOHLCVFromCoinGeckoClient(ctx context.Context, client *api.Client, coinID, vsCurrency, days string) ([]OHLCV, error) {
+	if coinID == "" || vsCurrency == "" || days == "" {
+		return nil, invalidParameter("coin id, currency, and days are required")
+	}
+
+	rows, err := client.CoinsOhlc(ctx, coinID, vsCurrency, days)
+	if err != nil {
+		return nil, coinGeckoError(err)
+	}
+	if rows == nil || len(*rows) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	// Each row is [close time in ms, open, high, low, close]
+	candles := make([]OHLCV, 0, len(*rows))
+	for i, row := range *rows {
+		if len(row) < 5 {
+			return nil, fmt.Errorf("%w: coingecko row %d has %d fields", ErrInvalidDataset, i, len(row))
+		}
+		candles = append(candles, OHLCV{
+			Timestamp: time.UnixMilli(int64(row[0])).UTC(),
+			Open:      row[1],
+			High:      row[2],
+			Low:       row[3],
+			Close:     row[4],
+		})
+	}
+	candles = dedupeCandles(candles)
+
+	// Shift close times to open times like the other data sources, using the candle spacing
+	if len(candles) > 1 {
+		interval := candles[len(candles)-1].Timestamp.Sub(candles[len(candles)-2].Timestamp)
+		for i := range candles {
+			candles[i].Timestamp = candles[i].Timestamp.Add(-interval)
+		}
+	}
+	return candles, nil
+}
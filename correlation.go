@@ -0,0 +1,186 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// CorrelationMethod selects how return correlations are measured
+type CorrelationMethod string
+
+const (
+	PearsonCorrelation  CorrelationMethod = "pearson"  // Linear correlation of returns
+	SpearmanCorrelation CorrelationMethod = "spearman" // Rank correlation of returns, robust to outliers
+)
+
+// CorrelationMatrix represents pairwise return correlations between assets
+type CorrelationMatrix struct {
+	Symbols      []string          `json:"symbols"`      // Sorted symbols, indexing rows and columns
+	Values       [][]float64       `json:"values"`       // Values[i][j] is the correlation of Symbols[i] and Symbols[j]
+	Method       CorrelationMethod `json:"method"`       // pearson, spearman
+	Observations int               `json:"observations"` // Number of aligned returns per asset
+}
+
+// Get returns the correlation between two symbols
+func (m CorrelationMatrix) Get(a, b string) (float64, bool) {
+	i, j := -1, -1
+	for k, symbol := range m.Symbols {
+		if symbol == a {
+			i = k
+		}
+		if symbol == b {
+			j = k
+		}
+	}
+
+	if i < 0 || j < 0 {
+		return 0, false
+	}
+
+	return m.Values[i][j], true
+}
+
+// CalculateCorrelationMatrix calculates the return correlation matrix for a set of assets.
+// Only timestamps present in every series are used so all returns cover the same periods.
+func CalculateCorrelationMatrix(datasets map[string][]OHLCV, method CorrelationMethod) (CorrelationMatrix, error) {
+	if len(datasets) < 2 {
+		return CorrelationMatrix{}, invalidParameter("at least 2 assets are required")
+	}
+
+	if method != PearsonCorrelation && method != SpearmanCorrelation {
+		return CorrelationMatrix{}, invalidParameter("unknown correlation method: %s", method)
+	}
+
+	symbols, aligned := alignDatasets(datasets)
+	if len(aligned[symbols[0]]) < 3 {
+		return CorrelationMatrix{}, ErrInsufficientData{Need: 3, Have: len(aligned[symbols[0]])}
+	}
+
+	// Build aligned return series
+	returns := make([][]float64, len(symbols))
+	for k, symbol := range symbols {
+		series, err := ReturnsFromOHLCV(aligned[symbol], 1)
+		if err != nil {
+			return CorrelationMatrix{}, fmt.Errorf("returns for %s: %w", symbol, err)
+		}
+
+		if method == SpearmanCorrelation {
+			series = rankValues(series)
+		}
+		returns[k] = series
+	}
+
+	values := make([][]float64, len(symbols))
+	for i := range symbols {
+		values[i] = make([]float64, len(symbols))
+		values[i][i] = 1
+	}
+
+	for i := 0; i < len(symbols); i++ {
+		for j := i + 1; j < len(symbols); j++ {
+			corr := pearson(returns[i], returns[j])
+			values[i][j] = corr
+			values[j][i] = corr
+		}
+	}
+
+	return CorrelationMatrix{
+		Symbols:      symbols,
+		Values:       values,
+		Method:       method,
+		Observations: len(aligned[symbols[0]]) - 1,
+	}, nil
+}
+
+// alignDatasets returns the sorted symbols and each dataset restricted to the timestamps common to all of them
+func alignDatasets(datasets map[string][]OHLCV) ([]string, map[string][]OHLCV) {
+	symbols := make([]string, 0, len(datasets))
+	for symbol := range datasets {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	// Find timestamps common to every asset
+	counts := make(map[int64]int)
+	for _, symbol := range symbols {
+		seen := make(map[int64]bool, len(datasets[symbol]))
+		for _, candle := range datasets[symbol] {
+			ts := candle.Timestamp.UnixNano()
+			if !seen[ts] {
+				seen[ts] = true
+				counts[ts]++
+			}
+		}
+	}
+
+	var common []int64
+	for ts, count := range counts {
+		if count == len(symbols) {
+			common = append(common, ts)
+		}
+	}
+	sort.Slice(common, func(i, j int) bool { return common[i] < common[j] })
+
+	aligned := make(map[string][]OHLCV, len(symbols))
+	for _, symbol := range symbols {
+		candles := make(map[int64]OHLCV, len(datasets[symbol]))
+		for _, candle := range datasets[symbol] {
+			candles[candle.Timestamp.UnixNano()] = candle
+		}
+
+		series := make([]OHLCV, len(common))
+		for i, ts := range common {
+			series[i] = candles[ts]
+		}
+		aligned[symbol] = series
+	}
+
+	return symbols, aligned
+}
+
+// pearson computes the Pearson correlation of two equally sized series
+func pearson(x, y []float64) float64 {
+	meanX := average(x)
+	meanY := average(y)
+
+	var covariance, varianceX, varianceY float64
+	for i := range x {
+		diffX := x[i] - meanX
+		diffY := y[i] - meanY
+		covariance += diffX * diffY
+		varianceX += diffX * diffX
+		varianceY += diffY * diffY
+	}
+
+	if varianceX == 0 || varianceY == 0 {
+		return 0
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY)
+}
+
+// rankValues converts values to ranks, assigning tied values their average rank
+func rankValues(values []float64) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return values[order[i]] < values[order[j]] })
+
+	ranks := make([]float64, len(values))
+	for i := 0; i < len(order); {
+		j := i
+		for j+1 < len(order) && values[order[j+1]] == values[order[i]] {
+			j++
+		}
+
+		avgRank := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[order[k]] = avgRank
+		}
+		i = j + 1
+	}
+
+	return ranks
+}
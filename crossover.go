@@ -0,0 +1,69 @@
+package techindicators
+
+// CrossAbove returns the indices into a where a crosses above b: at or below b on the previous value and
+// above it on the current one. The series are aligned at their ends, as the moving averages of one dataset
+// with different periods are, so b may be shorter or longer than a. NaN values never cross.
+func CrossAbove(a, b []float64) []int {
+	return crossIndices(a, b, crossedAbove)
+}
+
+// CrossBelow returns the indices into a where a crosses below b: at or above b on the previous value and
+// below it on the current one. The series are aligned at their ends like in CrossAbove.
+func CrossBelow(a, b []float64) []int {
+	return crossIndices(a, b, crossedBelow)
+}
+
+// CrossAboveLevel returns the indices where the series crosses above a fixed level, e.g. an RSI above 50
+func CrossAboveLevel(values []float64, level float64) []int {
+	return crossIndices(values, values, func(previous, current, _, _ float64) bool {
+		return crossedAbove(previous, current, level, level)
+	})
+}
+
+// CrossBelowLevel returns the indices where the series crosses below a fixed level
+func CrossBelowLevel(values []float64, level float64) []int {
+	return crossIndices(values, values, func(previous, current, _, _ float64) bool {
+		return crossedBelow(previous, current, level, level)
+	})
+}
+
+// CrossAboveSMA is CrossAbove for moving average series, e.g. a fast SMA over a slow one
+func CrossAboveSMA(a, b []SMAResult) []int {
+	return CrossAbove(smaValues(a), smaValues(b))
+}
+
+// CrossBelowSMA is CrossBelow for moving average series
+func CrossBelowSMA(a, b []SMAResult) []int {
+	return CrossBelow(smaValues(a), smaValues(b))
+}
+
+// crossIndices applies a crossing test to every pair of consecutive aligned values
+func crossIndices(a, b []float64, crossed func(aPrevious, aCurrent, bPrevious, bCurrent float64) bool) []int {
+	offset := len(a) - len(b) // Index into a of b's first value
+	var indices []int
+	for i := max(1, offset+1); i < len(a); i++ {
+		if crossed(a[i-1], a[i], b[i-offset-1], b[i-offset]) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// crossedAbove reports whether a moved from at or below b to above it
+func crossedAbove(aPrevious, aCurrent, bPrevious, bCurrent float64) bool {
+	return aPrevious <= bPrevious && aCurrent > bCurrent
+}
+
+// crossedBelow reports whether a moved from at or above b to below it
+func crossedBelow(aPrevious, aCurrent, bPrevious, bCurrent float64) bool {
+	return aPrevious >= bPrevious && aCurrent < bCurrent
+}
+
+// smaValues returns the values of a moving average series
+func smaValues(results []SMAResult) []float64 {
+	values := make([]float64, len(results))
+	for i, r := range results {
+		values[i] = r.Value
+	}
+	return values
+}
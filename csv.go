@@ -0,0 +1,376 @@
+package techindicators
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Timestamp formats understood by CSVOptions besides Go time layouts
+const (
+	UnixSeconds      = "unix"
+	UnixMilliseconds = "unix_ms"
+)
+
+// CSVColumns maps OHLCV fields to CSV header names
+type CSVColumns struct {
+	Timestamp string
+	Open      string
+	High      string
+	Low       string
+	Close     string
+	Volume    string
+}
+
+// DefaultCSVColumns is the column mapping used when CSVOptions.Columns is empty
+var DefaultCSVColumns = CSVColumns{
+	Timestamp: "timestamp",
+	Open:      "open",
+	High:      "high",
+	Low:       "low",
+	Close:     "close",
+	Volume:    "volume",
+}
+
+// CSVOptions controls OHLCV CSV reading and writing
+type CSVOptions struct {
+	Columns CSVColumns // Header names, matched case-insensitively (defaults to DefaultCSVColumns)
+	// TimestampFormat is UnixSeconds, UnixMilliseconds, or a Go time layout such as time.RFC3339.
+	// When empty, reading accepts Unix seconds, Unix milliseconds, or RFC 3339, and writing uses RFC 3339.
+	TimestampFormat string
+	Comma           rune // Field delimiter (defaults to ',')
+	NoHeader        bool // Columns are positional: timestamp, open, high, low, close, volume
+}
+
+// names returns the configured column names in OHLCV order
+func (o CSVOptions) names() [6]string {
+	columns := o.Columns
+	if columns == (CSVColumns{}) {
+		columns = DefaultCSVColumns
+	}
+	return [6]string{columns.Timestamp, columns.Open, columns.High, columns.Low, columns.Close, columns.Volume}
+}
+
+// parseTimestamp parses a timestamp field according to the format
+func (o CSVOptions) parseTimestamp(field string) (time.Time, error) {
+	switch o.TimestampFormat {
+	case UnixSeconds:
+		seconds, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(0, int64(seconds*1e9)).UTC(), nil
+	case UnixMilliseconds:
+		millis, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMilli(millis).UTC(), nil
+	case "":
+		if number, err := strconv.ParseFloat(field, 64); err == nil {
+			if number > 1e12 { // Milliseconds since 2001
+				return time.UnixMilli(int64(number)).UTC(), nil
+			}
+			return time.Unix(0, int64(number*1e9)).UTC(), nil
+		}
+		return time.Parse(time.RFC3339, field)
+	default:
+		return time.Parse(o.TimestampFormat, field)
+	}
+}
+
+// formatTimestamp formats a timestamp according to the format
+func (o CSVOptions) formatTimestamp(t time.Time) string {
+	switch o.TimestampFormat {
+	case UnixSeconds:
+		return strconv.FormatInt(t.Unix(), 10)
+	case UnixMilliseconds:
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	case "":
+		return t.Format(time.RFC3339)
+	default:
+		return t.Format(o.TimestampFormat)
+	}
+}
+
+// LoadOHLCVFromCSV reads candles from CSV. Extra columns are ignored; a missing volume column reads as 0.
+func LoadOHLCVFromCSV(r io.Reader, opts CSVOptions) ([]OHLCV, error) {
+	reader := csv.NewReader(r)
+	if opts.Comma != 0 {
+		reader.Comma = opts.Comma
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+
+	// Column index per OHLCV field; -1 when absent
+	index := [6]int{0, 1, 2, 3, 4, 5}
+	if !opts.NoHeader {
+		if len(records) == 0 {
+			return nil, ErrEmptyDataset
+		}
+		header := records[0]
+		records = records[1:]
+
+		for f, name := range opts.names() {
+			index[f] = -1
+			for j, column := range header {
+				if strings.EqualFold(strings.TrimSpace(column), name) {
+					index[f] = j
+					break
+				}
+			}
+			if index[f] == -1 && f != 5 {
+				return nil, invalidParameter("CSV has no %q column", name)
+			}
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	dataset := make([]OHLCV, 0, len(records))
+	for i, record := range records {
+		var values [6]float64
+		var timestamp time.Time
+
+		for f, j := range index {
+			if j == -1 {
+				continue
+			}
+			if j >= len(record) {
+				return nil, invalidParameter("row %d: expected at least %d fields, got %d", i+1, j+1, len(record))
+			}
+
+			field := strings.TrimSpace(record[j])
+			if f == 0 {
+				timestamp, err = opts.parseTimestamp(field)
+				if err != nil {
+					return nil, invalidParameter("row %d: timestamp %q: %v", i+1, field, err)
+				}
+				continue
+			}
+
+			values[f], err = strconv.ParseFloat(field, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: row %d, column %d: %w", ErrInvalidPrice, i+1, j+1, err)
+			}
+		}
+
+		dataset = append(dataset, OHLCV{
+			Timestamp: timestamp,
+			Open:      values[1],
+			High:      values[2],
+			Low:       values[3],
+			Close:     values[4],
+			Volume:    values[5],
+		})
+	}
+
+	return dataset, nil
+}
+
+// LoadOHLCVFromCSVFile reads candles from a CSV file
+func LoadOHLCVFromCSVFile(path string, opts CSVOptions) ([]OHLCV, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadOHLCVFromCSV(file, opts)
+}
+
+// WriteCSV writes candles as CSV using the configured column names and timestamp format
+func WriteCSV(w io.Writer, dataset []OHLCV, opts CSVOptions) error {
+	writer := csv.NewWriter(w)
+	if opts.Comma != 0 {
+		writer.Comma = opts.Comma
+	}
+
+	if !opts.NoHeader {
+		names := opts.names()
+		if err := writer.Write(names[:]); err != nil {
+			return err
+		}
+	}
+
+	for _, candle := range dataset {
+		record := []string{
+			opts.formatTimestamp(candle.Timestamp),
+			formatCSVFloat(candle.Open),
+			formatCSVFloat(candle.High),
+			formatCSVFloat(candle.Low),
+			formatCSVFloat(candle.Close),
+			formatCSVFloat(candle.Volume),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteResultsCSV writes any result slice (SMAResult, RSIResult, BollingerBands, VolumeResult,
+// VolatilityResult, SharpeResult, UlcerIndexResult, ...) as CSV. Columns are the struct's scalar
+// fields named by their JSON tags; nested structs, slices, and maps are skipped.
+func WriteResultsCSV[T any](w io.Writer, results []T) error {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		return invalidParameter("results must be structs, got %s", typ)
+	}
+
+	var fields []int
+	var header []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() || !isCSVScalar(field.Type) {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, i)
+		header = append(header, name)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(fields))
+	for _, result := range results {
+		value := reflect.ValueOf(result)
+		for k, i := range fields {
+			record[k] = formatCSVValue(value.Field(i))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WritePointsCSV writes indicator points as timestamp, value, and one column per component (sorted by name)
+func WritePointsCSV(w io.Writer, points []Point) error {
+	componentSet := make(map[string]bool)
+	for _, point := range points {
+		for name := range point.Components {
+			componentSet[name] = true
+		}
+	}
+	components := make([]string, 0, len(componentSet))
+	for name := range componentSet {
+		components = append(components, name)
+	}
+	sort.Strings(components)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{"timestamp", "value"}, components...)); err != nil {
+		return err
+	}
+
+	for _, point := range points {
+		record := []string{point.Timestamp.Format(time.RFC3339), formatCSVFloat(point.Value)}
+		for _, name := range components {
+			value, ok := point.Components[name]
+			if !ok {
+				value = math.NaN()
+			}
+			record = append(record, formatCSVFloat(value))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteCSV writes the table with a timestamp column followed by its columns; NaN cells are left empty
+func (t SeriesTable) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(append([]string{"timestamp"}, t.Columns...)); err != nil {
+		return err
+	}
+
+	for i, row := range t.Rows {
+		record := make([]string, 0, len(row)+1)
+		record = append(record, t.Timestamps[i].Format(time.RFC3339))
+		for _, value := range row {
+			if math.IsNaN(value) {
+				record = append(record, "")
+			} else {
+				record = append(record, formatCSVFloat(value))
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// isCSVScalar reports whether a field type is written by WriteResultsCSV
+func isCSVScalar(t reflect.Type) bool {
+	if t == timeType {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// formatCSVValue formats a scalar field
+func formatCSVValue(v reflect.Value) string {
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339)
+	}
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return formatCSVFloat(v.Float())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		return v.String()
+	}
+}
+
+// formatCSVFloat formats a float with the shortest representation that round-trips
+func formatCSVFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
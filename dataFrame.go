@@ -0,0 +1,94 @@
+package techindicators
+
+import "time"
+
+// DataFrame is a column-oriented view of aligned candles and indicator series for stats and ML tooling.
+// Data holds one slice per column, all as long as Index; missing values are NaN.
+// Data can be handed to gota directly (dataframe.LoadMaps(frame.Records()), or series.New per column)
+// or flattened with Matrix for gonum.
+type DataFrame struct {
+	Index   []time.Time
+	Columns []string // Column order, as in the SeriesTable
+	Data    map[string][]float64
+}
+
+// DataFrame converts the table to columns
+func (t SeriesTable) DataFrame() DataFrame {
+	frame := DataFrame{
+		Index:   append([]time.Time(nil), t.Timestamps...),
+		Columns: append([]string(nil), t.Columns...),
+		Data:    make(map[string][]float64, len(t.Columns)),
+	}
+	for j, name := range t.Columns {
+		values := make([]float64, len(t.Rows))
+		for i, row := range t.Rows {
+			values[i] = row[j]
+		}
+		frame.Data[name] = values
+	}
+	return frame
+}
+
+// NewDataFrame computes each indicator on the dataset and returns the candles and series as columns,
+// like JoinIndicators
+func NewDataFrame(dataset []OHLCV, indicators ...Indicator) (DataFrame, error) {
+	table, err := JoinIndicators(dataset, indicators...)
+	if err != nil {
+		return DataFrame{}, err
+	}
+	return table.DataFrame(), nil
+}
+
+// Len returns the number of rows
+func (f DataFrame) Len() int {
+	return len(f.Index)
+}
+
+// Select returns a frame with only the named columns, in the given order
+func (f DataFrame) Select(columns ...string) (DataFrame, error) {
+	selected := DataFrame{Index: f.Index, Columns: columns, Data: make(map[string][]float64, len(columns))}
+	for _, name := range columns {
+		values, ok := f.Data[name]
+		if !ok {
+			return DataFrame{}, invalidParameter("data frame has no column %q", name)
+		}
+		selected.Data[name] = values
+	}
+	return selected, nil
+}
+
+// Records returns one map per row with a "timestamp" key (time.Time) plus every column, the shape gota's
+// dataframe.LoadMaps and JSON encoders expect
+func (f DataFrame) Records() []map[string]any {
+	records := make([]map[string]any, len(f.Index))
+	for i, ts := range f.Index {
+		record := make(map[string]any, len(f.Columns)+1)
+		record["timestamp"] = ts
+		for _, name := range f.Columns {
+			record[name] = f.Data[name][i]
+		}
+		records[i] = record
+	}
+	return records
+}
+
+// Matrix returns the named columns (all columns when none are given) as a row-major slice with the
+// number of columns, e.g. for mat.NewDense(len(data)/cols, cols, data)
+func (f DataFrame) Matrix(columns ...string) (data []float64, cols int, err error) {
+	if len(columns) == 0 {
+		columns = f.Columns
+	}
+	for _, name := range columns {
+		if _, ok := f.Data[name]; !ok {
+			return nil, 0, invalidParameter("data frame has no column %q", name)
+		}
+	}
+
+	data = make([]float64, 0, len(f.Index)*len(columns))
+	for i := range f.Index {
+		for _, name := range columns {
+			data = append(data, f.Data[name][i])
+		}
+	}
+	return data, len(columns), nil
+}
@@ -0,0 +1,254 @@
+package techindicators
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FetchRequest selects the candles to download from a data source
+type FetchRequest struct {
+	Symbol   string        // Source-specific market symbol, e.g. "BTCUSDT" on Binance
+	Interval time.Duration // Candle interval; each source supports a fixed set
+	Start    time.Time     // First candle to fetch; zero fetches the most recent Limit candles
+	End      time.Time     // Last candle to fetch; zero means now
+	Limit    int           // Maximum number of candles across all pages; 0 means one page
+}
+
+// DataSource downloads candles from a market data API, paginating as needed.
+// Candles are returned in ascending time order; the newest one may still be forming.
+type DataSource interface {
+	Name() string
+	FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error)
+}
+
+// defaultHTTPClient is used by data sources created without an explicit client
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// maxErrorBody bounds how much of an error response is kept in ErrHTTPStatus
+const maxErrorBody = 512
+
+// getJSON performs a GET request and decodes the JSON response into out
+func getJSON(ctx context.Context, client *http.Client, source, url string, header http.Header, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return ErrHTTPStatus{Source: source, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s: decoding response: %w", source, err)
+	}
+	return nil
+}
+
+// postJSON sends body as a JSON POST request; a non-2xx response becomes ErrHTTPStatus
+func postJSON(ctx context.Context, client *http.Client, source, url string, body any) error {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return postBody(ctx, client, source, url, "application/json", encoded)
+}
+
+// postBody sends a POST request with the given content type; a non-2xx response becomes ErrHTTPStatus
+func postBody(ctx context.Context, client *http.Client, source, url, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	return doRequest(client, source, req)
+}
+
+// doRequest sends a request whose response body is not needed; a non-2xx response becomes ErrHTTPStatus
+func doRequest(client *http.Client, source string, req *http.Request) error {
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return ErrHTTPStatus{Source: source, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// jsonFloat parses a JSON number or a quoted decimal string, as exchanges use both for prices
+func jsonFloat(raw json.RawMessage) (float64, error) {
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return 0, err
+		}
+		return strconv.ParseFloat(s, 64)
+	}
+	var f float64
+	err := json.Unmarshal(raw, &f)
+	return f, err
+}
+
+// jsonCount reads an optional integer field of an array-encoded candle, 0 when absent or malformed
+func jsonCount(row []json.RawMessage, index int) int {
+	if index >= len(row) {
+		return 0
+	}
+	count, err := jsonFloat(row[index])
+	if err != nil || count < 0 {
+		return 0
+	}
+	return int(count)
+}
+
+// parseJSONRow converts an array-encoded candle into OHLCV. indexes gives the positions of
+// timestamp, open, high, low, close, and volume; a negative volume index leaves Volume at 0.
+func parseJSONRow(row []json.RawMessage, indexes [6]int, timestampUnit time.Duration) (OHLCV, error) {
+	var values [6]float64
+	for field, index := range indexes {
+		if index < 0 {
+			continue
+		}
+		if index >= len(row) {
+			return OHLCV{}, fmt.Errorf("candle has %d fields, need index %d", len(row), index)
+		}
+		value, err := jsonFloat(row[index])
+		if err != nil {
+			return OHLCV{}, fmt.Errorf("candle field %d: %w", index, err)
+		}
+		values[field] = value
+	}
+
+	return OHLCV{
+		Timestamp: time.Unix(0, int64(values[0])*int64(timestampUnit)).UTC(),
+		Open:      values[1],
+		High:      values[2],
+		Low:       values[3],
+		Close:     values[4],
+		Volume:    values[5],
+	}, nil
+}
+
+// sourceInterval maps a candle interval to a source's interval name
+func sourceInterval(source string, interval time.Duration, names map[time.Duration]string) (string, error) {
+	name, ok := names[interval]
+	if !ok {
+		return "", invalidParameter("%s does not support a %s interval", source, interval)
+	}
+	return name, nil
+}
+
+// fetchWindows paginates a source that serves fixed time windows of at most pageSize candles:
+// backwards from End (or now) when Start is zero, otherwise forwards from Start until End or Limit is reached
+func fetchWindows(req FetchRequest, pageSize int, fetch func(start, end time.Time) ([]OHLCV, error)) ([]OHLCV, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = pageSize
+	}
+	end := req.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	window := time.Duration(pageSize-1) * req.Interval
+
+	var candles []OHLCV
+	if req.Start.IsZero() {
+		for len(candles) < limit {
+			page, err := fetch(end.Add(-window), end)
+			if err != nil {
+				return nil, err
+			}
+			if len(page) == 0 {
+				break // Before the market existed
+			}
+			candles = append(page, candles...)
+			end = page[0].Timestamp.Add(-req.Interval)
+		}
+		candles = dedupeCandles(candles)
+		if len(candles) > limit {
+			candles = candles[len(candles)-limit:]
+		}
+		return candles, nil
+	}
+
+	for start := req.Start; len(candles) < limit && !start.After(end); start = start.Add(window + req.Interval) {
+		page, err := fetch(start, minTime(start.Add(window), end))
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+	}
+	candles = dedupeCandles(candles)
+	if len(candles) > limit {
+		candles = candles[:limit]
+	}
+	return candles, nil
+}
+
+// dedupeCandles sorts candles by time and drops repeated timestamps from overlapping pages, keeping the later copy
+func dedupeCandles(candles []OHLCV) []OHLCV {
+	sort.SliceStable(candles, func(i, j int) bool { return candles[i].Timestamp.Before(candles[j].Timestamp) })
+	out := candles[:0]
+	for _, candle := range candles {
+		if len(out) > 0 && out[len(out)-1].Timestamp.Equal(candle.Timestamp) {
+			out[len(out)-1] = candle
+			continue
+		}
+		out = append(out, candle)
+	}
+	return out
+}
+
+// minTime returns the earlier of two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// NewDataSource returns the built-in data source with the given name ("binance", "coinbase", "kraken", or "geckoterminal")
+func NewDataSource(name string) (DataSource, error) {
+	switch strings.ToLower(name) {
+	case "binance":
+		return NewBinanceClient(), nil
+	case "coinbase":
+		return NewCoinbaseClient(), nil
+	case "kraken":
+		return NewKrakenClient(), nil
+	case "geckoterminal":
+		return NewGeckoTerminalClient(), nil
+	default:
+		return nil, invalidParameter("unknown data source %q", name)
+	}
+}
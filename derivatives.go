@@ -0,0 +1,209 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// FundingRate is a perpetual swap funding rate per funding interval, e.g. 0.0001 for 0.01% every 8 hours.
+// Positive rates mean longs pay shorts.
+type FundingRate struct {
+	Timestamp time.Time `json:"timestamp"`
+	Rate      float64   `json:"rate"`
+}
+
+// OpenInterest is the open interest of a perpetual swap, in contracts or quote currency
+type OpenInterest struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// DerivativesData holds the perpetual swap series of a token, each in ascending time order
+type DerivativesData struct {
+	Funding      []FundingRate  `json:"funding"`
+	OpenInterest []OpenInterest `json:"open_interest"`
+}
+
+// DerivativesResult represents funding and open interest analysis result at one open interest sample
+type DerivativesResult struct {
+	Timestamp       time.Time `json:"timestamp"`
+	OpenInterest    float64   `json:"open_interest"`
+	OIChange        float64   `json:"oi_change"`        // Relative open interest change over the period
+	FundingRate     float64   `json:"funding_rate"`     // Latest funding rate at the sample, 0 before the first
+	WeightedFunding float64   `json:"weighted_funding"` // Funding averaged over the period, weighted by open interest
+}
+
+// DerivativesAnalysis is the latest derivatives reading with its contrarian signal
+type DerivativesAnalysis struct {
+	DerivativesResult
+	Crowding   string  `json:"crowding"`   // longs, shorts, or none: the side paying extreme funding
+	Signal     Signal  `json:"signal"`     // Bearish when longs are crowded, bullish when shorts are, strong as open interest builds
+	Confidence float64 `json:"confidence"` // 0-1 scale
+}
+
+// DerivativesConfig configures AnalyzeDerivatives. Zero fields take the defaults in brackets.
+type DerivativesConfig struct {
+	Period         int     `json:"period"`          // Open interest samples the change and weighted funding span [8]
+	ExtremeFunding float64 `json:"extreme_funding"` // Weighted funding rate at which one side is crowded [0.001]
+	OIBuildup      float64 `json:"oi_buildup"`      // Open interest change that makes a crowded signal strong [0.1]
+	Weight         float64 `json:"weight"`          // Vote weight in the comprehensive analysis [1]
+}
+
+// DefaultDerivativesConfig returns the standard derivatives thresholds (0.1% funding per interval, 10% OI buildup)
+func DefaultDerivativesConfig() DerivativesConfig {
+	return DerivativesConfig{
+		Period:         8,
+		ExtremeFunding: 0.001,
+		OIBuildup:      0.1,
+		Weight:         1,
+	}
+}
+
+// withDefaults fills zero fields
+func (c DerivativesConfig) withDefaults() DerivativesConfig {
+	defaults := DefaultDerivativesConfig()
+	if c.Period == 0 {
+		c.Period = defaults.Period
+	}
+	if c.ExtremeFunding == 0 {
+		c.ExtremeFunding = defaults.ExtremeFunding
+	}
+	if c.OIBuildup == 0 {
+		c.OIBuildup = defaults.OIBuildup
+	}
+	if c.Weight == 0 {
+		c.Weight = defaults.Weight
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c DerivativesConfig) validate() error {
+	switch {
+	case c.Period < 1:
+		return invalidPeriod("derivatives period must be greater than 0, got %d", c.Period)
+	case c.ExtremeFunding <= 0 || c.OIBuildup < 0:
+		return invalidParameter("extreme funding must be greater than 0 and OI buildup not negative")
+	case c.Weight < 0:
+		return invalidParameter("derivatives weight must not be negative")
+	}
+	return nil
+}
+
+// validate checks that both series are in ascending time order with finite values and non-negative open interest
+func (d DerivativesData) validate() error {
+	for i, f := range d.Funding {
+		if math.IsNaN(f.Rate) || math.IsInf(f.Rate, 0) {
+			return invalidParameter("funding rate at %s is not finite", f.Timestamp)
+		}
+		if i > 0 && f.Timestamp.Before(d.Funding[i-1].Timestamp) {
+			return invalidParameter("funding rates must be in ascending time order")
+		}
+	}
+	for i, oi := range d.OpenInterest {
+		if math.IsNaN(oi.Value) || math.IsInf(oi.Value, 0) || oi.Value < 0 {
+			return invalidParameter("open interest at %s must be finite and not negative", oi.Timestamp)
+		}
+		if i > 0 && oi.Timestamp.Before(d.OpenInterest[i-1].Timestamp) {
+			return invalidParameter("open interest must be in ascending time order")
+		}
+	}
+	return nil
+}
+
+// Until returns the samples before t, so an analysis of past candles does not see later data
+func (d DerivativesData) Until(t time.Time) DerivativesData {
+	funding := sort.Search(len(d.Funding), func(i int) bool { return !d.Funding[i].Timestamp.Before(t) })
+	oi := sort.Search(len(d.OpenInterest), func(i int) bool { return !d.OpenInterest[i].Timestamp.Before(t) })
+	return DerivativesData{Funding: d.Funding[:funding], OpenInterest: d.OpenInterest[:oi]}
+}
+
+// CalculateDerivatives computes, at every open interest sample from index period on, the open interest
+// change over the period and the funding rate weighted by open interest over the period's samples. Each
+// sample takes the latest funding rate at or before it; samples before the first rate carry no weight.
+func CalculateDerivatives(data DerivativesData, period int) ([]DerivativesResult, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+	if len(data.OpenInterest) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if len(data.OpenInterest) <= period {
+		return nil, ErrInsufficientData{Need: period + 1, Have: len(data.OpenInterest)}
+	}
+
+	// Funding rate in force at each open interest sample
+	rates := make([]float64, len(data.OpenInterest))
+	known := make([]bool, len(data.OpenInterest))
+	next := 0
+	for i, oi := range data.OpenInterest {
+		for next < len(data.Funding) && !data.Funding[next].Timestamp.After(oi.Timestamp) {
+			next++
+		}
+		if next > 0 {
+			rates[i], known[i] = data.Funding[next-1].Rate, true
+		}
+	}
+
+	results := make([]DerivativesResult, 0, len(data.OpenInterest)-period)
+	for i := period; i < len(data.OpenInterest); i++ {
+		oi := data.OpenInterest[i]
+		result := DerivativesResult{Timestamp: oi.Timestamp, OpenInterest: oi.Value, FundingRate: rates[i]}
+		if base := data.OpenInterest[i-period].Value; base > 0 {
+			result.OIChange = oi.Value/base - 1
+		}
+
+		var weighted, weights float64
+		for j := i - period + 1; j <= i; j++ {
+			if known[j] {
+				weighted += rates[j] * data.OpenInterest[j].Value
+				weights += data.OpenInterest[j].Value
+			}
+		}
+		if weights > 0 {
+			result.WeightedFunding = weighted / weights
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// AnalyzeDerivatives reads the latest funding and open interest as a contrarian signal: weighted funding at
+// or above ExtremeFunding means crowded longs and is bearish, at or below its negative crowded shorts and
+// bullish. A crowded signal is strong when open interest also grew by OIBuildup, as more leverage is at
+// stake in the squeeze.
+func AnalyzeDerivatives(data DerivativesData, config DerivativesConfig) (DerivativesAnalysis, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return DerivativesAnalysis{}, err
+	}
+
+	results, err := CalculateDerivatives(data, config.Period)
+	if err != nil {
+		return DerivativesAnalysis{}, err
+	}
+
+	analysis := DerivativesAnalysis{DerivativesResult: results[len(results)-1], Crowding: "none", Signal: SignalNeutral}
+	funding := analysis.WeightedFunding
+	buildup := analysis.OIChange >= config.OIBuildup
+	switch {
+	case funding >= config.ExtremeFunding:
+		analysis.Crowding, analysis.Signal = "longs", SignalBearish
+		if buildup {
+			analysis.Signal = SignalStrongBearish
+		}
+	case funding <= -config.ExtremeFunding:
+		analysis.Crowding, analysis.Signal = "shorts", SignalBullish
+		if buildup {
+			analysis.Signal = SignalStrongBullish
+		}
+	default:
+		return analysis, nil
+	}
+	analysis.Confidence = clampScore(math.Abs(funding) / (2 * config.ExtremeFunding))
+	return analysis, nil
+}
@@ -0,0 +1,234 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// Pivot is a swing high or low of a series
+type Pivot struct {
+	Index     int       `json:"index"` // Dataset index of the candle
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// Divergence is a disagreement between two price swings and the oscillator swings at the same candles.
+// The start and end pivots are the endpoints of the lines drawn on the price and oscillator charts.
+type Divergence struct {
+	Type            string  `json:"type"`     // bullish (on swing lows) or bearish (on swing highs)
+	Strength        string  `json:"strength"` // regular (reversal) or hidden (continuation)
+	PriceStart      Pivot   `json:"price_start"`
+	PriceEnd        Pivot   `json:"price_end"`
+	OscillatorStart Pivot   `json:"oscillator_start"`
+	OscillatorEnd   Pivot   `json:"oscillator_end"`
+	Confidence      float64 `json:"confidence"` // 0-1 scale: the oscillator move relative to its range between the pivots
+}
+
+// DivergenceConfig configures DetectDivergences. Zero fields take the defaults in brackets.
+type DivergenceConfig struct {
+	PivotStrength int  `json:"pivot_strength"` // Candles on each side a swing high or low must exceed [3]
+	Tolerance     int  `json:"tolerance"`      // Candles an oscillator pivot may be off its price pivot [2]
+	MinSpan       int  `json:"min_span"`       // Fewest candles between the two pivots [5]
+	MaxSpan       int  `json:"max_span"`       // Most candles between the two pivots [60]
+	Hidden        bool `json:"hidden"`         // Also report hidden divergences
+}
+
+// DefaultDivergenceConfig returns the standard swing pivot settings
+func DefaultDivergenceConfig() DivergenceConfig {
+	return DivergenceConfig{
+		PivotStrength: 3,
+		Tolerance:     2,
+		MinSpan:       5,
+		MaxSpan:       60,
+	}
+}
+
+// withDefaults fills zero fields
+func (c DivergenceConfig) withDefaults() DivergenceConfig {
+	defaults := DefaultDivergenceConfig()
+	if c.PivotStrength == 0 {
+		c.PivotStrength = defaults.PivotStrength
+	}
+	if c.Tolerance == 0 {
+		c.Tolerance = defaults.Tolerance
+	}
+	if c.MinSpan == 0 {
+		c.MinSpan = defaults.MinSpan
+	}
+	if c.MaxSpan == 0 {
+		c.MaxSpan = defaults.MaxSpan
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c DivergenceConfig) validate() error {
+	switch {
+	case c.PivotStrength < 1:
+		return invalidPeriod("pivot strength must be greater than 0, got %d", c.PivotStrength)
+	case c.Tolerance < 0:
+		return invalidParameter("divergence tolerance must not be negative")
+	case c.MinSpan < 1 || c.MaxSpan < c.MinSpan:
+		return invalidPeriod("divergence spans must satisfy 0 < min span <= max span")
+	}
+	return nil
+}
+
+// SwingPivots returns the indices of the swing highs and lows of the values: a swing high is above the
+// strength values before it and at least as high as the strength values after it, so a flat top counts
+// once; swing lows mirror it. The latest strength values cannot be confirmed yet. NaN values are never pivots.
+func SwingPivots(values []float64, strength int) (highs, lows []int) {
+	for i := strength; i < len(values)-strength; i++ {
+		high, low := !math.IsNaN(values[i]), !math.IsNaN(values[i])
+		for j := i - strength; j <= i+strength && (high || low); j++ {
+			switch {
+			case j == i:
+			case j < i:
+				high = high && values[i] > values[j]
+				low = low && values[i] < values[j]
+			default:
+				high = high && values[i] >= values[j]
+				low = low && values[i] <= values[j]
+			}
+		}
+		if high {
+			highs = append(highs, i)
+		}
+		if low {
+			lows = append(lows, i)
+		}
+	}
+	return highs, lows
+}
+
+// DetectDivergences finds every divergence between the price swings and an oscillator series aligned with
+// the dataset at its end, e.g. an RSI, MACD histogram, CCI, or MFI. Swing highs of the candle highs pair
+// with oscillator swing highs within Tolerance candles and swing lows of the lows with oscillator lows.
+// Of two consecutive paired swings between MinSpan and MaxSpan candles apart:
+//   - regular bearish: price higher high, oscillator lower high
+//   - regular bullish: price lower low, oscillator higher low
+//   - hidden bearish: price lower high, oscillator higher high
+//   - hidden bullish: price higher low, oscillator lower low
+//
+// Results are ordered by the candle of their end pivot.
+func DetectDivergences(dataset []OHLCV, oscillator []float64, config DivergenceConfig) ([]Divergence, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if len(oscillator) > len(dataset) {
+		return nil, invalidParameter("oscillator has %d values for %d candles", len(oscillator), len(dataset))
+	}
+	if need := 2*config.PivotStrength + 1 + config.MinSpan; len(oscillator) < need {
+		return nil, ErrInsufficientData{Need: need, Have: len(oscillator)}
+	}
+
+	offset := len(dataset) - len(oscillator) // Dataset index of the first oscillator value
+	highs := make([]float64, len(oscillator))
+	lows := make([]float64, len(oscillator))
+	for i := range oscillator {
+		highs[i], lows[i] = dataset[offset+i].High, dataset[offset+i].Low
+	}
+
+	priceHighs, _ := SwingPivots(highs, config.PivotStrength)
+	_, priceLows := SwingPivots(lows, config.PivotStrength)
+	oscillatorHighs, oscillatorLows := SwingPivots(oscillator, config.PivotStrength)
+
+	pivot := func(i int, value float64) Pivot {
+		return Pivot{Index: offset + i, Timestamp: dataset[offset+i].Timestamp, Value: value}
+	}
+	var divergences []Divergence
+	scan := func(pricePivots, oscillatorPivots []int, prices []float64, bullish bool) {
+		lastPrice, lastOscillator := -1, -1 // Previous paired swing
+		for _, p := range pricePivots {
+			o, ok := nearestPivot(oscillatorPivots, p, config.Tolerance)
+			if !ok {
+				continue
+			}
+			if span := p - lastPrice; lastPrice >= 0 && span >= config.MinSpan && span <= config.MaxSpan {
+				d, ok := classifyDivergence(prices[lastPrice], prices[p], oscillator[lastOscillator], oscillator[o], bullish)
+				if ok && (d.Strength == "regular" || config.Hidden) {
+					d.PriceStart, d.PriceEnd = pivot(lastPrice, prices[lastPrice]), pivot(p, prices[p])
+					d.OscillatorStart, d.OscillatorEnd = pivot(lastOscillator, oscillator[lastOscillator]), pivot(o, oscillator[o])
+					d.Confidence = divergenceConfidence(oscillator[min(lastOscillator, o) : max(lastOscillator, o)+1])
+					divergences = append(divergences, d)
+				}
+			}
+			lastPrice, lastOscillator = p, o
+		}
+	}
+	scan(priceHighs, oscillatorHighs, highs, false)
+	scan(priceLows, oscillatorLows, lows, true)
+
+	// Interleave the bearish and bullish results by end pivot, bearish first on a tie
+	slices.SortStableFunc(divergences, func(a, b Divergence) int { return a.PriceEnd.Index - b.PriceEnd.Index })
+	return divergences, nil
+}
+
+// DetectIndicatorDivergences runs DetectDivergences on the primary values of any indicator, so oscillators
+// plugged in through the Indicator interface or the registry get divergence detection without their own code
+func DetectIndicatorDivergences(dataset []OHLCV, indicator Indicator, config DivergenceConfig) ([]Divergence, error) {
+	points, err := indicator.Compute(dataset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", indicator.Name(), err)
+	}
+	return DetectDivergences(dataset, PointSeries(points).Values(), config)
+}
+
+// DetectRSIDivergences is DetectDivergences on the RSI. Unlike DetectRSIDivergence, which only reads the
+// latest lookback candles, it returns every divergence between real swing pivots.
+func DetectRSIDivergences(dataset []OHLCV, period int, priceType PriceType, config DivergenceConfig) ([]Divergence, error) {
+	rsi, err := CalculateRSI(dataset, period, priceType)
+	if err != nil {
+		return nil, err
+	}
+	return DetectDivergences(dataset, RSISeries(rsi).Values(), config)
+}
+
+// nearestPivot returns the pivot closest to index within tolerance, preferring the earlier on a tie
+func nearestPivot(pivots []int, index, tolerance int) (int, bool) {
+	best, found := 0, false
+	for _, p := range pivots {
+		if distance := abs(p - index); distance <= tolerance && (!found || distance < abs(best-index)) {
+			best, found = p, true
+		}
+	}
+	return best, found
+}
+
+// classifyDivergence compares two price swings with their oscillator swings
+func classifyDivergence(priceStart, priceEnd, oscillatorStart, oscillatorEnd float64, bullish bool) (Divergence, bool) {
+	d := Divergence{Type: "bearish"}
+	if bullish {
+		d.Type = "bullish"
+		// Mirror the lows so the bearish rules apply
+		priceStart, priceEnd, oscillatorStart, oscillatorEnd = -priceStart, -priceEnd, -oscillatorStart, -oscillatorEnd
+	}
+	switch {
+	case priceEnd > priceStart && oscillatorEnd < oscillatorStart:
+		d.Strength = "regular"
+	case priceEnd < priceStart && oscillatorEnd > oscillatorStart:
+		d.Strength = "hidden"
+	default:
+		return Divergence{}, false
+	}
+	return d, true
+}
+
+// divergenceConfidence scales the move between the two oscillator pivots, the ends of the window, by the
+// oscillator's range within it
+func divergenceConfidence(window []float64) float64 {
+	low, high := window[0], window[0]
+	for _, v := range window {
+		low, high = math.Min(low, v), math.Max(high, v)
+	}
+	if high <= low {
+		return 0
+	}
+	return clampScore(math.Abs(window[len(window)-1]-window[0]) / (high - low))
+}
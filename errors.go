@@ -0,0 +1,84 @@
+package techindicators
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by the indicator functions. Wrapped errors carry extra
+// context in their message, so branch on them with errors.Is instead of comparing strings.
+var (
+	ErrEmptyDataset     = errors.New("dataset is empty")
+	ErrInvalidPeriod    = errors.New("invalid period")
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrInvalidPrice     = errors.New("invalid price")
+	ErrNoResults        = errors.New("no results calculated")
+	ErrMissingValue     = errors.New("missing value")
+	ErrInvalidDataset   = errors.New("invalid dataset")
+)
+
+// ErrInsufficientData is returned when the dataset is too short for the requested calculation.
+// errors.Is(err, ErrInsufficientData{}) matches any instance; use errors.As to read Need and Have.
+type ErrInsufficientData struct {
+	Need int // Minimum number of candles (or aligned timestamps) required
+	Have int // Number available
+}
+
+func (e ErrInsufficientData) Error() string {
+	return fmt.Sprintf("insufficient data: need at least %d candles, got %d", e.Need, e.Have)
+}
+
+// Is reports whether target is an ErrInsufficientData, regardless of its counts
+func (e ErrInsufficientData) Is(target error) bool {
+	switch target.(type) {
+	case ErrInsufficientData, *ErrInsufficientData:
+		return true
+	}
+	return false
+}
+
+// ErrHTTPStatus is returned by the data sources when an API answers with a non-2xx status.
+// errors.Is(err, ErrHTTPStatus{}) matches any instance; use errors.As to read the status code.
+type ErrHTTPStatus struct {
+	Source     string // Data source name, e.g. "binance"
+	StatusCode int
+	Body       string // Start of the response body, usually the API's error message
+}
+
+func (e ErrHTTPStatus) Error() string {
+	return fmt.Sprintf("%s: HTTP %d: %s", e.Source, e.StatusCode, e.Body)
+}
+
+// Is reports whether target is an ErrHTTPStatus, regardless of its fields
+func (e ErrHTTPStatus) Is(target error) bool {
+	switch target.(type) {
+	case ErrHTTPStatus, *ErrHTTPStatus:
+		return true
+	}
+	return false
+}
+
+// invalidPeriod wraps ErrInvalidPeriod with a description of the offending parameter
+func invalidPeriod(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidPeriod, fmt.Sprintf(format, args...))
+}
+
+// invalidParameter wraps ErrInvalidParameter with a description of the offending parameter
+func invalidParameter(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidParameter, fmt.Sprintf(format, args...))
+}
+
+// invalidPrice wraps ErrInvalidPrice with the location of the bad price
+func invalidPrice(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidPrice, fmt.Sprintf(format, args...))
+}
+
+// missingValue wraps ErrMissingValue with the location of the missing or degenerate value
+func missingValue(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrMissingValue, fmt.Sprintf(format, args...))
+}
+
+// noResults wraps ErrNoResults with the name of the calculation
+func noResults(name string) error {
+	return fmt.Errorf("%w for %s", ErrNoResults, name)
+}
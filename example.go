@@ -45,7 +45,7 @@ func ExampleUsage() {
 	fmt.Println("SMA-5 (Close Prices) - Last 3 values:")
 	for i := len(sma5) - 3; i < len(sma5); i++ {
 		if i >= 0 {
-			fmt.Printf("  %s: %.6f\n", sma5[i].Timestamp, sma5[i].Value)
+			fmt.Printf("  %s: %.6f\n", sma5[i].Timestamp.Format(time.RFC3339), sma5[i].Value)
 		}
 	}
 
@@ -88,7 +88,7 @@ func ExampleUsage() {
 	fmt.Println("Bollinger Bands (5 period, 2.0 multiplier) - Last 2 values:")
 	for i := len(bb) - 2; i < len(bb); i++ {
 		if i >= 0 {
-			fmt.Printf("  %s:\n", bb[i].Timestamp)
+			fmt.Printf("  %s:\n", bb[i].Timestamp.Format(time.RFC3339))
 			fmt.Printf("    Upper: %.6f, Middle: %.6f, Lower: %.6f\n", bb[i].UpperBand, bb[i].MiddleBand, bb[i].LowerBand)
 			fmt.Printf("    Band Width: %.4f\n", bb[i].BandWidth)
 		}
@@ -125,7 +125,7 @@ func ExampleUsage() {
 	fmt.Println("RSI (5 period) - Last 3 values:")
 	for i := len(rsi) - 3; i < len(rsi); i++ {
 		if i >= 0 {
-			fmt.Printf("  %s: %.2f (%s)\n", rsi[i].Timestamp, rsi[i].Value, rsi[i].Signal)
+			fmt.Printf("  %s: %.2f (%s)\n", rsi[i].Timestamp.Format(time.RFC3339), rsi[i].Value, rsi[i].Signal)
 		}
 	}
 
@@ -153,7 +153,7 @@ func ExampleUsage() {
 	for i := len(volumeResults) - 2; i < len(volumeResults); i++ {
 		if i >= 0 {
 			vol := volumeResults[i]
-			fmt.Printf("  %s:\n", vol.Timestamp)
+			fmt.Printf("  %s:\n", vol.Timestamp.Format(time.RFC3339))
 			fmt.Printf("    Volume: %.0f, VMA: %.0f, Ratio: %.2f\n", vol.Volume, vol.VMA, vol.Volume/vol.VMA)
 			fmt.Printf("    OBV: %.0f, VPT: %.2f, ADL: %.2f\n", vol.OBV, vol.VPT, vol.ADL)
 		}
@@ -181,7 +181,7 @@ func ExampleUsage() {
 	fmt.Printf("📊 SMA Signal: %s\n", comprehensive.SMASignal)
 	fmt.Printf("📈 Bollinger Signal: %s\n", comprehensive.BollingerSignal)
 	fmt.Printf("⚡ RSI Signal: %s\n", comprehensive.RSISignal)
-	fmt.Printf("\n🎯 FINAL SIGNAL: %s\n", comprehensive.FinalSignal)
+	fmt.Printf("\n🎯 FINAL SIGNAL: %s\n", comprehensive.FinalSignal.Label())
 	fmt.Printf("🔥 Confidence: %s\n", comprehensive.Confidence)
 	fmt.Printf("⚠️  Risk Level: %s\n\n", comprehensive.RiskLevel)
 
@@ -196,11 +196,11 @@ func ExampleUsage() {
 		return
 	}
 
-	fmt.Printf("📈 Technical Signal: %s (%s confidence)\n", ultimate.Technical.FinalSignal, ultimate.Technical.Confidence)
+	fmt.Printf("📈 Technical Signal: %s (%s confidence)\n", ultimate.Technical.FinalSignal.Label(), ultimate.Technical.Confidence)
 	fmt.Printf("📊 Volume Signal: %s\n", ultimate.Volume.Signal)
 	fmt.Printf("✅ Volume Confirms Technical: %v\n", ultimate.VolumeConfirm)
 	fmt.Printf("\n🚨 RUG PULL RISK: %s\n", ultimate.RugPullRisk)
-	fmt.Printf("🎯 ULTIMATE SIGNAL: %s\n", ultimate.FinalSignal)
+	fmt.Printf("🎯 ULTIMATE SIGNAL: %s\n", ultimate.FinalSignal.Label())
 	fmt.Printf("🔥 Final Confidence: %s\n", ultimate.Confidence)
 	fmt.Printf("⚠️  Final Risk Level: %s\n\n", ultimate.RiskLevel)
 
@@ -209,51 +209,8 @@ func ExampleUsage() {
 	// ===================
 	fmt.Println("=== 💰 TRADING RECOMMENDATIONS 💰 ===")
 
-	switch ultimate.FinalSignal {
-	case "STRONG BUY":
-		fmt.Println("🚀 EXECUTE AGGRESSIVE BUY")
-		fmt.Println("   ✅ All technical indicators bullish")
-		fmt.Println("   ✅ Volume confirms breakout/accumulation")
-		fmt.Println("   ✅ Low rug pull risk")
-		fmt.Printf("   📊 Position: 3-5%% of portfolio (Risk: %s)\n", ultimate.RiskLevel)
-
-	case "BUY":
-		fmt.Println("📈 EXECUTE STANDARD BUY")
-		fmt.Println("   ✅ Majority indicators bullish")
-		if ultimate.VolumeConfirm {
-			fmt.Println("   ✅ Volume supports the move")
-		} else {
-			fmt.Println("   ⚠️ Volume doesn't fully confirm")
-		}
-		fmt.Printf("   📊 Position: 2-3%% of portfolio (Risk: %s)\n", ultimate.RiskLevel)
-
-	case "STRONG SELL":
-		fmt.Println("🔴 EXECUTE IMMEDIATE SELL")
-		fmt.Println("   ❌ All indicators bearish")
-		fmt.Println("   ❌ High distribution detected")
-		fmt.Printf("   🚨 Rug Pull Risk: %s\n", ultimate.RugPullRisk)
-
-	case "SELL":
-		fmt.Println("📉 EXECUTE GRADUAL SELL")
-		fmt.Println("   ❌ Majority indicators bearish")
-		fmt.Printf("   🚨 Rug Pull Risk: %s\n", ultimate.RugPullRisk)
-
-	case "WAIT":
-		fmt.Println("⏳ WAIT FOR OPTIMAL ENTRY")
-		fmt.Println("   🔄 Low volatility squeeze detected")
-		fmt.Println("   📊 Prepare for potential breakout")
-		fmt.Println("   🔔 Set alerts for volume spikes")
-
-	case "SUSPICIOUS":
-		fmt.Println("🚨 SUSPICIOUS ACTIVITY DETECTED")
-		fmt.Println("   ⚠️ Low volume on price moves")
-		fmt.Println("   🤖 Potential bot manipulation")
-		fmt.Println("   🚫 AVOID TRADING")
-
-	default:
-		fmt.Println("🤔 MAINTAIN CURRENT POSITION")
-		fmt.Println("   📊 Mixed or weak signals")
-		fmt.Printf("   📈 Volume Confirmation: %v\n", ultimate.VolumeConfirm)
+	for _, line := range ultimate.Recommendation() {
+		fmt.Println(line)
 	}
 
 	fmt.Println("\n💡 Remember: This is a demonstration with limited data.")
@@ -265,14 +222,14 @@ func ExampleUsage() {
 // This helper function can be used to migrate existing data
 func ConvertStringDataToOHLCV(stringData [][]string) ([]OHLCV, error) {
 	if len(stringData) == 0 {
-		return nil, fmt.Errorf("empty dataset")
+		return nil, ErrEmptyDataset
 	}
 
 	var ohlcvData []OHLCV
 
 	for i, candle := range stringData {
 		if len(candle) < 6 {
-			return nil, fmt.Errorf("invalid candle at index %d: expected 6 fields, got %d", i, len(candle))
+			return nil, invalidParameter("candle at index %d: expected 6 fields, got %d", i, len(candle))
 		}
 
 		// Parse timestamp (assuming Unix timestamp)
@@ -284,33 +241,33 @@ func ConvertStringDataToOHLCV(stringData [][]string) ([]OHLCV, error) {
 			if t, err := time.Parse(time.RFC3339, candle[0]); err == nil {
 				timestamp = t
 			} else {
-				return nil, fmt.Errorf("invalid timestamp at index %d: %s", i, candle[0])
+				return nil, invalidParameter("timestamp at index %d: %s", i, candle[0])
 			}
 		}
 
 		open, err := parseFloat64(candle[1])
 		if err != nil {
-			return nil, fmt.Errorf("invalid open price at index %d: %w", i, err)
+			return nil, fmt.Errorf("%w: open price at index %d: %w", ErrInvalidPrice, i, err)
 		}
 
 		close, err := parseFloat64(candle[2])
 		if err != nil {
-			return nil, fmt.Errorf("invalid close price at index %d: %w", i, err)
+			return nil, fmt.Errorf("%w: close price at index %d: %w", ErrInvalidPrice, i, err)
 		}
 
 		high, err := parseFloat64(candle[3])
 		if err != nil {
-			return nil, fmt.Errorf("invalid high price at index %d: %w", i, err)
+			return nil, fmt.Errorf("%w: high price at index %d: %w", ErrInvalidPrice, i, err)
 		}
 
 		low, err := parseFloat64(candle[4])
 		if err != nil {
-			return nil, fmt.Errorf("invalid low price at index %d: %w", i, err)
+			return nil, fmt.Errorf("%w: low price at index %d: %w", ErrInvalidPrice, i, err)
 		}
 
 		volume, err := parseFloat64(candle[5])
 		if err != nil {
-			return nil, fmt.Errorf("invalid volume at index %d: %w", i, err)
+			return nil, fmt.Errorf("%w: volume at index %d: %w", ErrInvalidPrice, i, err)
 		}
 
 		ohlcvData = append(ohlcvData, OHLCV{
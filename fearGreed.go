@@ -0,0 +1,273 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+// FearGreedComponent identifies one input of the Fear & Greed index
+type FearGreedComponent string
+
+const (
+	FearGreedVolatility    FearGreedComponent = "volatility"     // Low volatility percentile is greed, high is fear
+	FearGreedMomentum      FearGreedComponent = "momentum"       // RSI
+	FearGreedVolumeBreadth FearGreedComponent = "volume_breadth" // Share of the volume traded on up candles
+	FearGreedHighs         FearGreedComponent = "distance_highs" // Position of the close between the recent low and high
+)
+
+// fearGreedComponents lists the components in the order they are computed
+var fearGreedComponents = []FearGreedComponent{FearGreedVolatility, FearGreedMomentum, FearGreedVolumeBreadth, FearGreedHighs}
+
+// FearGreedLabel classifies a Fear & Greed value
+type FearGreedLabel string
+
+const (
+	ExtremeFearMood  FearGreedLabel = "extreme_fear"  // Below 25
+	FearMood         FearGreedLabel = "fear"          // 25 to below 45
+	NeutralMood      FearGreedLabel = "neutral"       // 45 to 55
+	GreedMood        FearGreedLabel = "greed"         // Above 55 to 75
+	ExtremeGreedMood FearGreedLabel = "extreme_greed" // Above 75
+)
+
+// FearGreedIndex is a 0-100 sentiment reading, 0 extreme fear and 100 extreme greed
+type FearGreedIndex struct {
+	Timestamp  time.Time                      `json:"timestamp"`
+	Value      float64                        `json:"value"`
+	Label      FearGreedLabel                 `json:"label"`
+	Components map[FearGreedComponent]float64 `json:"components"`       // 0-100 score per included component
+	Assets     map[string]FearGreedIndex      `json:"assets,omitempty"` // Per-symbol readings of a basket
+}
+
+// FearGreedConfig configures CalculateFearGreed. Zero fields take the defaults in brackets.
+type FearGreedConfig struct {
+	VolatilityWindow   int       `json:"volatility_window"`   // Returns per realized volatility reading [14]
+	VolatilityLookback int       `json:"volatility_lookback"` // Readings the volatility percentile ranks against [60]
+	RSIPeriod          int       `json:"rsi_period"`          // [14]
+	BreadthWindow      int       `json:"breadth_window"`      // Candles whose up and down volume is compared [14]
+	HighsLookback      int       `json:"highs_lookback"`      // Candles spanning the recent low and high [30]
+	PriceType          PriceType `json:"price_type"`
+
+	// Weight per component; missing components weigh 1 and a weight of 0 leaves a component out
+	Weights map[FearGreedComponent]float64 `json:"weights,omitempty"`
+}
+
+// DefaultFearGreedConfig returns the standard configuration with equally weighted components
+func DefaultFearGreedConfig() FearGreedConfig {
+	return FearGreedConfig{
+		VolatilityWindow:   14,
+		VolatilityLookback: 60,
+		RSIPeriod:          14,
+		BreadthWindow:      14,
+		HighsLookback:      30,
+		PriceType:          ClosePrice,
+	}
+}
+
+// withDefaults fills zero fields
+func (c FearGreedConfig) withDefaults() FearGreedConfig {
+	defaults := DefaultFearGreedConfig()
+	if c.VolatilityWindow == 0 {
+		c.VolatilityWindow = defaults.VolatilityWindow
+	}
+	if c.VolatilityLookback == 0 {
+		c.VolatilityLookback = defaults.VolatilityLookback
+	}
+	if c.RSIPeriod == 0 {
+		c.RSIPeriod = defaults.RSIPeriod
+	}
+	if c.BreadthWindow == 0 {
+		c.BreadthWindow = defaults.BreadthWindow
+	}
+	if c.HighsLookback == 0 {
+		c.HighsLookback = defaults.HighsLookback
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c FearGreedConfig) validate() error {
+	switch {
+	case c.VolatilityWindow < 2 || c.VolatilityLookback < 2:
+		return invalidPeriod("fear and greed volatility window and lookback must be at least 2")
+	case c.RSIPeriod < 1 || c.BreadthWindow < 1 || c.HighsLookback < 1:
+		return invalidPeriod("fear and greed periods must be greater than 0")
+	}
+
+	for component, weight := range c.Weights {
+		if !slices.Contains(fearGreedComponents, component) {
+			return invalidParameter("unknown fear and greed component %q", component)
+		}
+		if weight < 0 {
+			return invalidParameter("fear and greed weight of %s must not be negative", component)
+		}
+	}
+	total := 0.0
+	for _, component := range fearGreedComponents {
+		total += c.weight(component)
+	}
+	if total == 0 {
+		return invalidParameter("fear and greed weights sum to zero")
+	}
+	return nil
+}
+
+// weight returns the weight of a component
+func (c FearGreedConfig) weight(component FearGreedComponent) float64 {
+	if weight, ok := c.Weights[component]; ok {
+		return weight
+	}
+	return 1
+}
+
+// minCandles is the dataset length CalculateFearGreed needs for the included components
+func (c FearGreedConfig) minCandles() int {
+	required := 0
+	for component, need := range map[FearGreedComponent]int{
+		FearGreedVolatility:    c.VolatilityWindow + c.VolatilityLookback,
+		FearGreedMomentum:      c.RSIPeriod + 1,
+		FearGreedVolumeBreadth: c.BreadthWindow,
+		FearGreedHighs:         c.HighsLookback,
+	} {
+		if c.weight(component) > 0 {
+			required = max(required, need)
+		}
+	}
+	return required
+}
+
+// CalculateFearGreed computes a Fear & Greed index for the latest candle in the style of the crypto Fear &
+// Greed index, from the package's own metrics scored 0-100: the inverted percentile of realized volatility,
+// the RSI, the share of volume on up candles, and the close's position between the recent low and high.
+// The index is their weighted average.
+func CalculateFearGreed(dataset []OHLCV, config FearGreedConfig) (FearGreedIndex, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return FearGreedIndex{}, err
+	}
+	if len(dataset) == 0 {
+		return FearGreedIndex{}, ErrEmptyDataset
+	}
+	if need := config.minCandles(); len(dataset) < need {
+		return FearGreedIndex{}, ErrInsufficientData{Need: need, Have: len(dataset)}
+	}
+
+	index := FearGreedIndex{Timestamp: dataset[len(dataset)-1].Timestamp, Components: make(map[FearGreedComponent]float64)}
+	for _, component := range fearGreedComponents {
+		if config.weight(component) == 0 {
+			continue
+		}
+		score, err := fearGreedScore(dataset, component, config)
+		if err != nil {
+			return FearGreedIndex{}, err
+		}
+		index.Components[component] = score
+	}
+	index.finish(config)
+	return index, nil
+}
+
+// FearGreed computes the Fear & Greed index of the basket: each component is the weighted average of the
+// assets' component scores, and Assets holds the per-symbol readings. The timestamp is the latest candle
+// of any asset.
+func (p Portfolio) FearGreed(config FearGreedConfig) (FearGreedIndex, error) {
+	weights, err := p.normalizedWeights()
+	if err != nil {
+		return FearGreedIndex{}, err
+	}
+	config = config.withDefaults()
+
+	basket := FearGreedIndex{Components: make(map[FearGreedComponent]float64), Assets: make(map[string]FearGreedIndex, len(weights))}
+	for symbol, weight := range weights {
+		index, err := CalculateFearGreed(p.Assets[symbol], config)
+		if err != nil {
+			return FearGreedIndex{}, fmt.Errorf("fear and greed for %s: %w", symbol, err)
+		}
+		basket.Assets[symbol] = index
+		for component, score := range index.Components {
+			basket.Components[component] += weight * score
+		}
+		if index.Timestamp.After(basket.Timestamp) {
+			basket.Timestamp = index.Timestamp
+		}
+	}
+	basket.finish(config)
+	return basket, nil
+}
+
+// finish sets the value and label from the component scores
+func (f *FearGreedIndex) finish(config FearGreedConfig) {
+	var sum, weights float64
+	for _, component := range fearGreedComponents {
+		if score, ok := f.Components[component]; ok {
+			sum += config.weight(component) * score
+			weights += config.weight(component)
+		}
+	}
+	if weights > 0 {
+		f.Value = sum / weights
+	}
+
+	switch {
+	case f.Value < 25:
+		f.Label = ExtremeFearMood
+	case f.Value < 45:
+		f.Label = FearMood
+	case f.Value <= 55:
+		f.Label = NeutralMood
+	case f.Value <= 75:
+		f.Label = GreedMood
+	default:
+		f.Label = ExtremeGreedMood
+	}
+}
+
+// fearGreedScore scores one component of the latest candle 0-100
+func fearGreedScore(dataset []OHLCV, component FearGreedComponent, config FearGreedConfig) (float64, error) {
+	latest := dataset[len(dataset)-1]
+	switch component {
+	case FearGreedVolatility:
+		regime, err := GetCurrentVolatilityRegime(dataset, config.VolatilityWindow, config.VolatilityLookback)
+		if err != nil {
+			return 0, err
+		}
+		return 100 - regime.Percentile, nil
+
+	case FearGreedMomentum:
+		rsi, err := GetLatestRSI(dataset, config.RSIPeriod, config.PriceType)
+		if err != nil {
+			return 0, err
+		}
+		return rsi.Value, nil
+
+	case FearGreedVolumeBreadth:
+		var up, total float64
+		for _, candle := range dataset[len(dataset)-config.BreadthWindow:] {
+			if math.IsNaN(candle.Volume) {
+				continue
+			}
+			total += candle.Volume
+			switch {
+			case candle.Close > candle.Open:
+				up += candle.Volume
+			case candle.Close == candle.Open:
+				up += candle.Volume / 2
+			}
+		}
+		if total <= 0 {
+			return 50, nil
+		}
+		return 100 * up / total, nil
+
+	default:
+		low, high := latest.Low, latest.High
+		for _, candle := range dataset[len(dataset)-config.HighsLookback:] {
+			low, high = min(low, candle.Low), max(high, candle.High)
+		}
+		if high <= low {
+			return 50, nil
+		}
+		return clampScore((latest.ExtractPrice(config.PriceType)-low)/(high-low)) * 100, nil
+	}
+}
@@ -0,0 +1,116 @@
+package techindicators
+
+import (
+	"fmt"
+	"time"
+)
+
+// GapFillStrategy selects how FillGaps replaces missing candles
+type GapFillStrategy string
+
+const (
+	ForwardFillGaps  GapFillStrategy = "forward_fill" // Repeat the previous close as a flat candle with zero volume
+	InterpolateGaps  GapFillStrategy = "interpolate"  // Linearly interpolate price between the surrounding closes, zero volume
+	LeaveAndFlagGaps GapFillStrategy = "leave"        // Keep the dataset as is and only report the gaps
+)
+
+// Gap describes a run of missing candles between two consecutive candles of a dataset
+type Gap struct {
+	Index   int       `json:"index"`   // Index of the candle right after the gap in the original dataset
+	From    time.Time `json:"from"`    // Timestamp of the last candle before the gap
+	To      time.Time `json:"to"`      // Timestamp of the first candle after the gap
+	Missing int       `json:"missing"` // Number of candles missing at the expected interval
+}
+
+// DetectGaps finds places where consecutive candles are further apart than the interval.
+// A zero interval uses the dataset's most common spacing. Timestamps must be strictly increasing.
+func DetectGaps(dataset []OHLCV, interval time.Duration) ([]Gap, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if interval < 0 {
+		return nil, invalidParameter("interval must not be negative")
+	}
+
+	if interval == 0 {
+		interval = dominantSpacing(dataset)
+		if interval == 0 {
+			return nil, nil // Fewer than two distinct timestamps: nothing to compare
+		}
+	}
+
+	var gaps []Gap
+	for i := 1; i < len(dataset); i++ {
+		delta := dataset[i].Timestamp.Sub(dataset[i-1].Timestamp)
+		if delta <= 0 {
+			return nil, fmt.Errorf("%w: timestamp at index %d is not after the previous candle", ErrInvalidDataset, i)
+		}
+
+		// Candles that would fit strictly between the two timestamps
+		if missing := int((delta - 1) / interval); missing > 0 {
+			gaps = append(gaps, Gap{
+				Index:   i,
+				From:    dataset[i-1].Timestamp,
+				To:      dataset[i].Timestamp,
+				Missing: missing,
+			})
+		}
+	}
+
+	return gaps, nil
+}
+
+// FillGaps detects gaps (see DetectGaps) and fills them with synthetic candles at the interval so that
+// indicator periods line up with wall-clock time. It returns the filled dataset and the gaps that were found;
+// with LeaveAndFlagGaps the dataset is returned unchanged. The input slice is never modified.
+func FillGaps(dataset []OHLCV, interval time.Duration, strategy GapFillStrategy) ([]OHLCV, []Gap, error) {
+	if strategy != ForwardFillGaps && strategy != InterpolateGaps && strategy != LeaveAndFlagGaps {
+		return nil, nil, invalidParameter("unknown gap fill strategy: %s", strategy)
+	}
+
+	if interval == 0 && len(dataset) > 1 {
+		interval = dominantSpacing(dataset)
+	}
+
+	gaps, err := DetectGaps(dataset, interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(gaps) == 0 || strategy == LeaveAndFlagGaps {
+		return dataset, gaps, nil
+	}
+
+	missing := 0
+	for _, gap := range gaps {
+		missing += gap.Missing
+	}
+
+	filled := make([]OHLCV, 0, len(dataset)+missing)
+	next := 0
+	for i, candle := range dataset {
+		if next < len(gaps) && gaps[next].Index == i {
+			prev := dataset[i-1]
+			for k := 1; k <= gaps[next].Missing; k++ {
+				price := prev.Close
+				if strategy == InterpolateGaps {
+					fraction := float64(k) / float64(gaps[next].Missing+1)
+					price = prev.Close + fraction*(candle.Close-prev.Close)
+				}
+
+				filled = append(filled, OHLCV{
+					Timestamp: prev.Timestamp.Add(time.Duration(k) * interval),
+					Open:      price,
+					High:      price,
+					Low:       price,
+					Close:     price,
+				})
+			}
+			next++
+		}
+		filled = append(filled, candle)
+	}
+
+	return filled, gaps, nil
+}
@@ -0,0 +1,141 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// geckoTerminalPageSize is the largest number of candles GeckoTerminal returns per request
+const geckoTerminalPageSize = 1000
+
+// geckoTerminalTimeframes maps candle intervals to GeckoTerminal "timeframe:aggregate" pairs
+var geckoTerminalTimeframes = map[time.Duration]string{
+	time.Minute:      "minute:1",
+	5 * time.Minute:  "minute:5",
+	15 * time.Minute: "minute:15",
+	time.Hour:        "hour:1",
+	4 * time.Hour:    "hour:4",
+	12 * time.Hour:   "hour:12",
+	24 * time.Hour:   "day:1",
+}
+
+// GeckoTerminalClient fetches DEX pool candles from the public GeckoTerminal API, covering tokens
+// that only trade on decentralized exchanges
+type GeckoTerminalClient struct {
+	BaseURL    string       // Defaults to https://api.geckoterminal.com/api/v2
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+	Currency   string       // Price currency: "usd" (default) or "token" for the quote token
+}
+
+// NewGeckoTerminalClient creates a GeckoTerminal client quoting prices in USD
+func NewGeckoTerminalClient() *GeckoTerminalClient {
+	return &GeckoTerminalClient{BaseURL: "https://api.geckoterminal.com/api/v2", Currency: "usd"}
+}
+
+// Name returns the data source name
+func (c *GeckoTerminalClient) Name() string {
+	return "geckoterminal"
+}
+
+// FetchOHLCV downloads pool candles. The symbol is "<network>/<pool address>", e.g. "solana/<address>"
+// or "eth/0x...". Pages are followed backwards from End until Limit candles or Start is reached.
+func (c *GeckoTerminalClient) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	network, pool, ok := strings.Cut(req.Symbol, "/")
+	if !ok || network == "" || pool == "" {
+		return nil, invalidParameter("symbol must be <network>/<pool address>, got %q", req.Symbol)
+	}
+	timeframe, err := sourceInterval(c.Name(), req.Interval, geckoTerminalTimeframes)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = geckoTerminalPageSize
+		if !req.Start.IsZero() {
+			limit = math.MaxInt // Bounded by Start instead
+		}
+	}
+
+	var candles []OHLCV
+	before := req.End
+	for len(candles) < limit {
+		size := min(limit-len(candles), geckoTerminalPageSize)
+		page, err := c.ohlcv(ctx, network, pool, timeframe, before, size)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) == 0 || (!before.IsZero() && !page[0].Timestamp.Before(before)) {
+			break
+		}
+		candles = append(page, candles...)
+		before = page[0].Timestamp
+		if len(page) < size || (!req.Start.IsZero() && before.Before(req.Start)) {
+			break
+		}
+	}
+
+	candles = dedupeCandles(candles)
+	if !req.Start.IsZero() {
+		first := 0
+		for first < len(candles) && candles[first].Timestamp.Before(req.Start) {
+			first++
+		}
+		candles = candles[first:]
+	}
+	if len(candles) > limit {
+		candles = candles[len(candles)-limit:]
+	}
+	return candles, nil
+}
+
+// FetchDEXOHLCV fetches the most recent USD candles of a DEX pool from GeckoTerminal,
+// ready for UltimateAnalysis
+func FetchDEXOHLCV(ctx context.Context, network, poolAddress string, interval time.Duration, limit int) ([]OHLCV, error) {
+	return NewGeckoTerminalClient().FetchOHLCV(ctx, FetchRequest{Symbol: network + "/" + poolAddress, Interval: interval, Limit: limit})
+}
+
+// ohlcv requests one page of candles before a timestamp, returned in ascending order
+func (c *GeckoTerminalClient) ohlcv(ctx context.Context, network, pool, timeframe string, before time.Time, limit int) ([]OHLCV, error) {
+	period, aggregate, _ := strings.Cut(timeframe, ":")
+	query := url.Values{}
+	query.Set("aggregate", aggregate)
+	query.Set("limit", strconv.Itoa(limit))
+	if c.Currency != "" {
+		query.Set("currency", c.Currency)
+	}
+	if !before.IsZero() {
+		query.Set("before_timestamp", strconv.FormatInt(before.Unix(), 10))
+	}
+
+	var resp struct {
+		Data struct {
+			Attributes struct {
+				OHLCVList [][]json.RawMessage `json:"ohlcv_list"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	endpoint := fmt.Sprintf("%s/networks/%s/pools/%s/ohlcv/%s?%s", strings.TrimRight(c.BaseURL, "/"),
+		url.PathEscape(network), url.PathEscape(pool), period, query.Encode())
+	if err := getJSON(ctx, c.HTTPClient, c.Name(), endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	// Each row is [timestamp, open, high, low, close, volume], newest first
+	rows := resp.Data.Attributes.OHLCVList
+	candles := make([]OHLCV, len(rows))
+	for i, row := range rows {
+		candle, err := parseJSONRow(row, [6]int{0, 1, 2, 3, 4, 5}, time.Second)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.Name(), err)
+		}
+		candles[len(rows)-1-i] = candle
+	}
+	return dedupeCandles(candles), nil
+}
@@ -0,0 +1,301 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// FitnessFunc scores a backtest; optimizers maximize it
+type FitnessFunc func(BacktestResult) float64
+
+// TotalReturnFitness scores by total return
+func TotalReturnFitness(r BacktestResult) float64 {
+	return r.TotalReturn
+}
+
+// SharpeFitness scores by the per-candle Sharpe ratio
+func SharpeFitness(r BacktestResult) float64 {
+	return r.SharpeRatio
+}
+
+// CalmarFitness scores by total return over maximum drawdown; a run without drawdown scores its return
+func CalmarFitness(r BacktestResult) float64 {
+	if r.MaxDrawdown == 0 {
+		return r.TotalReturn
+	}
+	return r.TotalReturn / r.MaxDrawdown
+}
+
+// ProfitFactorFitness scores by gross profit over gross loss of the trades, capped at 100 when nothing was lost
+func ProfitFactorFitness(r BacktestResult) float64 {
+	var profit, loss float64
+	for _, trade := range r.Trades {
+		if trade.Return > 0 {
+			profit += trade.Return
+		} else {
+			loss -= trade.Return
+		}
+	}
+	if loss == 0 {
+		if profit > 0 {
+			return 100
+		}
+		return 0
+	}
+	return math.Min(profit/loss, 100)
+}
+
+// GeneticConfig controls a genetic parameter search
+type GeneticConfig struct {
+	Ranges       []ParameterRange
+	Backtest     BacktestConfig
+	Fitness      FitnessFunc // Defaults to TotalReturnFitness
+	Population   int         // Defaults to 20
+	Generations  int         // Defaults to 20
+	MutationRate float64     // Probability of mutating each gene (defaults to 0.1)
+	Elite        int         // Best individuals copied unchanged to the next generation (defaults to 2)
+	Seed         int64       // Random seed; equal seeds give equal results
+	Workers      int         // Parallel backtests (defaults to the number of CPUs)
+	TopN         int         // Configurations to return (defaults to 10)
+}
+
+// withDefaults fills zero-valued fields
+func (c GeneticConfig) withDefaults() GeneticConfig {
+	if c.Fitness == nil {
+		c.Fitness = TotalReturnFitness
+	}
+	if c.Population == 0 {
+		c.Population = 20
+	}
+	if c.Generations == 0 {
+		c.Generations = 20
+	}
+	if c.MutationRate == 0 {
+		c.MutationRate = 0.1
+	}
+	if c.Elite == 0 {
+		c.Elite = 2
+	}
+	if c.Workers <= 0 {
+		c.Workers = runtime.NumCPU()
+	}
+	if c.TopN <= 0 {
+		c.TopN = 10
+	}
+	return c
+}
+
+// GeneticReport summarizes a genetic search
+type GeneticReport struct {
+	Top         []GridSearchResult `json:"top"`          // Best first
+	Evaluated   int                `json:"evaluated"`    // Distinct combinations backtested
+	Failed      int                `json:"failed"`       // Combinations whose backtest returned an error
+	BestFitness []float64          `json:"best_fitness"` // Best fitness after each generation
+}
+
+// OptimizeGenetic searches the parameter ranges with a genetic algorithm (tournament selection, uniform
+// crossover, and step mutation), evaluating only a fraction of the combinations GridSearch would
+func OptimizeGenetic(dataset []OHLCV, factory StrategyFactory, config GeneticConfig) (GeneticReport, error) {
+	return OptimizeGeneticContext(context.Background(), dataset, factory, config)
+}
+
+// OptimizeGeneticContext is OptimizeGenetic with cancellation
+func OptimizeGeneticContext(ctx context.Context, dataset []OHLCV, factory StrategyFactory, config GeneticConfig) (GeneticReport, error) {
+	if len(dataset) == 0 {
+		return GeneticReport{}, ErrEmptyDataset
+	}
+	if factory == nil {
+		return GeneticReport{}, invalidParameter("strategy factory is required")
+	}
+	if len(config.Ranges) == 0 {
+		return GeneticReport{}, invalidParameter("at least one parameter range is required")
+	}
+
+	config = config.withDefaults()
+	if config.Population < 2 || config.Generations < 1 {
+		return GeneticReport{}, invalidParameter("population must be at least 2 and generations at least 1")
+	}
+	if config.MutationRate < 0 || config.MutationRate > 1 {
+		return GeneticReport{}, invalidParameter("mutation rate must be between 0 and 1")
+	}
+	if config.Elite < 0 || config.Elite >= config.Population {
+		return GeneticReport{}, invalidParameter("elite must be less than the population")
+	}
+
+	grid := make([][]float64, len(config.Ranges))
+	for i, r := range config.Ranges {
+		if r.Name == "" {
+			return GeneticReport{}, invalidParameter("parameter range %d has no name", i)
+		}
+		if r.Step <= 0 || r.Max < r.Min {
+			return GeneticReport{}, invalidParameter("range %q needs min <= max and a positive step", r.Name)
+		}
+		if !(r.count() <= maxGridCombinations) {
+			return GeneticReport{}, invalidParameter("range %q exceeds %d values", r.Name, maxGridCombinations)
+		}
+		grid[i] = r.values()
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	evaluated := make(map[string]*GridSearchResult)
+	var report GeneticReport
+
+	// A genome holds one value index per range
+	genomeKey := func(genome []int) string { return fmt.Sprint(genome) }
+	fitness := func(genome []int) float64 {
+		if result := evaluated[genomeKey(genome)]; result != nil {
+			return result.Score
+		}
+		return math.Inf(-1)
+	}
+
+	population := make([][]int, config.Population)
+	for i := range population {
+		population[i] = make([]int, len(grid))
+		for g := range grid {
+			population[i][g] = rng.Intn(len(grid[g]))
+		}
+	}
+
+	for generation := 0; generation < config.Generations; generation++ {
+		if err := geneticEvaluate(ctx, dataset, factory, config, grid, population, evaluated, &report); err != nil {
+			return GeneticReport{}, err
+		}
+
+		sort.SliceStable(population, func(a, b int) bool { return fitness(population[a]) > fitness(population[b]) })
+		report.BestFitness = append(report.BestFitness, fitness(population[0]))
+
+		if generation == config.Generations-1 {
+			break
+		}
+
+		// Next generation: elites, then children of tournament winners
+		next := make([][]int, 0, config.Population)
+		for _, elite := range population[:config.Elite] {
+			next = append(next, append([]int(nil), elite...))
+		}
+
+		tournament := func() []int {
+			best := population[rng.Intn(len(population))]
+			for k := 0; k < 2; k++ {
+				if challenger := population[rng.Intn(len(population))]; fitness(challenger) > fitness(best) {
+					best = challenger
+				}
+			}
+			return best
+		}
+
+		for len(next) < config.Population {
+			a, b := tournament(), tournament()
+			child := make([]int, len(grid))
+			for g := range grid {
+				child[g] = a[g]
+				if rng.Intn(2) == 0 {
+					child[g] = b[g]
+				}
+				if rng.Float64() < config.MutationRate {
+					// Mostly small steps, occasionally a random jump
+					if rng.Intn(4) == 0 {
+						child[g] = rng.Intn(len(grid[g]))
+					} else {
+						child[g] = min(max(child[g]+rng.Intn(3)-1, 0), len(grid[g])-1)
+					}
+				}
+			}
+			next = append(next, child)
+		}
+		population = next
+	}
+
+	var ranked []GridSearchResult
+	for _, result := range evaluated {
+		if result != nil {
+			ranked = append(ranked, *result)
+		}
+	}
+	if len(ranked) == 0 {
+		return GeneticReport{}, invalidParameter("every evaluated combination failed")
+	}
+
+	sort.Slice(ranked, func(a, b int) bool {
+		if ranked[a].Score != ranked[b].Score {
+			return ranked[a].Score > ranked[b].Score
+		}
+		return fmt.Sprint(ranked[a].Params) < fmt.Sprint(ranked[b].Params)
+	})
+	report.Top = ranked[:min(config.TopN, len(ranked))]
+
+	return report, nil
+}
+
+// geneticEvaluate backtests the genomes not evaluated yet in parallel, recording failures as nil results
+func geneticEvaluate(ctx context.Context, dataset []OHLCV, factory StrategyFactory, config GeneticConfig,
+	grid [][]float64, population [][]int, evaluated map[string]*GridSearchResult, report *GeneticReport) error {
+
+	var pending [][]int
+	for _, genome := range population {
+		key := fmt.Sprint(genome)
+		if _, ok := evaluated[key]; ok {
+			continue
+		}
+		evaluated[key] = nil // Reserve so duplicates in this generation run once
+		pending = append(pending, genome)
+	}
+
+	results := make([]*GridSearchResult, len(pending))
+	errs := make([]error, len(pending))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(config.Workers, len(pending)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				params := make(IndicatorParams, len(grid))
+				for g, index := range pending[i] {
+					params[config.Ranges[g].Name] = grid[g][index]
+				}
+
+				result, err := BacktestContext(ctx, dataset, factory(params), config.Backtest)
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+				results[i] = &GridSearchResult{Params: params, Score: config.Fitness(result), Result: result}
+			}
+		}()
+	}
+
+	for i := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for i, genome := range pending {
+		if errs[i] != nil {
+			if errors.Is(errs[i], context.Canceled) || errors.Is(errs[i], context.DeadlineExceeded) {
+				return errs[i]
+			}
+			report.Failed++
+			continue
+		}
+		report.Evaluated++
+		evaluated[fmt.Sprint(genome)] = results[i]
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package techindicators
+
+import (
+	"fmt"
+	"time"
+)
+
+// MajorTrend is the long-term trend state of a golden/death cross detector
+type MajorTrend struct {
+	Timestamp time.Time `json:"timestamp"`
+	Trend     Signal    `json:"trend"` // Bullish after a golden cross, bearish after a death cross, neutral when equal
+	Fast      float64   `json:"fast"`
+	Slow      float64   `json:"slow"`
+
+	// Latest golden or death cross in the dataset, nil when the averages never crossed
+	LastCross         *CrossoverEvent `json:"last_cross,omitempty"`
+	CandlesSinceCross int             `json:"candles_since_cross"` // 0 on the crossing candle, -1 without a cross
+}
+
+// GoldenCrossConfig configures DetectGoldenCross. Zero fields take the defaults in brackets.
+type GoldenCrossConfig struct {
+	FastPeriod int       `json:"fast_period"` // [50]
+	SlowPeriod int       `json:"slow_period"` // [200]
+	PriceType  PriceType `json:"price_type"`
+}
+
+// DefaultGoldenCrossConfig returns the classic SMA-50/SMA-200 cross
+func DefaultGoldenCrossConfig() GoldenCrossConfig {
+	return GoldenCrossConfig{
+		FastPeriod: 50,
+		SlowPeriod: 200,
+		PriceType:  ClosePrice,
+	}
+}
+
+// withDefaults fills zero fields
+func (c GoldenCrossConfig) withDefaults() GoldenCrossConfig {
+	defaults := DefaultGoldenCrossConfig()
+	if c.FastPeriod == 0 {
+		c.FastPeriod = defaults.FastPeriod
+	}
+	if c.SlowPeriod == 0 {
+		c.SlowPeriod = defaults.SlowPeriod
+	}
+	return c
+}
+
+// DetectGoldenCross reports the major trend from the fast and slow SMAs: bullish while the fast average
+// is above the slow one, bearish while it is below, and the latest golden or death cross that set it.
+func DetectGoldenCross(dataset []OHLCV, config GoldenCrossConfig) (MajorTrend, error) {
+	config = config.withDefaults()
+	fastSMA, slowSMA, err := crossoverSMAs(dataset, config.FastPeriod, config.SlowPeriod, config.PriceType)
+	if err != nil {
+		return MajorTrend{}, err
+	}
+	events := crossoverHistory(fastSMA, slowSMA, len(dataset))
+	fast, slow := fastSMA[len(fastSMA)-1].Value, slowSMA[len(slowSMA)-1].Value
+
+	trend := MajorTrend{Timestamp: dataset[len(dataset)-1].Timestamp, Trend: SignalNeutral, Fast: fast, Slow: slow, CandlesSinceCross: -1}
+	switch {
+	case fast > slow:
+		trend.Trend = SignalBullish
+	case fast < slow:
+		trend.Trend = SignalBearish
+	}
+
+	if len(events) > 0 {
+		cross := events[len(events)-1]
+		if cross.Signal == SignalBullishCrossover {
+			cross.Signal = SignalGoldenCross
+		} else {
+			cross.Signal = SignalDeathCross
+		}
+		trend.LastCross = &cross
+		trend.CandlesSinceCross = len(dataset) - 1 - cross.Index
+	}
+	return trend, nil
+}
+
+// MajorTrendFilter only lets the strategy's bullish signals through while the golden/death cross trend is
+// bullish; other bullish signals become SignalWait. Bearish signals always pass so positions can be
+// closed in a bear trend. Histories too short for the slow average return the ErrInsufficientData that
+// Backtest treats as warm-up.
+func MajorTrendFilter(strategy Strategy, config GoldenCrossConfig) Strategy {
+	return func(history []OHLCV) (Signal, error) {
+		signal, err := strategy(history)
+		if err != nil || !signal.IsBullish() {
+			return signal, err
+		}
+
+		trend, err := DetectGoldenCross(history, config)
+		if err != nil {
+			return "", fmt.Errorf("major trend filter: %w", err)
+		}
+		if trend.Trend != SignalBullish {
+			return SignalWait, nil
+		}
+		return signal, nil
+	}
+}
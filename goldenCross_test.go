@@ -0,0 +1,37 @@
+package techindicators
+
+import "testing"
+
+func TestDetectGoldenCrossMatchesHistory(t *testing.T) {
+	dataset := syntheticCandles(t, 1500)
+	config := GoldenCrossConfig{FastPeriod: 10, SlowPeriod: 30}
+
+	for _, n := range []int{31, 200, 700, len(dataset)} {
+		history := dataset[:n]
+		trend, err := DetectGoldenCross(history, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fast, _ := GetLatestSMA(history, config.FastPeriod, ClosePrice)
+		slow, _ := GetLatestSMA(history, config.SlowPeriod, ClosePrice)
+		if trend.Fast != fast || trend.Slow != slow {
+			t.Fatalf("n=%d: averages %v/%v; want %v/%v", n, trend.Fast, trend.Slow, fast, slow)
+		}
+
+		events, err := SMACrossoverHistory(history, config.FastPeriod, config.SlowPeriod, ClosePrice)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) == 0 {
+			if trend.LastCross != nil {
+				t.Fatalf("n=%d: cross %+v without a crossover", n, trend.LastCross)
+			}
+			continue
+		}
+		want := map[Signal]Signal{SignalBullishCrossover: SignalGoldenCross, SignalBearishCrossover: SignalDeathCross}[events[len(events)-1].Signal]
+		if trend.LastCross == nil || trend.LastCross.Signal != want || trend.LastCross.Index != events[len(events)-1].Index {
+			t.Fatalf("n=%d: last cross %+v; want %s at %d", n, trend.LastCross, want, events[len(events)-1].Index)
+		}
+	}
+}
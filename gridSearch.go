@@ -0,0 +1,229 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// maxGridCombinations bounds the size of a grid search
+const maxGridCombinations = 100000
+
+// ParameterRange sweeps one parameter from Min to Max inclusive in Step increments
+type ParameterRange struct {
+	Name string
+	Min  float64
+	Max  float64
+	Step float64
+}
+
+// count returns the number of values of the range, as a float so that huge or invalid ranges can be
+// rejected before values allocates them
+func (r ParameterRange) count() float64 {
+	return math.Floor((r.Max-r.Min)/r.Step+1e-9) + 1
+}
+
+// values lists the parameter values of the range; check count first
+func (r ParameterRange) values() []float64 {
+	values := make([]float64, int(r.count()))
+	for i := range values {
+		values[i] = r.Min + float64(i)*r.Step
+	}
+	return values
+}
+
+// GridSearchConfig controls a grid search
+type GridSearchConfig struct {
+	Ranges    []ParameterRange
+	Backtest  BacktestConfig
+	Objective func(BacktestResult) float64 // Score to maximize (defaults to total return)
+	TopN      int                          // Configurations to return (defaults to 10)
+	Workers   int                          // Parallel backtests (defaults to the number of CPUs)
+}
+
+// GridSearchResult is the performance of one parameter combination
+type GridSearchResult struct {
+	Params IndicatorParams `json:"params"`
+	Score  float64         `json:"score"`
+	Result BacktestResult  `json:"result"`
+}
+
+// GridSearchReport lists the best combinations and flags results that are likely curve-fit
+type GridSearchReport struct {
+	Top                []GridSearchResult `json:"top"` // Best first
+	Evaluated          int                `json:"evaluated"`
+	Failed             int                `json:"failed"` // Combinations whose backtest returned an error (e.g. invalid periods)
+	OverfittingWarning bool               `json:"overfitting_warning"`
+	Warnings           []string           `json:"warnings,omitempty"`
+}
+
+// GridSearch backtests every combination of the parameter ranges in parallel and returns the top configurations
+func GridSearch(dataset []OHLCV, factory StrategyFactory, config GridSearchConfig) (GridSearchReport, error) {
+	return GridSearchContext(context.Background(), dataset, factory, config)
+}
+
+// GridSearchContext is GridSearch with cancellation
+func GridSearchContext(ctx context.Context, dataset []OHLCV, factory StrategyFactory, config GridSearchConfig) (GridSearchReport, error) {
+	if len(dataset) == 0 {
+		return GridSearchReport{}, ErrEmptyDataset
+	}
+	if factory == nil {
+		return GridSearchReport{}, invalidParameter("strategy factory is required")
+	}
+	if len(config.Ranges) == 0 {
+		return GridSearchReport{}, invalidParameter("at least one parameter range is required")
+	}
+
+	grid := make([][]float64, len(config.Ranges))
+	combinations := 1
+	for i, r := range config.Ranges {
+		if r.Name == "" {
+			return GridSearchReport{}, invalidParameter("parameter range %d has no name", i)
+		}
+		if r.Step <= 0 || r.Max < r.Min {
+			return GridSearchReport{}, invalidParameter("range %q needs min <= max and a positive step", r.Name)
+		}
+		// Bound the running product before allocating the range's values; NaN counts fail too
+		if !(r.count()*float64(combinations) <= maxGridCombinations) {
+			return GridSearchReport{}, invalidParameter("grid exceeds %d combinations", maxGridCombinations)
+		}
+		grid[i] = r.values()
+		combinations *= len(grid[i])
+	}
+
+	objective := config.Objective
+	if objective == nil {
+		objective = func(r BacktestResult) float64 { return r.TotalReturn }
+	}
+	topN := config.TopN
+	if topN <= 0 {
+		topN = 10
+	}
+	workers := config.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	// Run every combination; results are indexed by combination so the output is deterministic
+	results := make([]*GridSearchResult, combinations)
+	errs := make([]error, combinations)
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(workers, combinations); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				params := gridParams(config.Ranges, grid, index)
+				result, err := BacktestContext(ctx, dataset, factory(params), config.Backtest)
+				if err != nil {
+					errs[index] = err
+					continue
+				}
+				results[index] = &GridSearchResult{Params: params, Score: objective(result), Result: result}
+			}
+		}()
+	}
+
+feed:
+	for index := 0; index < combinations; index++ {
+		select {
+		case <-ctx.Done():
+			break feed
+		case indices <- index:
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return GridSearchReport{}, err
+	}
+
+	var report GridSearchReport
+	var ranked []GridSearchResult
+	for index, result := range results {
+		if result == nil {
+			if errors.Is(errs[index], context.Canceled) || errors.Is(errs[index], context.DeadlineExceeded) {
+				return GridSearchReport{}, errs[index]
+			}
+			report.Failed++
+			continue
+		}
+		report.Evaluated++
+		ranked = append(ranked, *result)
+	}
+	if len(ranked) == 0 {
+		return GridSearchReport{}, fmt.Errorf("every combination failed: %w", errs[0])
+	}
+
+	// Best index before sorting, for the neighbor check
+	best := 0
+	for index, result := range results {
+		if result != nil && (results[best] == nil || result.Score > results[best].Score) {
+			best = index
+		}
+	}
+
+	sort.SliceStable(ranked, func(a, b int) bool { return ranked[a].Score > ranked[b].Score })
+	report.Top = ranked[:min(topN, len(ranked))]
+
+	report.Warnings = overfittingWarnings(results, grid, best, len(dataset)-config.Backtest.Start)
+	report.OverfittingWarning = len(report.Warnings) > 0
+
+	return report, nil
+}
+
+// gridParams decodes a combination index into parameter values (the last range varies fastest)
+func gridParams(ranges []ParameterRange, grid [][]float64, index int) IndicatorParams {
+	params := make(IndicatorParams, len(ranges))
+	for i := len(ranges) - 1; i >= 0; i-- {
+		params[ranges[i].Name] = grid[i][index%len(grid[i])]
+		index /= len(grid[i])
+	}
+	return params
+}
+
+// overfittingWarnings applies curve-fitting heuristics to the best combination: too few trades to be
+// significant, more combinations than the data can support, and a score that collapses one step away
+func overfittingWarnings(results []*GridSearchResult, grid [][]float64, best, candles int) []string {
+	var warnings []string
+	top := results[best]
+
+	if trades := len(top.Result.Trades); trades < 10 {
+		warnings = append(warnings, fmt.Sprintf("best configuration made only %d trades", trades))
+	}
+
+	if combinations := len(results); combinations > candles/10 {
+		warnings = append(warnings, fmt.Sprintf("%d combinations tested on %d candles", combinations, candles))
+	}
+
+	// Neighbors differ by one step in one parameter
+	var neighborSum float64
+	neighbors := 0
+	stride := 1
+	for i := len(grid) - 1; i >= 0; i-- {
+		position := best / stride % len(grid[i])
+		for _, offset := range []int{-1, 1} {
+			if p := position + offset; p >= 0 && p < len(grid[i]) {
+				if neighbor := results[best+offset*stride]; neighbor != nil {
+					neighborSum += neighbor.Score
+					neighbors++
+				}
+			}
+		}
+		stride *= len(grid[i])
+	}
+	if neighbors > 0 && top.Score > 0 {
+		if mean := neighborSum / float64(neighbors); mean < top.Score/2 {
+			warnings = append(warnings, fmt.Sprintf("best score %.4f is an isolated peak (neighbor mean %.4f)", top.Score, mean))
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,31 @@
+package techindicators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestGridSearchRejectsHugeRangesBeforeAllocating(t *testing.T) {
+	dataset := syntheticCandles(t, 50)
+	for _, ranges := range [][]ParameterRange{
+		{{Name: "period", Min: 0, Max: 1e9, Step: 1e-9}},
+		{{Name: "period", Min: 1, Max: 1000, Step: 1}, {Name: "multiplier", Min: 1, Max: 1000, Step: 1}},
+		{{Name: "period", Min: 1, Max: 2, Step: math.NaN()}},
+	} {
+		_, err := GridSearch(dataset, ComprehensiveStrategyFactory, GridSearchConfig{Ranges: ranges})
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("GridSearch(%v) error = %v; want ErrInvalidParameter", ranges, err)
+		}
+	}
+
+	for _, r := range []ParameterRange{
+		{Name: "period", Min: 0, Max: 1e9, Step: 1e-9},
+		{Name: "period", Min: 1, Max: 2, Step: math.NaN()},
+	} {
+		_, err := OptimizeGenetic(dataset, ComprehensiveStrategyFactory, GeneticConfig{Ranges: []ParameterRange{r}})
+		if !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("OptimizeGenetic(%v) error = %v; want ErrInvalidParameter", r, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Package grpc serves the techindicators calculations over gRPC. The service is defined in
+// techindicators.proto; regenerate the .pb.go files with
+//
+//	protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative techindicators.proto
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	ti "github.com/luislaredovelazquez/techindicators"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server implements IndicatorServiceServer by calling the techindicators package
+type Server struct {
+	UnimplementedIndicatorServiceServer
+}
+
+// NewServer returns a Server; register it with RegisterIndicatorServiceServer
+func NewServer() *Server {
+	return &Server{}
+}
+
+// SMA returns the simple moving average of the candles
+func (s *Server) SMA(ctx context.Context, req *SeriesRequest) (*SeriesResponse, error) {
+	results, err := ti.CalculateSMA(toDataset(req.GetCandles()), int(req.GetPeriod()), ti.PriceType(req.GetPriceType()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return smaResponse(results), nil
+}
+
+// EMA returns the exponential moving average of the candles
+func (s *Server) EMA(ctx context.Context, req *SeriesRequest) (*SeriesResponse, error) {
+	results, err := ti.CalculateEMA(toDataset(req.GetCandles()), int(req.GetPeriod()), ti.PriceType(req.GetPriceType()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return smaResponse(results), nil
+}
+
+// RSI returns the relative strength index of the candles
+func (s *Server) RSI(ctx context.Context, req *SeriesRequest) (*SeriesResponse, error) {
+	results, err := ti.CalculateRSI(toDataset(req.GetCandles()), int(req.GetPeriod()), ti.PriceType(req.GetPriceType()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	points := make([]*Point, len(results))
+	for i, result := range results {
+		points[i] = &Point{Timestamp: timestamppb.New(result.Timestamp), Value: result.Value}
+	}
+	return &SeriesResponse{Points: points}, nil
+}
+
+// BollingerBands returns the Bollinger Bands of the candles
+func (s *Server) BollingerBands(ctx context.Context, req *BollingerRequest) (*BollingerResponse, error) {
+	results, err := ti.CalculateBollingerBands(toDataset(req.GetCandles()), int(req.GetPeriod()), req.GetMultiplier(), ti.PriceType(req.GetPriceType()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	bands := make([]*Band, len(results))
+	for i, result := range results {
+		bands[i] = &Band{
+			Timestamp: timestamppb.New(result.Timestamp),
+			Upper:     result.UpperBand,
+			Middle:    result.MiddleBand,
+			Lower:     result.LowerBand,
+			BandWidth: result.BandWidth,
+		}
+	}
+	return &BollingerResponse{Bands: bands}, nil
+}
+
+// ComprehensiveAnalysis runs the SMA, Bollinger Bands, and RSI vote
+func (s *Server) ComprehensiveAnalysis(ctx context.Context, req *AnalysisRequest) (*ComprehensiveAnalysis, error) {
+	analysis, err := ti.ComprehensiveAnalysisContext(ctx, toDataset(req.GetCandles()), toConfig(req.GetConfig()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromComprehensive(analysis), nil
+}
+
+// UltimateAnalysis runs the comprehensive analysis confirmed by volume
+func (s *Server) UltimateAnalysis(ctx context.Context, req *AnalysisRequest) (*UltimateAnalysis, error) {
+	analysis, err := ti.UltimateAnalysisContext(ctx, toDataset(req.GetCandles()), toConfig(req.GetConfig()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &UltimateAnalysis{
+		Technical:       fromComprehensive(analysis.Technical),
+		VolumeSignal:    string(analysis.Volume.Signal),
+		VolumeRatio:     analysis.Volume.VolumeRatio,
+		ObvTrend:        analysis.Volume.OBVTrend,
+		FinalSignal:     string(analysis.FinalSignal),
+		Confidence:      analysis.Confidence,
+		RiskLevel:       analysis.RiskLevel,
+		RugPullRisk:     analysis.RugPullRisk,
+		VolumeConfirm:   analysis.VolumeConfirm,
+		ConfidenceScore: analysis.ConfidenceScore,
+		RiskScore:       analysis.RiskScore,
+	}, nil
+}
+
+// toDataset converts wire candles to OHLCV
+func toDataset(candles []*Candle) []ti.OHLCV {
+	dataset := make([]ti.OHLCV, len(candles))
+	for i, candle := range candles {
+		dataset[i] = ti.OHLCV{
+			Timestamp: candle.GetTimestamp().AsTime(),
+			Open:      candle.GetOpen(),
+			High:      candle.GetHigh(),
+			Low:       candle.GetLow(),
+			Close:     candle.GetClose(),
+			Volume:    candle.GetVolume(),
+		}
+	}
+	return dataset
+}
+
+// toConfig overlays the non-zero request fields on the default analysis config
+func toConfig(config *AnalysisConfig) ti.AnalysisConfig {
+	result := ti.DefaultAnalysisConfig()
+	if config == nil {
+		return result
+	}
+
+	if config.GetSmaPeriod() != 0 {
+		result.SMAPeriod = int(config.GetSmaPeriod())
+	}
+	if config.GetBbPeriod() != 0 {
+		result.BBPeriod = int(config.GetBbPeriod())
+	}
+	if config.GetBbMultiplier() != 0 {
+		result.BBMultiplier = config.GetBbMultiplier()
+	}
+	if config.GetRsiPeriod() != 0 {
+		result.RSIPeriod = int(config.GetRsiPeriod())
+	}
+	if config.GetVmaPeriod() != 0 {
+		result.VMAPeriod = int(config.GetVmaPeriod())
+	}
+	if config.GetVrocPeriod() != 0 {
+		result.VROCPeriod = int(config.GetVrocPeriod())
+	}
+	result.PriceType = ti.PriceType(config.GetPriceType())
+	return result
+}
+
+// smaResponse converts moving average results
+func smaResponse(results []ti.SMAResult) *SeriesResponse {
+	points := make([]*Point, len(results))
+	for i, result := range results {
+		points[i] = &Point{Timestamp: timestamppb.New(result.Timestamp), Value: result.Value}
+	}
+	return &SeriesResponse{Points: points}
+}
+
+// fromComprehensive converts a comprehensive analysis
+func fromComprehensive(analysis ti.CombinedTechnicalAnalysis) *ComprehensiveAnalysis {
+	return &ComprehensiveAnalysis{
+		SmaSignal:       string(analysis.SMASignal),
+		BollingerSignal: string(analysis.BollingerSignal),
+		RsiSignal:       string(analysis.RSISignal),
+		FinalSignal:     string(analysis.FinalSignal),
+		Confidence:      analysis.Confidence,
+		RiskLevel:       analysis.RiskLevel,
+		ConfidenceScore: analysis.ConfidenceScore,
+		RiskScore:       analysis.RiskScore,
+		WeightedScore:   analysis.WeightedScore,
+	}
+}
+
+// toStatus maps package errors to gRPC status codes
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, ti.ErrInsufficientData{}), errors.Is(err, ti.ErrEmptyDataset):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ti.ErrInvalidPeriod), errors.Is(err, ti.ErrInvalidParameter),
+		errors.Is(err, ti.ErrInvalidPrice), errors.Is(err, ti.ErrInvalidDataset), errors.Is(err, ti.ErrMissingValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
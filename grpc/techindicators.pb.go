@@ -0,0 +1,1085 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: techindicators.proto
+
+package grpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriceType mirrors techindicators.PriceType
+type PriceType int32
+
+const (
+	PriceType_PRICE_TYPE_CLOSE    PriceType = 0
+	PriceType_PRICE_TYPE_OPEN     PriceType = 1
+	PriceType_PRICE_TYPE_HIGH     PriceType = 2
+	PriceType_PRICE_TYPE_LOW      PriceType = 3
+	PriceType_PRICE_TYPE_TYPICAL  PriceType = 4
+	PriceType_PRICE_TYPE_WEIGHTED PriceType = 5
+	PriceType_PRICE_TYPE_MEDIAN   PriceType = 6
+	PriceType_PRICE_TYPE_OHLC4    PriceType = 7
+)
+
+// Enum value maps for PriceType.
+var (
+	PriceType_name = map[int32]string{
+		0: "PRICE_TYPE_CLOSE",
+		1: "PRICE_TYPE_OPEN",
+		2: "PRICE_TYPE_HIGH",
+		3: "PRICE_TYPE_LOW",
+		4: "PRICE_TYPE_TYPICAL",
+		5: "PRICE_TYPE_WEIGHTED",
+		6: "PRICE_TYPE_MEDIAN",
+		7: "PRICE_TYPE_OHLC4",
+	}
+	PriceType_value = map[string]int32{
+		"PRICE_TYPE_CLOSE":    0,
+		"PRICE_TYPE_OPEN":     1,
+		"PRICE_TYPE_HIGH":     2,
+		"PRICE_TYPE_LOW":      3,
+		"PRICE_TYPE_TYPICAL":  4,
+		"PRICE_TYPE_WEIGHTED": 5,
+		"PRICE_TYPE_MEDIAN":   6,
+		"PRICE_TYPE_OHLC4":    7,
+	}
+)
+
+func (x PriceType) Enum() *PriceType {
+	p := new(PriceType)
+	*p = x
+	return p
+}
+
+func (x PriceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_techindicators_proto_enumTypes[0].Descriptor()
+}
+
+func (PriceType) Type() protoreflect.EnumType {
+	return &file_techindicators_proto_enumTypes[0]
+}
+
+func (x PriceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceType.Descriptor instead.
+func (PriceType) EnumDescriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{0}
+}
+
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_techindicators_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Candle) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type SeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	PriceType     PriceType              `protobuf:"varint,3,opt,name=price_type,json=priceType,proto3,enum=techindicators.v1.PriceType" json:"price_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesRequest) Reset() {
+	*x = SeriesRequest{}
+	mi := &file_techindicators_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesRequest) ProtoMessage() {}
+
+func (x *SeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesRequest.ProtoReflect.Descriptor instead.
+func (*SeriesRequest) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeriesRequest) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *SeriesRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *SeriesRequest) GetPriceType() PriceType {
+	if x != nil {
+		return x.PriceType
+	}
+	return PriceType_PRICE_TYPE_CLOSE
+}
+
+type Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_techindicators_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Point) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Point) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*Point               `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesResponse) Reset() {
+	*x = SeriesResponse{}
+	mi := &file_techindicators_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesResponse) ProtoMessage() {}
+
+func (x *SeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesResponse.ProtoReflect.Descriptor instead.
+func (*SeriesResponse) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SeriesResponse) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type BollingerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	Period        int32                  `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	PriceType     PriceType              `protobuf:"varint,4,opt,name=price_type,json=priceType,proto3,enum=techindicators.v1.PriceType" json:"price_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BollingerRequest) Reset() {
+	*x = BollingerRequest{}
+	mi := &file_techindicators_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BollingerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BollingerRequest) ProtoMessage() {}
+
+func (x *BollingerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BollingerRequest.ProtoReflect.Descriptor instead.
+func (*BollingerRequest) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BollingerRequest) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *BollingerRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *BollingerRequest) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *BollingerRequest) GetPriceType() PriceType {
+	if x != nil {
+		return x.PriceType
+	}
+	return PriceType_PRICE_TYPE_CLOSE
+}
+
+type Band struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Upper         float64                `protobuf:"fixed64,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Middle        float64                `protobuf:"fixed64,3,opt,name=middle,proto3" json:"middle,omitempty"`
+	Lower         float64                `protobuf:"fixed64,4,opt,name=lower,proto3" json:"lower,omitempty"`
+	BandWidth     float64                `protobuf:"fixed64,5,opt,name=band_width,json=bandWidth,proto3" json:"band_width,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Band) Reset() {
+	*x = Band{}
+	mi := &file_techindicators_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Band) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Band) ProtoMessage() {}
+
+func (x *Band) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Band.ProtoReflect.Descriptor instead.
+func (*Band) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Band) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Band) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *Band) GetMiddle() float64 {
+	if x != nil {
+		return x.Middle
+	}
+	return 0
+}
+
+func (x *Band) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *Band) GetBandWidth() float64 {
+	if x != nil {
+		return x.BandWidth
+	}
+	return 0
+}
+
+type BollingerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bands         []*Band                `protobuf:"bytes,1,rep,name=bands,proto3" json:"bands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BollingerResponse) Reset() {
+	*x = BollingerResponse{}
+	mi := &file_techindicators_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BollingerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BollingerResponse) ProtoMessage() {}
+
+func (x *BollingerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BollingerResponse.ProtoReflect.Descriptor instead.
+func (*BollingerResponse) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BollingerResponse) GetBands() []*Band {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+// AnalysisConfig mirrors techindicators.AnalysisConfig; zero fields take the package defaults
+type AnalysisConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmaPeriod     int32                  `protobuf:"varint,1,opt,name=sma_period,json=smaPeriod,proto3" json:"sma_period,omitempty"`
+	BbPeriod      int32                  `protobuf:"varint,2,opt,name=bb_period,json=bbPeriod,proto3" json:"bb_period,omitempty"`
+	BbMultiplier  float64                `protobuf:"fixed64,3,opt,name=bb_multiplier,json=bbMultiplier,proto3" json:"bb_multiplier,omitempty"`
+	RsiPeriod     int32                  `protobuf:"varint,4,opt,name=rsi_period,json=rsiPeriod,proto3" json:"rsi_period,omitempty"`
+	VmaPeriod     int32                  `protobuf:"varint,5,opt,name=vma_period,json=vmaPeriod,proto3" json:"vma_period,omitempty"`
+	VrocPeriod    int32                  `protobuf:"varint,6,opt,name=vroc_period,json=vrocPeriod,proto3" json:"vroc_period,omitempty"`
+	PriceType     PriceType              `protobuf:"varint,7,opt,name=price_type,json=priceType,proto3,enum=techindicators.v1.PriceType" json:"price_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalysisConfig) Reset() {
+	*x = AnalysisConfig{}
+	mi := &file_techindicators_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisConfig) ProtoMessage() {}
+
+func (x *AnalysisConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisConfig.ProtoReflect.Descriptor instead.
+func (*AnalysisConfig) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AnalysisConfig) GetSmaPeriod() int32 {
+	if x != nil {
+		return x.SmaPeriod
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetBbPeriod() int32 {
+	if x != nil {
+		return x.BbPeriod
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetBbMultiplier() float64 {
+	if x != nil {
+		return x.BbMultiplier
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetRsiPeriod() int32 {
+	if x != nil {
+		return x.RsiPeriod
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetVmaPeriod() int32 {
+	if x != nil {
+		return x.VmaPeriod
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetVrocPeriod() int32 {
+	if x != nil {
+		return x.VrocPeriod
+	}
+	return 0
+}
+
+func (x *AnalysisConfig) GetPriceType() PriceType {
+	if x != nil {
+		return x.PriceType
+	}
+	return PriceType_PRICE_TYPE_CLOSE
+}
+
+type AnalysisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       []*Candle              `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"`
+	Config        *AnalysisConfig        `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalysisRequest) Reset() {
+	*x = AnalysisRequest{}
+	mi := &file_techindicators_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalysisRequest) ProtoMessage() {}
+
+func (x *AnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalysisRequest.ProtoReflect.Descriptor instead.
+func (*AnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AnalysisRequest) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+func (x *AnalysisRequest) GetConfig() *AnalysisConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ComprehensiveAnalysis struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SmaSignal       string                 `protobuf:"bytes,1,opt,name=sma_signal,json=smaSignal,proto3" json:"sma_signal,omitempty"`
+	BollingerSignal string                 `protobuf:"bytes,2,opt,name=bollinger_signal,json=bollingerSignal,proto3" json:"bollinger_signal,omitempty"`
+	RsiSignal       string                 `protobuf:"bytes,3,opt,name=rsi_signal,json=rsiSignal,proto3" json:"rsi_signal,omitempty"`
+	FinalSignal     string                 `protobuf:"bytes,4,opt,name=final_signal,json=finalSignal,proto3" json:"final_signal,omitempty"`
+	Confidence      string                 `protobuf:"bytes,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	RiskLevel       string                 `protobuf:"bytes,6,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	ConfidenceScore float64                `protobuf:"fixed64,7,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	RiskScore       float64                `protobuf:"fixed64,8,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	WeightedScore   float64                `protobuf:"fixed64,9,opt,name=weighted_score,json=weightedScore,proto3" json:"weighted_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ComprehensiveAnalysis) Reset() {
+	*x = ComprehensiveAnalysis{}
+	mi := &file_techindicators_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComprehensiveAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComprehensiveAnalysis) ProtoMessage() {}
+
+func (x *ComprehensiveAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComprehensiveAnalysis.ProtoReflect.Descriptor instead.
+func (*ComprehensiveAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ComprehensiveAnalysis) GetSmaSignal() string {
+	if x != nil {
+		return x.SmaSignal
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetBollingerSignal() string {
+	if x != nil {
+		return x.BollingerSignal
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetRsiSignal() string {
+	if x != nil {
+		return x.RsiSignal
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetFinalSignal() string {
+	if x != nil {
+		return x.FinalSignal
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *ComprehensiveAnalysis) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *ComprehensiveAnalysis) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *ComprehensiveAnalysis) GetWeightedScore() float64 {
+	if x != nil {
+		return x.WeightedScore
+	}
+	return 0
+}
+
+type UltimateAnalysis struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Technical       *ComprehensiveAnalysis `protobuf:"bytes,1,opt,name=technical,proto3" json:"technical,omitempty"`
+	VolumeSignal    string                 `protobuf:"bytes,2,opt,name=volume_signal,json=volumeSignal,proto3" json:"volume_signal,omitempty"`
+	VolumeRatio     float64                `protobuf:"fixed64,3,opt,name=volume_ratio,json=volumeRatio,proto3" json:"volume_ratio,omitempty"`
+	ObvTrend        string                 `protobuf:"bytes,4,opt,name=obv_trend,json=obvTrend,proto3" json:"obv_trend,omitempty"`
+	FinalSignal     string                 `protobuf:"bytes,5,opt,name=final_signal,json=finalSignal,proto3" json:"final_signal,omitempty"`
+	Confidence      string                 `protobuf:"bytes,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	RiskLevel       string                 `protobuf:"bytes,7,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	RugPullRisk     string                 `protobuf:"bytes,8,opt,name=rug_pull_risk,json=rugPullRisk,proto3" json:"rug_pull_risk,omitempty"`
+	VolumeConfirm   bool                   `protobuf:"varint,9,opt,name=volume_confirm,json=volumeConfirm,proto3" json:"volume_confirm,omitempty"`
+	ConfidenceScore float64                `protobuf:"fixed64,10,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	RiskScore       float64                `protobuf:"fixed64,11,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UltimateAnalysis) Reset() {
+	*x = UltimateAnalysis{}
+	mi := &file_techindicators_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UltimateAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UltimateAnalysis) ProtoMessage() {}
+
+func (x *UltimateAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UltimateAnalysis.ProtoReflect.Descriptor instead.
+func (*UltimateAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UltimateAnalysis) GetTechnical() *ComprehensiveAnalysis {
+	if x != nil {
+		return x.Technical
+	}
+	return nil
+}
+
+func (x *UltimateAnalysis) GetVolumeSignal() string {
+	if x != nil {
+		return x.VolumeSignal
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetVolumeRatio() float64 {
+	if x != nil {
+		return x.VolumeRatio
+	}
+	return 0
+}
+
+func (x *UltimateAnalysis) GetObvTrend() string {
+	if x != nil {
+		return x.ObvTrend
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetFinalSignal() string {
+	if x != nil {
+		return x.FinalSignal
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetRugPullRisk() string {
+	if x != nil {
+		return x.RugPullRisk
+	}
+	return ""
+}
+
+func (x *UltimateAnalysis) GetVolumeConfirm() bool {
+	if x != nil {
+		return x.VolumeConfirm
+	}
+	return false
+}
+
+func (x *UltimateAnalysis) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *UltimateAnalysis) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+var File_techindicators_proto protoreflect.FileDescriptor
+
+const file_techindicators_proto_rawDesc = "" +
+	"\n" +
+	"\x14techindicators.proto\x12\x11techindicators.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x01\n" +
+	"\x06Candle\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\"\x99\x01\n" +
+	"\rSeriesRequest\x123\n" +
+	"\acandles\x18\x01 \x03(\v2\x19.techindicators.v1.CandleR\acandles\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x12;\n" +
+	"\n" +
+	"price_type\x18\x03 \x01(\x0e2\x1c.techindicators.v1.PriceTypeR\tpriceType\"W\n" +
+	"\x05Point\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"B\n" +
+	"\x0eSeriesResponse\x120\n" +
+	"\x06points\x18\x01 \x03(\v2\x18.techindicators.v1.PointR\x06points\"\xbc\x01\n" +
+	"\x10BollingerRequest\x123\n" +
+	"\acandles\x18\x01 \x03(\v2\x19.techindicators.v1.CandleR\acandles\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x03 \x01(\x01R\n" +
+	"multiplier\x12;\n" +
+	"\n" +
+	"price_type\x18\x04 \x01(\x0e2\x1c.techindicators.v1.PriceTypeR\tpriceType\"\xa3\x01\n" +
+	"\x04Band\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05upper\x18\x02 \x01(\x01R\x05upper\x12\x16\n" +
+	"\x06middle\x18\x03 \x01(\x01R\x06middle\x12\x14\n" +
+	"\x05lower\x18\x04 \x01(\x01R\x05lower\x12\x1d\n" +
+	"\n" +
+	"band_width\x18\x05 \x01(\x01R\tbandWidth\"B\n" +
+	"\x11BollingerResponse\x12-\n" +
+	"\x05bands\x18\x01 \x03(\v2\x17.techindicators.v1.BandR\x05bands\"\x8d\x02\n" +
+	"\x0eAnalysisConfig\x12\x1d\n" +
+	"\n" +
+	"sma_period\x18\x01 \x01(\x05R\tsmaPeriod\x12\x1b\n" +
+	"\tbb_period\x18\x02 \x01(\x05R\bbbPeriod\x12#\n" +
+	"\rbb_multiplier\x18\x03 \x01(\x01R\fbbMultiplier\x12\x1d\n" +
+	"\n" +
+	"rsi_period\x18\x04 \x01(\x05R\trsiPeriod\x12\x1d\n" +
+	"\n" +
+	"vma_period\x18\x05 \x01(\x05R\tvmaPeriod\x12\x1f\n" +
+	"\vvroc_period\x18\x06 \x01(\x05R\n" +
+	"vrocPeriod\x12;\n" +
+	"\n" +
+	"price_type\x18\a \x01(\x0e2\x1c.techindicators.v1.PriceTypeR\tpriceType\"\x81\x01\n" +
+	"\x0fAnalysisRequest\x123\n" +
+	"\acandles\x18\x01 \x03(\v2\x19.techindicators.v1.CandleR\acandles\x129\n" +
+	"\x06config\x18\x02 \x01(\v2!.techindicators.v1.AnalysisConfigR\x06config\"\xd3\x02\n" +
+	"\x15ComprehensiveAnalysis\x12\x1d\n" +
+	"\n" +
+	"sma_signal\x18\x01 \x01(\tR\tsmaSignal\x12)\n" +
+	"\x10bollinger_signal\x18\x02 \x01(\tR\x0fbollingerSignal\x12\x1d\n" +
+	"\n" +
+	"rsi_signal\x18\x03 \x01(\tR\trsiSignal\x12!\n" +
+	"\ffinal_signal\x18\x04 \x01(\tR\vfinalSignal\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\tR\n" +
+	"confidence\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x06 \x01(\tR\triskLevel\x12)\n" +
+	"\x10confidence_score\x18\a \x01(\x01R\x0fconfidenceScore\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\b \x01(\x01R\triskScore\x12%\n" +
+	"\x0eweighted_score\x18\t \x01(\x01R\rweightedScore\"\xb6\x03\n" +
+	"\x10UltimateAnalysis\x12F\n" +
+	"\ttechnical\x18\x01 \x01(\v2(.techindicators.v1.ComprehensiveAnalysisR\ttechnical\x12#\n" +
+	"\rvolume_signal\x18\x02 \x01(\tR\fvolumeSignal\x12!\n" +
+	"\fvolume_ratio\x18\x03 \x01(\x01R\vvolumeRatio\x12\x1b\n" +
+	"\tobv_trend\x18\x04 \x01(\tR\bobvTrend\x12!\n" +
+	"\ffinal_signal\x18\x05 \x01(\tR\vfinalSignal\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\tR\n" +
+	"confidence\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\a \x01(\tR\triskLevel\x12\"\n" +
+	"\rrug_pull_risk\x18\b \x01(\tR\vrugPullRisk\x12%\n" +
+	"\x0evolume_confirm\x18\t \x01(\bR\rvolumeConfirm\x12)\n" +
+	"\x10confidence_score\x18\n" +
+	" \x01(\x01R\x0fconfidenceScore\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\v \x01(\x01R\triskScore*\xbd\x01\n" +
+	"\tPriceType\x12\x14\n" +
+	"\x10PRICE_TYPE_CLOSE\x10\x00\x12\x13\n" +
+	"\x0fPRICE_TYPE_OPEN\x10\x01\x12\x13\n" +
+	"\x0fPRICE_TYPE_HIGH\x10\x02\x12\x12\n" +
+	"\x0ePRICE_TYPE_LOW\x10\x03\x12\x16\n" +
+	"\x12PRICE_TYPE_TYPICAL\x10\x04\x12\x17\n" +
+	"\x13PRICE_TYPE_WEIGHTED\x10\x05\x12\x15\n" +
+	"\x11PRICE_TYPE_MEDIAN\x10\x06\x12\x14\n" +
+	"\x10PRICE_TYPE_OHLC4\x10\a2\x97\x04\n" +
+	"\x10IndicatorService\x12J\n" +
+	"\x03SMA\x12 .techindicators.v1.SeriesRequest\x1a!.techindicators.v1.SeriesResponse\x12J\n" +
+	"\x03EMA\x12 .techindicators.v1.SeriesRequest\x1a!.techindicators.v1.SeriesResponse\x12J\n" +
+	"\x03RSI\x12 .techindicators.v1.SeriesRequest\x1a!.techindicators.v1.SeriesResponse\x12[\n" +
+	"\x0eBollingerBands\x12#.techindicators.v1.BollingerRequest\x1a$.techindicators.v1.BollingerResponse\x12e\n" +
+	"\x15ComprehensiveAnalysis\x12\".techindicators.v1.AnalysisRequest\x1a(.techindicators.v1.ComprehensiveAnalysis\x12[\n" +
+	"\x10UltimateAnalysis\x12\".techindicators.v1.AnalysisRequest\x1a#.techindicators.v1.UltimateAnalysisB4Z2github.com/luislaredovelazquez/techindicators/grpcb\x06proto3"
+
+var (
+	file_techindicators_proto_rawDescOnce sync.Once
+	file_techindicators_proto_rawDescData []byte
+)
+
+func file_techindicators_proto_rawDescGZIP() []byte {
+	file_techindicators_proto_rawDescOnce.Do(func() {
+		file_techindicators_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_techindicators_proto_rawDesc), len(file_techindicators_proto_rawDesc)))
+	})
+	return file_techindicators_proto_rawDescData
+}
+
+var file_techindicators_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_techindicators_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_techindicators_proto_goTypes = []any{
+	(PriceType)(0),                // 0: techindicators.v1.PriceType
+	(*Candle)(nil),                // 1: techindicators.v1.Candle
+	(*SeriesRequest)(nil),         // 2: techindicators.v1.SeriesRequest
+	(*Point)(nil),                 // 3: techindicators.v1.Point
+	(*SeriesResponse)(nil),        // 4: techindicators.v1.SeriesResponse
+	(*BollingerRequest)(nil),      // 5: techindicators.v1.BollingerRequest
+	(*Band)(nil),                  // 6: techindicators.v1.Band
+	(*BollingerResponse)(nil),     // 7: techindicators.v1.BollingerResponse
+	(*AnalysisConfig)(nil),        // 8: techindicators.v1.AnalysisConfig
+	(*AnalysisRequest)(nil),       // 9: techindicators.v1.AnalysisRequest
+	(*ComprehensiveAnalysis)(nil), // 10: techindicators.v1.ComprehensiveAnalysis
+	(*UltimateAnalysis)(nil),      // 11: techindicators.v1.UltimateAnalysis
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_techindicators_proto_depIdxs = []int32{
+	12, // 0: techindicators.v1.Candle.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 1: techindicators.v1.SeriesRequest.candles:type_name -> techindicators.v1.Candle
+	0,  // 2: techindicators.v1.SeriesRequest.price_type:type_name -> techindicators.v1.PriceType
+	12, // 3: techindicators.v1.Point.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 4: techindicators.v1.SeriesResponse.points:type_name -> techindicators.v1.Point
+	1,  // 5: techindicators.v1.BollingerRequest.candles:type_name -> techindicators.v1.Candle
+	0,  // 6: techindicators.v1.BollingerRequest.price_type:type_name -> techindicators.v1.PriceType
+	12, // 7: techindicators.v1.Band.timestamp:type_name -> google.protobuf.Timestamp
+	6,  // 8: techindicators.v1.BollingerResponse.bands:type_name -> techindicators.v1.Band
+	0,  // 9: techindicators.v1.AnalysisConfig.price_type:type_name -> techindicators.v1.PriceType
+	1,  // 10: techindicators.v1.AnalysisRequest.candles:type_name -> techindicators.v1.Candle
+	8,  // 11: techindicators.v1.AnalysisRequest.config:type_name -> techindicators.v1.AnalysisConfig
+	10, // 12: techindicators.v1.UltimateAnalysis.technical:type_name -> techindicators.v1.ComprehensiveAnalysis
+	2,  // 13: techindicators.v1.IndicatorService.SMA:input_type -> techindicators.v1.SeriesRequest
+	2,  // 14: techindicators.v1.IndicatorService.EMA:input_type -> techindicators.v1.SeriesRequest
+	2,  // 15: techindicators.v1.IndicatorService.RSI:input_type -> techindicators.v1.SeriesRequest
+	5,  // 16: techindicators.v1.IndicatorService.BollingerBands:input_type -> techindicators.v1.BollingerRequest
+	9,  // 17: techindicators.v1.IndicatorService.ComprehensiveAnalysis:input_type -> techindicators.v1.AnalysisRequest
+	9,  // 18: techindicators.v1.IndicatorService.UltimateAnalysis:input_type -> techindicators.v1.AnalysisRequest
+	4,  // 19: techindicators.v1.IndicatorService.SMA:output_type -> techindicators.v1.SeriesResponse
+	4,  // 20: techindicators.v1.IndicatorService.EMA:output_type -> techindicators.v1.SeriesResponse
+	4,  // 21: techindicators.v1.IndicatorService.RSI:output_type -> techindicators.v1.SeriesResponse
+	7,  // 22: techindicators.v1.IndicatorService.BollingerBands:output_type -> techindicators.v1.BollingerResponse
+	10, // 23: techindicators.v1.IndicatorService.ComprehensiveAnalysis:output_type -> techindicators.v1.ComprehensiveAnalysis
+	11, // 24: techindicators.v1.IndicatorService.UltimateAnalysis:output_type -> techindicators.v1.UltimateAnalysis
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_techindicators_proto_init() }
+func file_techindicators_proto_init() {
+	if File_techindicators_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_techindicators_proto_rawDesc), len(file_techindicators_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_techindicators_proto_goTypes,
+		DependencyIndexes: file_techindicators_proto_depIdxs,
+		EnumInfos:         file_techindicators_proto_enumTypes,
+		MessageInfos:      file_techindicators_proto_msgTypes,
+	}.Build()
+	File_techindicators_proto = out.File
+	file_techindicators_proto_goTypes = nil
+	file_techindicators_proto_depIdxs = nil
+}
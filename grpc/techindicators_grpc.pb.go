@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: techindicators.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	IndicatorService_SMA_FullMethodName                   = "/techindicators.v1.IndicatorService/SMA"
+	IndicatorService_EMA_FullMethodName                   = "/techindicators.v1.IndicatorService/EMA"
+	IndicatorService_RSI_FullMethodName                   = "/techindicators.v1.IndicatorService/RSI"
+	IndicatorService_BollingerBands_FullMethodName        = "/techindicators.v1.IndicatorService/BollingerBands"
+	IndicatorService_ComprehensiveAnalysis_FullMethodName = "/techindicators.v1.IndicatorService/ComprehensiveAnalysis"
+	IndicatorService_UltimateAnalysis_FullMethodName      = "/techindicators.v1.IndicatorService/UltimateAnalysis"
+)
+
+// IndicatorServiceClient is the client API for IndicatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// IndicatorService exposes the indicator functions and the combined analyses over gRPC.
+// Every request carries its own candles, oldest first.
+type IndicatorServiceClient interface {
+	SMA(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error)
+	EMA(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error)
+	RSI(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error)
+	BollingerBands(ctx context.Context, in *BollingerRequest, opts ...grpc.CallOption) (*BollingerResponse, error)
+	ComprehensiveAnalysis(ctx context.Context, in *AnalysisRequest, opts ...grpc.CallOption) (*ComprehensiveAnalysis, error)
+	UltimateAnalysis(ctx context.Context, in *AnalysisRequest, opts ...grpc.CallOption) (*UltimateAnalysis, error)
+}
+
+type indicatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIndicatorServiceClient(cc grpc.ClientConnInterface) IndicatorServiceClient {
+	return &indicatorServiceClient{cc}
+}
+
+func (c *indicatorServiceClient) SMA(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeriesResponse)
+	err := c.cc.Invoke(ctx, IndicatorService_SMA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indicatorServiceClient) EMA(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeriesResponse)
+	err := c.cc.Invoke(ctx, IndicatorService_EMA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indicatorServiceClient) RSI(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeriesResponse)
+	err := c.cc.Invoke(ctx, IndicatorService_RSI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indicatorServiceClient) BollingerBands(ctx context.Context, in *BollingerRequest, opts ...grpc.CallOption) (*BollingerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BollingerResponse)
+	err := c.cc.Invoke(ctx, IndicatorService_BollingerBands_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indicatorServiceClient) ComprehensiveAnalysis(ctx context.Context, in *AnalysisRequest, opts ...grpc.CallOption) (*ComprehensiveAnalysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComprehensiveAnalysis)
+	err := c.cc.Invoke(ctx, IndicatorService_ComprehensiveAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indicatorServiceClient) UltimateAnalysis(ctx context.Context, in *AnalysisRequest, opts ...grpc.CallOption) (*UltimateAnalysis, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UltimateAnalysis)
+	err := c.cc.Invoke(ctx, IndicatorService_UltimateAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IndicatorServiceServer is the server API for IndicatorService service.
+// All implementations must embed UnimplementedIndicatorServiceServer
+// for forward compatibility.
+//
+// IndicatorService exposes the indicator functions and the combined analyses over gRPC.
+// Every request carries its own candles, oldest first.
+type IndicatorServiceServer interface {
+	SMA(context.Context, *SeriesRequest) (*SeriesResponse, error)
+	EMA(context.Context, *SeriesRequest) (*SeriesResponse, error)
+	RSI(context.Context, *SeriesRequest) (*SeriesResponse, error)
+	BollingerBands(context.Context, *BollingerRequest) (*BollingerResponse, error)
+	ComprehensiveAnalysis(context.Context, *AnalysisRequest) (*ComprehensiveAnalysis, error)
+	UltimateAnalysis(context.Context, *AnalysisRequest) (*UltimateAnalysis, error)
+	mustEmbedUnimplementedIndicatorServiceServer()
+}
+
+// UnimplementedIndicatorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIndicatorServiceServer struct{}
+
+func (UnimplementedIndicatorServiceServer) SMA(context.Context, *SeriesRequest) (*SeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMA not implemented")
+}
+func (UnimplementedIndicatorServiceServer) EMA(context.Context, *SeriesRequest) (*SeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EMA not implemented")
+}
+func (UnimplementedIndicatorServiceServer) RSI(context.Context, *SeriesRequest) (*SeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RSI not implemented")
+}
+func (UnimplementedIndicatorServiceServer) BollingerBands(context.Context, *BollingerRequest) (*BollingerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BollingerBands not implemented")
+}
+func (UnimplementedIndicatorServiceServer) ComprehensiveAnalysis(context.Context, *AnalysisRequest) (*ComprehensiveAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComprehensiveAnalysis not implemented")
+}
+func (UnimplementedIndicatorServiceServer) UltimateAnalysis(context.Context, *AnalysisRequest) (*UltimateAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UltimateAnalysis not implemented")
+}
+func (UnimplementedIndicatorServiceServer) mustEmbedUnimplementedIndicatorServiceServer() {}
+func (UnimplementedIndicatorServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeIndicatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IndicatorServiceServer will
+// result in compilation errors.
+type UnsafeIndicatorServiceServer interface {
+	mustEmbedUnimplementedIndicatorServiceServer()
+}
+
+func RegisterIndicatorServiceServer(s grpc.ServiceRegistrar, srv IndicatorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIndicatorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IndicatorService_ServiceDesc, srv)
+}
+
+func _IndicatorService_SMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).SMA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_SMA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).SMA(ctx, req.(*SeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndicatorService_EMA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).EMA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_EMA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).EMA(ctx, req.(*SeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndicatorService_RSI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).RSI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_RSI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).RSI(ctx, req.(*SeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndicatorService_BollingerBands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BollingerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).BollingerBands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_BollingerBands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).BollingerBands(ctx, req.(*BollingerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndicatorService_ComprehensiveAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).ComprehensiveAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_ComprehensiveAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).ComprehensiveAnalysis(ctx, req.(*AnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IndicatorService_UltimateAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndicatorServiceServer).UltimateAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IndicatorService_UltimateAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndicatorServiceServer).UltimateAnalysis(ctx, req.(*AnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IndicatorService_ServiceDesc is the grpc.ServiceDesc for IndicatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IndicatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "techindicators.v1.IndicatorService",
+	HandlerType: (*IndicatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SMA",
+			Handler:    _IndicatorService_SMA_Handler,
+		},
+		{
+			MethodName: "EMA",
+			Handler:    _IndicatorService_EMA_Handler,
+		},
+		{
+			MethodName: "RSI",
+			Handler:    _IndicatorService_RSI_Handler,
+		},
+		{
+			MethodName: "BollingerBands",
+			Handler:    _IndicatorService_BollingerBands_Handler,
+		},
+		{
+			MethodName: "ComprehensiveAnalysis",
+			Handler:    _IndicatorService_ComprehensiveAnalysis_Handler,
+		},
+		{
+			MethodName: "UltimateAnalysis",
+			Handler:    _IndicatorService_UltimateAnalysis_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "techindicators.proto",
+}
@@ -0,0 +1,30 @@
+package techindicators
+
+// HeikinAshi converts candles to Heikin-Ashi candles, which smooth noise so trends are easier to read.
+// The first candle opens at the midpoint of its regular open and close; volume and timestamps are unchanged.
+func HeikinAshi(dataset []OHLCV) ([]OHLCV, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	result := make([]OHLCV, len(dataset))
+	for i, candle := range dataset {
+		haClose := (candle.Open + candle.High + candle.Low + candle.Close) / 4
+
+		haOpen := (candle.Open + candle.Close) / 2
+		if i > 0 {
+			haOpen = (result[i-1].Open + result[i-1].Close) / 2
+		}
+
+		result[i] = OHLCV{
+			Timestamp: candle.Timestamp,
+			Open:      haOpen,
+			High:      max(candle.High, haOpen, haClose),
+			Low:       min(candle.Low, haOpen, haClose),
+			Close:     haClose,
+			Volume:    candle.Volume,
+		}
+	}
+
+	return result, nil
+}
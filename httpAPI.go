@@ -0,0 +1,259 @@
+package techindicators
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// APIOptions configures NewAPIHandler
+type APIOptions struct {
+	Sources      map[string]DataSource // Data sources for symbol requests, keyed by name (defaults to NewDataSource)
+	MaxBodyBytes int64                 // Request body limit (default 10 MiB)
+	MaxCandles   int                   // Upper bound on the limit of a symbol request (default 5000)
+}
+
+// APIRequest is the input of every API endpoint: inline candles, or a symbol fetched from a source.
+// POST bodies are JSON; GET requests take the scalar fields as query parameters.
+type APIRequest struct {
+	OHLCV []OHLCV `json:"ohlcv,omitempty"`
+
+	Source   string `json:"source,omitempty"`   // binance, coinbase, kraken, geckoterminal, or a name from APIOptions.Sources
+	Symbol   string `json:"symbol,omitempty"`   // Source-specific market symbol
+	Interval string `json:"interval,omitempty"` // Candle interval such as 15m, 1h, or 1d (default 1h)
+	Limit    int    `json:"limit,omitempty"`    // Number of most recent candles to fetch (default 200)
+
+	Period     int       `json:"period,omitempty"`     // Indicator period (defaults to 14 for RSI and 20 otherwise)
+	Multiplier float64   `json:"multiplier,omitempty"` // Bollinger Bands multiplier (default 2)
+	PriceType  PriceType `json:"price_type,omitempty"`
+
+	// AnalysisConfig fields overriding DefaultAnalysisConfig for /volume, /comprehensive, and /ultimate
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// apiError is the body of an error response
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// apiEndpoint computes one endpoint's result from the request and its candles
+type apiEndpoint func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error)
+
+// apiHandler serves the endpoints registered by NewAPIHandler
+type apiHandler struct {
+	opts APIOptions
+}
+
+// NewAPIHandler returns an http.Handler exposing the indicators as JSON endpoints for dashboards and webhooks:
+// /sma, /ema, /rsi, /bollinger, /volume, /comprehensive, and /ultimate. Each responds with the package's
+// JSON-tagged result types; errors are {"error": "..."} with 400 for invalid input, 422 for too little data,
+// and 502 when the data source fails. Mount it under a prefix with http.StripPrefix.
+func NewAPIHandler(opts APIOptions) http.Handler {
+	if opts.MaxBodyBytes <= 0 {
+		opts.MaxBodyBytes = 10 << 20
+	}
+	if opts.MaxCandles <= 0 {
+		opts.MaxCandles = 5000
+	}
+	h := &apiHandler{opts: opts}
+
+	mux := http.NewServeMux()
+	mux.Handle("/sma", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		return CalculateSMA(dataset, defaultInt(req.Period, 20), req.PriceType)
+	}))
+	mux.Handle("/ema", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		return CalculateEMA(dataset, defaultInt(req.Period, 20), req.PriceType)
+	}))
+	mux.Handle("/rsi", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		return CalculateRSI(dataset, defaultInt(req.Period, 14), req.PriceType)
+	}))
+	mux.Handle("/bollinger", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		multiplier := req.Multiplier
+		if multiplier == 0 {
+			multiplier = 2
+		}
+		return CalculateBollingerBands(dataset, defaultInt(req.Period, 20), multiplier, req.PriceType)
+	}))
+	mux.Handle("/volume", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		config, err := req.analysisConfig()
+		if err != nil {
+			return nil, err
+		}
+		return AnalyzeVolumeStrategy(dataset, config.VMAPeriod, config.VROCPeriod)
+	}))
+	mux.Handle("/comprehensive", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		config, err := req.analysisConfig()
+		if err != nil {
+			return nil, err
+		}
+		return ComprehensiveAnalysisContext(ctx, dataset, config)
+	}))
+	mux.Handle("/ultimate", h.endpoint(func(ctx context.Context, req APIRequest, dataset []OHLCV) (any, error) {
+		config, err := req.analysisConfig()
+		if err != nil {
+			return nil, err
+		}
+		return UltimateAnalysisContext(ctx, dataset, config)
+	}))
+	return mux
+}
+
+// endpoint wraps a calculation with request decoding, data loading, and JSON encoding
+func (h *apiHandler) endpoint(calculate apiEndpoint) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req APIRequest
+		switch r.Method {
+		case http.MethodGet:
+			var err error
+			if req, err = apiRequestFromQuery(r.URL.Query()); err != nil {
+				writeAPIError(w, err)
+				return
+			}
+		case http.MethodPost:
+			decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.opts.MaxBodyBytes))
+			if err := decoder.Decode(&req); err != nil {
+				writeAPIError(w, fmt.Errorf("%w: request body: %w", ErrInvalidDataset, err))
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeAPIJSON(w, http.StatusMethodNotAllowed, apiError{Error: "method not allowed"})
+			return
+		}
+
+		dataset, err := h.dataset(r.Context(), req)
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+
+		result, err := calculate(r.Context(), req, dataset)
+		if err != nil {
+			writeAPIError(w, err)
+			return
+		}
+		writeAPIJSON(w, http.StatusOK, result)
+	})
+}
+
+// dataset returns the inline candles or fetches the requested symbol
+func (h *apiHandler) dataset(ctx context.Context, req APIRequest) ([]OHLCV, error) {
+	if len(req.OHLCV) > 0 {
+		return req.OHLCV, ValidateOHLCV(req.OHLCV).Err()
+	}
+	if req.Source == "" || req.Symbol == "" {
+		return nil, invalidParameter("either ohlcv or source and symbol are required")
+	}
+
+	source, ok := h.opts.Sources[req.Source]
+	if !ok {
+		var err error
+		if source, err = NewDataSource(req.Source); err != nil {
+			return nil, err
+		}
+	}
+
+	interval, err := ParseInterval(defaultString(req.Interval, "1h"))
+	if err != nil {
+		return nil, invalidParameter("interval %q: %v", req.Interval, err)
+	}
+	limit := defaultInt(req.Limit, 200)
+	if limit < 0 || limit > h.opts.MaxCandles {
+		return nil, invalidParameter("limit must be between 1 and %d, got %d", h.opts.MaxCandles, limit)
+	}
+
+	return source.FetchOHLCV(ctx, FetchRequest{Symbol: req.Symbol, Interval: interval, Limit: limit})
+}
+
+// analysisConfig overlays the request's config on DefaultAnalysisConfig
+func (req APIRequest) analysisConfig() (AnalysisConfig, error) {
+	config := DefaultAnalysisConfig()
+	config.PriceType = req.PriceType
+	if len(req.Config) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(req.Config))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&config); err != nil {
+			return AnalysisConfig{}, invalidParameter("config: %v", err)
+		}
+	}
+	return config, nil
+}
+
+// apiRequestFromQuery reads the scalar request fields from GET query parameters
+func apiRequestFromQuery(query url.Values) (APIRequest, error) {
+	req := APIRequest{
+		Source:   query.Get("source"),
+		Symbol:   query.Get("symbol"),
+		Interval: query.Get("interval"),
+	}
+
+	var err error
+	for name, target := range map[string]*int{"limit": &req.Limit, "period": &req.Period} {
+		if value := query.Get(name); value != "" {
+			if *target, err = strconv.Atoi(value); err != nil {
+				return APIRequest{}, invalidParameter("%s: %v", name, err)
+			}
+		}
+	}
+	if value := query.Get("multiplier"); value != "" {
+		if req.Multiplier, err = strconv.ParseFloat(value, 64); err != nil {
+			return APIRequest{}, invalidParameter("multiplier: %v", err)
+		}
+	}
+	if value := query.Get("price_type"); value != "" {
+		priceType, err := strconv.Atoi(value)
+		if err != nil {
+			return APIRequest{}, invalidParameter("price_type: %v", err)
+		}
+		req.PriceType = PriceType(priceType)
+	}
+	return req, nil
+}
+
+// writeAPIError responds with the error and the status code matching its kind
+func writeAPIError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrInsufficientData{}), errors.Is(err, ErrEmptyDataset):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, ErrInvalidPeriod), errors.Is(err, ErrInvalidParameter), errors.Is(err, ErrInvalidPrice),
+		errors.Is(err, ErrInvalidDataset), errors.Is(err, ErrMissingValue):
+		status = http.StatusBadRequest
+	case errors.Is(err, ErrHTTPStatus{}):
+		status = http.StatusBadGateway
+	case errors.Is(err, context.DeadlineExceeded):
+		status = http.StatusGatewayTimeout
+	}
+	writeAPIJSON(w, status, apiError{Error: err.Error()})
+}
+
+// writeAPIJSON writes a JSON response
+func writeAPIJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// defaultInt returns value, or fallback when it is zero
+func defaultInt(value, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+	return value
+}
+
+// defaultString returns value, or fallback when it is empty
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,324 @@
+package techindicators
+
+import (
+	"fmt"
+	"time"
+)
+
+// Point represents a single indicator output aligned to a candle
+type Point struct {
+	Timestamp  time.Time          `json:"timestamp"`
+	Value      float64            `json:"value"`                // Primary value of the indicator
+	Components map[string]float64 `json:"components,omitempty"` // Secondary outputs (e.g. upper/lower bands)
+}
+
+// Indicator is implemented by every series indicator so they can be composed and iterated generically
+type Indicator interface {
+	// Name identifies the indicator and its parameters, e.g. "SMA(20)"
+	Name() string
+	// MinPeriods is the number of candles needed to produce the first point
+	MinPeriods() int
+	// Compute calculates the indicator series for the dataset
+	Compute(dataset []OHLCV) ([]Point, error)
+}
+
+// MinCandles returns the number of candles needed for every indicator to produce at least one point
+func MinCandles(indicators ...Indicator) int {
+	required := 0
+	for _, indicator := range indicators {
+		required = max(required, indicator.MinPeriods())
+	}
+	return required
+}
+
+// SMAIndicator adapts CalculateSMA to the Indicator interface
+type SMAIndicator struct {
+	Period    int
+	PriceType PriceType
+}
+
+func (i SMAIndicator) Name() string    { return fmt.Sprintf("SMA(%d)", i.Period) }
+func (i SMAIndicator) MinPeriods() int { return i.Period }
+
+func (i SMAIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateSMA(dataset, i.Period, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return smaPoints(results), nil
+}
+
+// EMAIndicator adapts CalculateEMA to the Indicator interface
+type EMAIndicator struct {
+	Period    int
+	PriceType PriceType
+}
+
+func (i EMAIndicator) Name() string    { return fmt.Sprintf("EMA(%d)", i.Period) }
+func (i EMAIndicator) MinPeriods() int { return i.Period }
+
+func (i EMAIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateEMA(dataset, i.Period, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return smaPoints(results), nil
+}
+
+// RSIIndicator adapts CalculateRSI to the Indicator interface
+type RSIIndicator struct {
+	Period    int
+	PriceType PriceType
+}
+
+func (i RSIIndicator) Name() string    { return fmt.Sprintf("RSI(%d)", i.Period) }
+func (i RSIIndicator) MinPeriods() int { return i.Period + 1 }
+
+func (i RSIIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateRSI(dataset, i.Period, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return rsiPoints(results), nil
+}
+
+// BollingerIndicator adapts CalculateBollingerBands to the Indicator interface.
+// The primary value is the middle band; upper, lower, and band width are components.
+type BollingerIndicator struct {
+	Period     int
+	Multiplier float64
+	PriceType  PriceType
+}
+
+func (i BollingerIndicator) Name() string {
+	return fmt.Sprintf("BB(%d,%g)", i.Period, i.Multiplier)
+}
+func (i BollingerIndicator) MinPeriods() int { return i.Period }
+
+func (i BollingerIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateBollingerBands(dataset, i.Period, i.Multiplier, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	return bollingerPoints(results), nil
+}
+
+// VolumeIndicator adapts CalculateVolumeAnalysis to the Indicator interface.
+// The primary value is the volume moving average; OBV, VPT, VROC, and ADL are components.
+type VolumeIndicator struct {
+	VMAPeriod  int
+	VROCPeriod int
+}
+
+func (i VolumeIndicator) Name() string {
+	return fmt.Sprintf("VOLUME(%d,%d)", i.VMAPeriod, i.VROCPeriod)
+}
+
+func (i VolumeIndicator) MinPeriods() int {
+	if i.VROCPeriod > i.VMAPeriod {
+		return i.VROCPeriod + 1
+	}
+	return i.VMAPeriod + 1
+}
+
+func (i VolumeIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateVolumeAnalysis(dataset, i.VMAPeriod, i.VROCPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumePoints(results), nil
+}
+
+// ADXIndicator adapts CalculateADX to the Indicator interface.
+// The primary value is the ADX; +DI and -DI are components.
+type ADXIndicator struct {
+	Period int
+}
+
+func (i ADXIndicator) Name() string    { return fmt.Sprintf("ADX(%d)", i.Period) }
+func (i ADXIndicator) MinPeriods() int { return 2 * i.Period }
+
+func (i ADXIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateADX(dataset, i.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp:  r.Timestamp,
+			Value:      r.ADX,
+			Components: map[string]float64{"plus_di": r.PlusDI, "minus_di": r.MinusDI},
+		}
+	}
+	return points, nil
+}
+
+// UlcerIndexIndicator adapts CalculateUlcerIndex to the Indicator interface
+type UlcerIndexIndicator struct {
+	Period    int
+	PriceType PriceType
+}
+
+func (i UlcerIndexIndicator) Name() string    { return fmt.Sprintf("ULCER(%d)", i.Period) }
+func (i UlcerIndexIndicator) MinPeriods() int { return i.Period }
+
+func (i UlcerIndexIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateUlcerIndex(dataset, i.Period, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{Timestamp: r.Timestamp, Value: r.Value}
+	}
+	return points, nil
+}
+
+// VolatilityEstimator selects the volatility estimator used by VolatilityIndicator
+type VolatilityEstimator string
+
+const (
+	CloseToCloseEstimator VolatilityEstimator = "close_to_close"
+	ParkinsonEstimator    VolatilityEstimator = "parkinson"
+	GarmanKlassEstimator  VolatilityEstimator = "garman_klass"
+)
+
+// VolatilityIndicator adapts the annualized volatility estimators to the Indicator interface
+type VolatilityIndicator struct {
+	Window        int
+	Annualization float64
+	Estimator     VolatilityEstimator // Defaults to close-to-close
+}
+
+func (i VolatilityIndicator) Name() string {
+	estimator := i.Estimator
+	if estimator == "" {
+		estimator = CloseToCloseEstimator
+	}
+	return fmt.Sprintf("VOL_%s(%d)", estimator, i.Window)
+}
+
+func (i VolatilityIndicator) MinPeriods() int {
+	if i.Estimator == ParkinsonEstimator || i.Estimator == GarmanKlassEstimator {
+		return i.Window
+	}
+	return i.Window + 1
+}
+
+func (i VolatilityIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	var results []VolatilityResult
+	var err error
+
+	switch i.Estimator {
+	case "", CloseToCloseEstimator:
+		results, err = CalculateRollingVolatility(dataset, i.Window, i.Annualization)
+	case ParkinsonEstimator:
+		results, err = CalculateParkinsonVolatility(dataset, i.Window, i.Annualization)
+	case GarmanKlassEstimator:
+		results, err = CalculateGarmanKlassVolatility(dataset, i.Window, i.Annualization)
+	default:
+		return nil, invalidParameter("unknown volatility estimator: %s", i.Estimator)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{Timestamp: r.Timestamp, Value: r.Value}
+	}
+	return points, nil
+}
+
+// SharpeIndicator adapts CalculateSharpeFromOHLCV to the Indicator interface.
+// The primary value is the per-candle Sharpe ratio.
+type SharpeIndicator struct {
+	Window   int
+	RiskFree float64
+}
+
+func (i SharpeIndicator) Name() string    { return fmt.Sprintf("SHARPE(%d)", i.Window) }
+func (i SharpeIndicator) MinPeriods() int { return i.Window + 1 }
+
+func (i SharpeIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateSharpeFromOHLCV(dataset, i.RiskFree, i.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp: r.Timestamp,
+			Value:     r.SharpeRatio,
+			Components: map[string]float64{
+				"annual_sharpe_ratio": r.AnnualSharpeRatio,
+				"avg_return":          r.AvgReturn,
+				"volatility":          r.Volatility,
+			},
+		}
+	}
+	return points, nil
+}
+
+// smaPoints converts moving average results to indicator points
+func smaPoints(results []SMAResult) []Point {
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{Timestamp: r.Timestamp, Value: r.Value}
+	}
+	return points
+}
+
+// rsiPoints converts RSI results to indicator points
+func rsiPoints(results []RSIResult) []Point {
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{Timestamp: r.Timestamp, Value: r.Value}
+	}
+	return points
+}
+
+// bollingerPoints converts band results to points with the middle band as the primary value
+func bollingerPoints(results []BollingerBands) []Point {
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp: r.Timestamp,
+			Value:     r.MiddleBand,
+			Components: map[string]float64{
+				"upper":      r.UpperBand,
+				"lower":      r.LowerBand,
+				"band_width": r.BandWidth,
+			},
+		}
+	}
+	return points
+}
+
+// volumePoints converts volume results to points with the VMA as the primary value
+func volumePoints(results []VolumeResult) []Point {
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp: r.Timestamp,
+			Value:     r.VMA,
+			Components: map[string]float64{
+				"volume": r.Volume,
+				"obv":    r.OBV,
+				"vpt":    r.VPT,
+				"vroc":   r.VROC,
+				"adl":    r.ADL,
+			},
+		}
+	}
+	return points
+}
@@ -0,0 +1,157 @@
+package techindicators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InfluxWriter writes candles and indicator series to InfluxDB 2.x (or 3.x's v2-compatible endpoint)
+// in line protocol. Candles go to the "ohlcv" measurement and indicators to "indicator", tagged with
+// symbol, interval, and for indicators the indicator name and parameters.
+type InfluxWriter struct {
+	URL        string // Server URL, e.g. http://localhost:8086
+	HTTPClient *http.Client
+	Token      string
+	Org        string
+	Bucket     string
+	BatchSize  int // Lines per write request (default 5000)
+}
+
+// NewInfluxWriter returns a writer for the bucket
+func NewInfluxWriter(serverURL, token, org, bucket string) *InfluxWriter {
+	return &InfluxWriter{URL: serverURL, Token: token, Org: org, Bucket: bucket}
+}
+
+// WriteCandles writes each candle as an ohlcv point
+func (w *InfluxWriter) WriteCandles(ctx context.Context, symbol string, interval time.Duration, candles []OHLCV) error {
+	tags := influxTags(map[string]string{"symbol": symbol, "interval": FormatInterval(interval)})
+
+	lines := make([]string, 0, len(candles))
+	for _, candle := range candles {
+		fields := influxFields(map[string]float64{
+			"open":   candle.Open,
+			"high":   candle.High,
+			"low":    candle.Low,
+			"close":  candle.Close,
+			"volume": candle.Volume,
+		})
+		if fields == "" {
+			continue
+		}
+		lines = append(lines, "ohlcv"+tags+" "+fields+" "+strconv.FormatInt(candle.Timestamp.UnixMilli(), 10))
+	}
+	return w.write(ctx, lines)
+}
+
+// WriteIndicator writes each point as an indicator point with a value field and one field per component
+func (w *InfluxWriter) WriteIndicator(ctx context.Context, symbol string, interval time.Duration, indicator string, params IndicatorParams, points []Point) error {
+	tags := influxTags(map[string]string{
+		"symbol":    symbol,
+		"interval":  FormatInterval(interval),
+		"indicator": indicator,
+		"params":    formatIndicatorParams(params),
+	})
+
+	lines := make([]string, 0, len(points))
+	for _, point := range points {
+		values := make(map[string]float64, len(point.Components)+1)
+		for name, value := range point.Components {
+			values[name] = value
+		}
+		values["value"] = point.Value
+
+		fields := influxFields(values)
+		if fields == "" {
+			continue
+		}
+		lines = append(lines, "indicator"+tags+" "+fields+" "+strconv.FormatInt(point.Timestamp.UnixMilli(), 10))
+	}
+	return w.write(ctx, lines)
+}
+
+// write posts the lines in batches with millisecond precision
+func (w *InfluxWriter) write(ctx context.Context, lines []string) error {
+	if w.URL == "" || w.Bucket == "" {
+		return invalidParameter("influxdb URL and bucket are required")
+	}
+	batchSize := w.BatchSize
+	if batchSize <= 0 {
+		batchSize = 5000
+	}
+
+	query := url.Values{"bucket": {w.Bucket}, "precision": {"ms"}}
+	if w.Org != "" {
+		query.Set("org", w.Org)
+	}
+	endpoint := strings.TrimRight(w.URL, "/") + "/api/v2/write?" + query.Encode()
+
+	for start := 0; start < len(lines); start += batchSize {
+		body := strings.Join(lines[start:min(start+batchSize, len(lines))], "\n")
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewBufferString(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+		if w.Token != "" {
+			req.Header.Set("Authorization", "Token "+w.Token)
+		}
+
+		if err := doRequest(w.HTTPClient, "influxdb", req); err != nil {
+			return fmt.Errorf("writing lines %d-%d: %w", start, min(start+batchSize, len(lines))-1, err)
+		}
+	}
+	return nil
+}
+
+// influxTags renders the tag set (with its leading comma) sorted by key, skipping empty values
+func influxTags(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		if value != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, key := range keys {
+		b.WriteByte(',')
+		b.WriteString(influxEscape(key))
+		b.WriteByte('=')
+		b.WriteString(influxEscape(tags[key]))
+	}
+	return b.String()
+}
+
+// influxFields renders the float field set sorted by key; NaN and infinite values are left out
+func influxFields(fields map[string]float64) string {
+	keys := make([]string, 0, len(fields))
+	for key, value := range fields {
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = influxEscape(key) + "=" + strconv.FormatFloat(fields[key], 'g', -1, 64)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// influxEscaper escapes the characters line protocol reserves in tag keys, tag values, and field keys
+var influxEscaper = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+
+// influxEscape escapes a tag key, tag value, or field key
+func influxEscape(s string) string {
+	return influxEscaper.Replace(s)
+}
@@ -0,0 +1,137 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// krakenIntervals maps candle intervals to Kraken OHLC interval minutes
+var krakenIntervals = map[time.Duration]string{
+	time.Minute:         "1",
+	5 * time.Minute:     "5",
+	15 * time.Minute:    "15",
+	30 * time.Minute:    "30",
+	time.Hour:           "60",
+	4 * time.Hour:       "240",
+	24 * time.Hour:      "1440",
+	7 * 24 * time.Hour:  "10080",
+	15 * 24 * time.Hour: "21600",
+}
+
+// KrakenClient fetches candles from the public Kraken OHLC endpoint
+type KrakenClient struct {
+	BaseURL    string       // Defaults to https://api.kraken.com
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+}
+
+// NewKrakenClient creates a Kraken client for the public market endpoints
+func NewKrakenClient() *KrakenClient {
+	return &KrakenClient{BaseURL: "https://api.kraken.com"}
+}
+
+// Name returns the data source name
+func (c *KrakenClient) Name() string {
+	return "kraken"
+}
+
+// FetchOHLCV downloads candles for a pair such as "XBTUSD". Kraken serves at most the 720 most recent
+// candles per interval; with a Start the since cursor is followed forwards until End or Limit is reached,
+// otherwise the most recent Limit candles of that window are returned.
+func (c *KrakenClient) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	if req.Symbol == "" {
+		return nil, invalidParameter("pair is required")
+	}
+	interval, err := sourceInterval(c.Name(), req.Interval, krakenIntervals)
+	if err != nil {
+		return nil, err
+	}
+
+	var candles []OHLCV
+	since := int64(0)
+	if !req.Start.IsZero() {
+		since = req.Start.Unix() - 1 // since is exclusive
+	}
+	for {
+		page, last, err := c.ohlc(ctx, req.Symbol, interval, since)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, page...)
+		if req.Start.IsZero() || len(page) == 0 || last <= since || (req.Limit > 0 && len(candles) >= req.Limit) ||
+			(!req.End.IsZero() && !page[len(page)-1].Timestamp.Before(req.End)) {
+			break
+		}
+		since = last
+	}
+
+	candles = dedupeCandles(candles)
+	out := candles[:0]
+	for _, candle := range candles {
+		if (req.Start.IsZero() || !candle.Timestamp.Before(req.Start)) && (req.End.IsZero() || !candle.Timestamp.After(req.End)) {
+			out = append(out, candle)
+		}
+	}
+	candles = out
+
+	if req.Limit > 0 && len(candles) > req.Limit {
+		if req.Start.IsZero() {
+			return candles[len(candles)-req.Limit:], nil
+		}
+		return candles[:req.Limit], nil
+	}
+	return candles, nil
+}
+
+// ohlc requests one page of candles and returns the cursor for the next one
+func (c *KrakenClient) ohlc(ctx context.Context, pair, interval string, since int64) ([]OHLCV, int64, error) {
+	query := url.Values{}
+	query.Set("pair", strings.ToUpper(pair))
+	query.Set("interval", interval)
+	if since > 0 {
+		query.Set("since", strconv.FormatInt(since, 10))
+	}
+
+	var resp struct {
+		Error  []string                   `json:"error"`
+		Result map[string]json.RawMessage `json:"result"`
+	}
+	if err := getJSON(ctx, c.HTTPClient, c.Name(), strings.TrimRight(c.BaseURL, "/")+"/0/public/OHLC?"+query.Encode(), nil, &resp); err != nil {
+		return nil, 0, err
+	}
+	if len(resp.Error) > 0 {
+		return nil, 0, fmt.Errorf("%s: %s", c.Name(), strings.Join(resp.Error, "; "))
+	}
+
+	// The result holds the candles under the canonical pair name (e.g. XXBTZUSD) next to the "last" cursor
+	var last int64
+	var candles []OHLCV
+	for key, raw := range resp.Result {
+		if key == "last" {
+			if err := json.Unmarshal(raw, &last); err != nil {
+				return nil, 0, fmt.Errorf("%s: cursor: %w", c.Name(), err)
+			}
+			continue
+		}
+
+		var rows [][]json.RawMessage
+		if err := json.Unmarshal(raw, &rows); err != nil {
+			return nil, 0, fmt.Errorf("%s: decoding candles: %w", c.Name(), err)
+		}
+		// Each row is [time, open, high, low, close, vwap, volume, count] with prices as strings
+		for _, row := range rows {
+			candle, err := parseJSONRow(row, [6]int{0, 1, 2, 3, 4, 6}, time.Second)
+			if err != nil {
+				return nil, 0, fmt.Errorf("%s: %w", c.Name(), err)
+			}
+			candle.Trades = jsonCount(row, 7)
+			candles = append(candles, candle)
+		}
+	}
+	return candles, last, nil
+}
@@ -0,0 +1,153 @@
+package techindicators
+
+import (
+	"math"
+	"time"
+)
+
+// LiquidationCascade is a candle whose wick, volume, and retrace look like forced liquidations rather
+// than an organic breakdown or breakout
+type LiquidationCascade struct {
+	Index       int       `json:"index"`
+	Timestamp   time.Time `json:"timestamp"`
+	Signal      Signal    `json:"signal"`       // SignalLongLiquidation for a flush down, SignalShortLiquidation for a squeeze up
+	Wick        float64   `json:"wick"`         // Signed move from the open to the extreme, relative to the open
+	VolumeRatio float64   `json:"volume_ratio"` // Volume relative to the average of the preceding candles
+	Retrace     float64   `json:"retrace"`      // Share of the wick recovered by the close or the following candles, above 1 past the open
+}
+
+// LiquidationConfig configures DetectLiquidationCascades. Zero fields take the defaults in brackets.
+type LiquidationConfig struct {
+	VolumePeriod   int     `json:"volume_period"`   // Preceding candles the volume is compared against [20]
+	VolumeSpike    float64 `json:"volume_spike"`    // Volume ratio of a cascade [3]
+	MinWick        float64 `json:"min_wick"`        // Open-to-extreme move of a cascade [0.05]
+	MinRetrace     float64 `json:"min_retrace"`     // Share of the wick that must be recovered [0.3]
+	RetraceCandles int     `json:"retrace_candles"` // Following candles that may complete the retrace [1]
+}
+
+// DefaultLiquidationConfig returns the thresholds UltimateAnalysis uses by default
+func DefaultLiquidationConfig() LiquidationConfig {
+	return LiquidationConfig{
+		VolumePeriod:   20,
+		VolumeSpike:    3,
+		MinWick:        0.05,
+		MinRetrace:     0.3,
+		RetraceCandles: 1,
+	}
+}
+
+// withDefaults fills zero fields
+func (c LiquidationConfig) withDefaults() LiquidationConfig {
+	defaults := DefaultLiquidationConfig()
+	if c.VolumePeriod == 0 {
+		c.VolumePeriod = defaults.VolumePeriod
+	}
+	if c.VolumeSpike == 0 {
+		c.VolumeSpike = defaults.VolumeSpike
+	}
+	if c.MinWick == 0 {
+		c.MinWick = defaults.MinWick
+	}
+	if c.MinRetrace == 0 {
+		c.MinRetrace = defaults.MinRetrace
+	}
+	if c.RetraceCandles == 0 {
+		c.RetraceCandles = defaults.RetraceCandles
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c LiquidationConfig) validate() error {
+	switch {
+	case c.VolumePeriod < 1:
+		return invalidPeriod("liquidation volume period must be greater than 0, got %d", c.VolumePeriod)
+	case c.VolumeSpike < 0 || c.MinWick < 0 || c.RetraceCandles < 0:
+		return invalidParameter("liquidation thresholds must not be negative")
+	case c.MinRetrace < 0 || c.MinRetrace > 1:
+		return invalidParameter("liquidation min retrace must be between 0 and 1, got %v", c.MinRetrace)
+	}
+	return nil
+}
+
+// DetectLiquidationCascades finds candles consistent with liquidation cascades: a rapid wick of at least
+// MinWick from the open, volume at least VolumeSpike times the preceding average, and an immediate partial
+// retrace of at least MinRetrace of the wick by the candle's close or within RetraceCandles candles.
+// A breakdown that closes near its low is organic and not reported. Results are in dataset order.
+func DetectLiquidationCascades(dataset []OHLCV, config LiquidationConfig) ([]LiquidationCascade, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if len(dataset) <= config.VolumePeriod {
+		return nil, ErrInsufficientData{Need: config.VolumePeriod + 1, Have: len(dataset)}
+	}
+
+	// Rolling volume sum over the preceding candles; missing volume counts as 0
+	volume := func(candle OHLCV) float64 {
+		if math.IsNaN(candle.Volume) {
+			return 0
+		}
+		return candle.Volume
+	}
+	var cascades []LiquidationCascade
+	volumeSum := 0.0
+	for _, candle := range dataset[:config.VolumePeriod] {
+		volumeSum += volume(candle)
+	}
+	for i := config.VolumePeriod; i < len(dataset); i++ {
+		candle := dataset[i]
+		averageVolume := volumeSum / float64(config.VolumePeriod)
+		volumeSum += volume(candle) - volume(dataset[i-config.VolumePeriod])
+
+		if candle.Open <= 0 || averageVolume <= 0 || math.IsNaN(candle.Volume) {
+			continue
+		}
+		ratio := candle.Volume / averageVolume
+		if ratio < config.VolumeSpike {
+			continue
+		}
+
+		down, up := (candle.Open-candle.Low)/candle.Open, (candle.High-candle.Open)/candle.Open
+		cascade := LiquidationCascade{Index: i, Timestamp: candle.Timestamp, VolumeRatio: ratio}
+		last := min(len(dataset)-1, i+config.RetraceCandles)
+		switch {
+		case down >= config.MinWick && down >= up:
+			cascade.Signal, cascade.Wick = SignalLongLiquidation, -down
+			for _, c := range dataset[i : last+1] {
+				cascade.Retrace = math.Max(cascade.Retrace, (c.Close-candle.Low)/(candle.Open-candle.Low))
+			}
+		case up >= config.MinWick:
+			cascade.Signal, cascade.Wick = SignalShortLiquidation, up
+			for _, c := range dataset[i : last+1] {
+				cascade.Retrace = math.Max(cascade.Retrace, (candle.High-c.Close)/(candle.High-candle.Open))
+			}
+		default:
+			continue
+		}
+		if cascade.Retrace >= config.MinRetrace {
+			cascades = append(cascades, cascade)
+		}
+	}
+	return cascades, nil
+}
+
+// applyLiquidation gives the analysis the cascade's signal when it is among the latest candles: the
+// move was forced and tends to revert, so it should not be traded as a breakdown or breakout
+func applyLiquidation(analysis *UltimateMemecoinAnalysis, cascades []LiquidationCascade, candles int, config LiquidationConfig) {
+	if len(cascades) == 0 {
+		return
+	}
+	latest := cascades[len(cascades)-1]
+	if latest.Index < candles-1-config.withDefaults().RetraceCandles {
+		return
+	}
+
+	analysis.Liquidation = &latest
+	analysis.FinalSignal = latest.Signal
+	analysis.RiskLevel = "HIGH"
+	analysis.RiskScore = math.Max(analysis.RiskScore, 0.7)
+}
@@ -0,0 +1,238 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// LiquiditySample is the liquidity of a token at Timestamp, e.g. the pool TVL or the order book depth in USD
+type LiquiditySample struct {
+	Timestamp time.Time `json:"timestamp"`
+	USD       float64   `json:"usd"`
+}
+
+// LiquidityResult represents liquidity analysis result
+type LiquidityResult struct {
+	Timestamp time.Time `json:"timestamp"`
+	Liquidity float64   `json:"liquidity"` // Latest sample by the candle's close
+	MA        float64   `json:"ma"`        // Moving average of the liquidity
+	Ratio     float64   `json:"ratio"`     // Liquidity relative to the moving average, 0 when it is 0
+	Change    float64   `json:"change"`    // Relative change from the previous candle
+}
+
+// LiquidityDrop is a candle whose liquidity fell suddenly, as when a pool is drained or an order book pulled
+type LiquidityDrop struct {
+	Index     int       `json:"index"`
+	Timestamp time.Time `json:"timestamp"`
+	Before    float64   `json:"before"`    // Highest liquidity of the preceding candles
+	Liquidity float64   `json:"liquidity"` // Liquidity after the drop
+	Drop      float64   `json:"drop"`      // Share of the liquidity removed, 0-1
+}
+
+// LiquidityConfig configures DetectLiquidityDrops. Zero fields take the defaults in brackets.
+type LiquidityConfig struct {
+	DropShare   float64 `json:"drop_share"`   // Share of the liquidity that must be removed [0.2]
+	DropCandles int     `json:"drop_candles"` // Preceding candles the liquidity is compared against [3]
+}
+
+// DefaultLiquidityConfig returns the standard sudden drop thresholds (20% within 3 candles)
+func DefaultLiquidityConfig() LiquidityConfig {
+	return LiquidityConfig{
+		DropShare:   0.2,
+		DropCandles: 3,
+	}
+}
+
+// withDefaults fills zero fields
+func (c LiquidityConfig) withDefaults() LiquidityConfig {
+	defaults := DefaultLiquidityConfig()
+	if c.DropShare == 0 {
+		c.DropShare = defaults.DropShare
+	}
+	if c.DropCandles == 0 {
+		c.DropCandles = defaults.DropCandles
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c LiquidityConfig) validate() error {
+	switch {
+	case c.DropCandles < 1:
+		return invalidPeriod("liquidity drop candles must be greater than 0, got %d", c.DropCandles)
+	case c.DropShare < 0 || c.DropShare > 1:
+		return invalidParameter("liquidity drop share must be between 0 and 1, got %v", c.DropShare)
+	}
+	return nil
+}
+
+// validateLiquidity checks that the samples are in ascending time order with finite, non-negative values
+func validateLiquidity(samples []LiquiditySample) error {
+	for i, sample := range samples {
+		if !(sample.USD >= 0) || math.IsInf(sample.USD, 0) {
+			return invalidParameter("liquidity at %s must be finite and not negative, got %v", sample.Timestamp, sample.USD)
+		}
+		if i > 0 && sample.Timestamp.Before(samples[i-1].Timestamp) {
+			return invalidParameter("liquidity samples must be in ascending time order")
+		}
+	}
+	return nil
+}
+
+// AlignLiquidity takes for each candle the latest liquidity sample before its close, the next candle's
+// open; the last candle closes an interval after its open, and a lone candle takes every sample. Candles
+// before the first sample get NaN.
+func AlignLiquidity(dataset []OHLCV, samples []LiquiditySample) ([]float64, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if err := validateLiquidity(samples); err != nil {
+		return nil, err
+	}
+
+	end, bounded := datasetEnd(dataset)
+	liquidity := make([]float64, len(dataset))
+	next := 0
+	for i := range dataset {
+		closed := func(t time.Time) bool {
+			switch {
+			case i+1 < len(dataset):
+				return t.Before(dataset[i+1].Timestamp)
+			case bounded:
+				return t.Before(end)
+			}
+			return true
+		}
+		for next < len(samples) && closed(samples[next].Timestamp) {
+			next++
+		}
+		liquidity[i] = math.NaN()
+		if next > 0 {
+			liquidity[i] = samples[next-1].USD
+		}
+	}
+	return liquidity, nil
+}
+
+// CalculateLiquidity aligns the liquidity samples to the candles and computes their moving average and
+// candle-to-candle change. The first result is the candle where period candles have a sample.
+func CalculateLiquidity(dataset []OHLCV, samples []LiquiditySample, period int) ([]LiquidityResult, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	liquidity, err := AlignLiquidity(dataset, samples)
+	if err != nil {
+		return nil, err
+	}
+
+	first := sort.Search(len(liquidity), func(i int) bool { return !math.IsNaN(liquidity[i]) })
+	if have := len(liquidity) - first; have < period {
+		return nil, ErrInsufficientData{Need: period, Have: have}
+	}
+
+	results := make([]LiquidityResult, 0, len(liquidity)-first-period+1)
+	sum := 0.0
+	for i := first; i < len(liquidity); i++ {
+		sum += liquidity[i]
+		if i-first >= period {
+			sum -= liquidity[i-period]
+		}
+		if i-first < period-1 {
+			continue
+		}
+
+		result := LiquidityResult{Timestamp: dataset[i].Timestamp, Liquidity: liquidity[i], MA: sum / float64(period)}
+		if result.MA > 0 {
+			result.Ratio = liquidity[i] / result.MA
+		}
+		if i > first && liquidity[i-1] > 0 {
+			result.Change = liquidity[i]/liquidity[i-1] - 1
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// DetectLiquidityDrops finds candles whose liquidity is at least DropShare below the highest liquidity of
+// the preceding DropCandles candles, the sudden removals of a rug pull rather than the gradual drift of
+// trading. Consecutive candles of one removal are each reported. Results are in dataset order.
+func DetectLiquidityDrops(dataset []OHLCV, samples []LiquiditySample, config LiquidityConfig) ([]LiquidityDrop, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	liquidity, err := AlignLiquidity(dataset, samples)
+	if err != nil {
+		return nil, err
+	}
+
+	var drops []LiquidityDrop
+	for i := 1; i < len(liquidity); i++ {
+		before := math.NaN()
+		for _, l := range liquidity[max(0, i-config.DropCandles):i] {
+			if !math.IsNaN(l) && !(l <= before) {
+				before = l
+			}
+		}
+		if !(before > 0) || math.IsNaN(liquidity[i]) {
+			continue
+		}
+		if drop := 1 - liquidity[i]/before; drop >= config.DropShare && drop > 0 {
+			drops = append(drops, LiquidityDrop{Index: i, Timestamp: dataset[i].Timestamp, Before: before, Liquidity: liquidity[i], Drop: drop})
+		}
+	}
+	return drops, nil
+}
+
+// removedLiquiditySeriesShare returns the share of the highest liquidity within the window before at that
+// is gone by the latest sample at or before at; it is 0 with fewer than two samples in reach
+func removedLiquiditySeriesShare(samples []LiquiditySample, at time.Time, window time.Duration) float64 {
+	last := sort.Search(len(samples), func(i int) bool { return samples[i].Timestamp.After(at) }) - 1
+	if last < 1 {
+		return 0
+	}
+
+	peak := 0.0
+	for i := last - 1; i >= 0 && at.Sub(samples[i].Timestamp) <= window; i-- {
+		peak = math.Max(peak, samples[i].USD)
+	}
+	if peak <= 0 || samples[last].USD >= peak {
+		return 0
+	}
+	return 1 - samples[last].USD/peak
+}
+
+// LiquidityIndicator adapts CalculateLiquidity to the Indicator interface for a fixed set of samples.
+// The primary value is the moving average; liquidity, ratio, and change are components.
+type LiquidityIndicator struct {
+	Samples []LiquiditySample
+	Period  int
+}
+
+func (i LiquidityIndicator) Name() string    { return fmt.Sprintf("LIQ(%d)", i.Period) }
+func (i LiquidityIndicator) MinPeriods() int { return i.Period }
+
+func (i LiquidityIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateLiquidity(dataset, i.Samples, i.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp: r.Timestamp,
+			Value:     r.MA,
+			Components: map[string]float64{
+				"liquidity": r.Liquidity,
+				"ratio":     r.Ratio,
+				"change":    r.Change,
+			},
+		}
+	}
+	return points, nil
+}
@@ -0,0 +1,395 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// KlineUpdate is one candle update from an exchange stream. Closed is set when the exchange marks
+// the candle final; otherwise it is treated as final once an update for a later candle arrives.
+type KlineUpdate struct {
+	Candle OHLCV
+	Closed bool
+}
+
+// KlineStream adapts one exchange's websocket kline channel to LiveStream
+type KlineStream interface {
+	Name() string
+	// Endpoint returns the websocket URL for a symbol and interval
+	Endpoint(symbol string, interval time.Duration) (string, error)
+	// Subscribe returns the messages to send after connecting; nil when the URL selects the channel
+	Subscribe(symbol string, interval time.Duration) ([][]byte, error)
+	// Parse converts a message into candle updates, returning none for heartbeats and acknowledgements
+	Parse(message []byte) ([]KlineUpdate, error)
+}
+
+// LiveStreamConfig configures a LiveStream
+type LiveStreamConfig struct {
+	Symbol   string        // Stream symbol, e.g. "btcusdt" on Binance or "BTC/USD" on Kraken
+	Interval time.Duration // Candle interval
+
+	Backfill       DataSource // Optional REST source to warm up and to fill gaps after reconnects
+	BackfillSymbol string     // Symbol on the backfill source when it differs from Symbol
+	WarmUp         int        // Closed candles to load from Backfill before streaming
+
+	ReconnectDelay    time.Duration // First reconnect delay, doubled after each failure (default 1s)
+	MaxReconnectDelay time.Duration // Upper bound of the reconnect delay (default 1 minute)
+	ReadTimeout       time.Duration // Reconnect when no message arrives for this long (default 90s)
+	Header            http.Header   // Extra handshake headers
+}
+
+// withDefaults fills in the reconnect and timeout defaults
+func (c LiveStreamConfig) withDefaults() LiveStreamConfig {
+	if c.ReconnectDelay <= 0 {
+		c.ReconnectDelay = time.Second
+	}
+	if c.MaxReconnectDelay <= 0 {
+		c.MaxReconnectDelay = time.Minute
+	}
+	if c.ReadTimeout <= 0 {
+		c.ReadTimeout = 90 * time.Second
+	}
+	if c.BackfillSymbol == "" {
+		c.BackfillSymbol = c.Symbol
+	}
+	return c
+}
+
+// LiveStream consumes an exchange kline channel and delivers each closed candle exactly once and in
+// order. It reconnects with exponential backoff and, when a Backfill source is set, fetches candles
+// missed while disconnected before resuming.
+type LiveStream struct {
+	stream  KlineStream
+	config  LiveStreamConfig
+	last    time.Time // Timestamp of the last delivered candle
+	pending *OHLCV    // Forming candle not yet known to be closed
+}
+
+// NewLiveStream creates a live stream for one symbol and interval
+func NewLiveStream(stream KlineStream, config LiveStreamConfig) (*LiveStream, error) {
+	if stream == nil {
+		return nil, invalidParameter("kline stream is required")
+	}
+	if config.Symbol == "" {
+		return nil, invalidParameter("symbol is required")
+	}
+	if config.Interval <= 0 {
+		return nil, invalidPeriod("interval must be positive")
+	}
+	if config.WarmUp < 0 {
+		return nil, invalidParameter("warm up must not be negative")
+	}
+	if _, err := stream.Endpoint(config.Symbol, config.Interval); err != nil {
+		return nil, err
+	}
+	return &LiveStream{stream: stream, config: config.withDefaults()}, nil
+}
+
+// Run streams until the context is done or the handler returns an error, which Run then returns.
+// Feed the candles to a CandleAggregator with FeedAggregator or to streaming indicators with FeedIndicators.
+func (s *LiveStream) Run(ctx context.Context, handler func(OHLCV) error) error {
+	if s.config.WarmUp > 0 && s.config.Backfill != nil {
+		if err := s.backfill(ctx, time.Time{}, s.config.WarmUp, handler); err != nil {
+			return err
+		}
+	}
+
+	delay := s.config.ReconnectDelay
+	for {
+		connected, err := s.session(ctx, handler)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var handlerErr handlerError
+		if errors.As(err, &handlerErr) {
+			return handlerErr.err
+		}
+
+		// The forming candle may be incomplete after a disconnect; backfill replaces it once closed
+		s.pending = nil
+		if connected {
+			delay = s.config.ReconnectDelay
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, s.config.MaxReconnectDelay)
+	}
+}
+
+// handlerError marks errors returned by the user's handler, which stop Run instead of reconnecting
+type handlerError struct{ err error }
+
+func (e handlerError) Error() string { return e.err.Error() }
+
+// session runs one connection until it fails, reporting whether it got as far as receiving messages
+func (s *LiveStream) session(ctx context.Context, handler func(OHLCV) error) (bool, error) {
+	endpoint, err := s.stream.Endpoint(s.config.Symbol, s.config.Interval)
+	if err != nil {
+		return false, err
+	}
+	conn, err := dialWebSocket(ctx, endpoint, s.config.Header)
+	if err != nil {
+		return false, err
+	}
+	defer conn.close()
+
+	// Unblock reads when the context is cancelled
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.conn.Close()
+		case <-done:
+		}
+	}()
+
+	messages, err := s.stream.Subscribe(s.config.Symbol, s.config.Interval)
+	if err != nil {
+		return false, err
+	}
+	for _, message := range messages {
+		if err := conn.writeText(message); err != nil {
+			return false, err
+		}
+	}
+
+	connected := false
+	for {
+		conn.setReadDeadline(time.Now().Add(s.config.ReadTimeout))
+		message, err := conn.readMessage()
+		if err != nil {
+			return connected, err
+		}
+		connected = true
+
+		updates, err := s.stream.Parse(message)
+		if err != nil {
+			return connected, err
+		}
+		for _, update := range updates {
+			if err := s.apply(ctx, update, handler); err != nil {
+				return connected, err
+			}
+		}
+	}
+}
+
+// apply tracks the forming candle and delivers candles as they close
+func (s *LiveStream) apply(ctx context.Context, update KlineUpdate, handler func(OHLCV) error) error {
+	candle := update.Candle
+	if !candle.Timestamp.After(s.last) {
+		return nil // Already delivered
+	}
+
+	// An update for a later candle closes the pending one
+	if s.pending != nil && candle.Timestamp.After(s.pending.Timestamp) {
+		if err := s.deliver(ctx, *s.pending, handler); err != nil {
+			return err
+		}
+		s.pending = nil
+	}
+
+	if update.Closed {
+		s.pending = nil
+		return s.deliver(ctx, candle, handler)
+	}
+	s.pending = &candle
+	return nil
+}
+
+// deliver passes a closed candle to the handler after filling any gap since the last one
+func (s *LiveStream) deliver(ctx context.Context, candle OHLCV, handler func(OHLCV) error) error {
+	if !s.last.IsZero() && s.config.Backfill != nil && candle.Timestamp.Sub(s.last) > s.config.Interval {
+		missing := int(candle.Timestamp.Sub(s.last)/s.config.Interval) - 1
+		if err := s.backfill(ctx, s.last.Add(s.config.Interval), missing, handler); err != nil {
+			var handlerErr handlerError
+			if errors.As(err, &handlerErr) {
+				return err
+			}
+			// A failed backfill leaves the gap rather than stalling the stream
+		}
+	}
+
+	if !candle.Timestamp.After(s.last) {
+		return nil
+	}
+	if err := handler(candle); err != nil {
+		return handlerError{err}
+	}
+	s.last = candle.Timestamp
+	return nil
+}
+
+// backfill fetches closed candles from the REST source, from start or the most recent ones when start is zero
+func (s *LiveStream) backfill(ctx context.Context, start time.Time, limit int, handler func(OHLCV) error) error {
+	candles, err := s.config.Backfill.FetchOHLCV(ctx, FetchRequest{
+		Symbol:   s.config.BackfillSymbol,
+		Interval: s.config.Interval,
+		Start:    start,
+		Limit:    limit + 1, // The newest candle may still be forming
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, candle := range candles {
+		if !candle.Timestamp.After(s.last) || candle.Timestamp.Add(s.config.Interval).After(now) {
+			continue
+		}
+		if err := handler(candle); err != nil {
+			return handlerError{err}
+		}
+		s.last = candle.Timestamp
+	}
+	return nil
+}
+
+// FeedAggregator returns a LiveStream handler that adds each candle to a CandleAggregator
+func FeedAggregator(aggregator *CandleAggregator) func(OHLCV) error {
+	return aggregator.AddCandle
+}
+
+// FeedIndicators returns a LiveStream handler that updates streaming indicators with each candle
+func FeedIndicators(indicators ...StreamingIndicator) func(OHLCV) error {
+	return func(candle OHLCV) error {
+		for _, indicator := range indicators {
+			indicator.Update(candle)
+		}
+		return nil
+	}
+}
+
+// BinanceKlineStream reads the Binance spot kline stream
+type BinanceKlineStream struct {
+	BaseURL string // Defaults to wss://stream.binance.com:9443
+}
+
+// Name returns the stream name
+func (b BinanceKlineStream) Name() string { return "binance" }
+
+// Endpoint returns the raw stream URL for the symbol's kline channel
+func (b BinanceKlineStream) Endpoint(symbol string, interval time.Duration) (string, error) {
+	name, err := sourceInterval(b.Name(), interval, binanceIntervals)
+	if err != nil {
+		return "", err
+	}
+	base := b.BaseURL
+	if base == "" {
+		base = "wss://stream.binance.com:9443"
+	}
+	return fmt.Sprintf("%s/ws/%s@kline_%s", strings.TrimRight(base, "/"), strings.ToLower(symbol), name), nil
+}
+
+// Subscribe returns nothing: the URL selects the channel
+func (b BinanceKlineStream) Subscribe(string, time.Duration) ([][]byte, error) { return nil, nil }
+
+// Parse decodes kline events, raw or wrapped by the combined stream endpoint
+func (b BinanceKlineStream) Parse(message []byte) ([]KlineUpdate, error) {
+	var event struct {
+		Data  json.RawMessage `json:"data"` // Combined streams wrap the event
+		Type  string          `json:"e"`
+		Kline struct {
+			Start  json.RawMessage `json:"t"`
+			Open   json.RawMessage `json:"o"`
+			High   json.RawMessage `json:"h"`
+			Low    json.RawMessage `json:"l"`
+			Close  json.RawMessage `json:"c"`
+			Volume json.RawMessage `json:"v"`
+			Trades json.RawMessage `json:"n"`
+			Closed bool            `json:"x"`
+		} `json:"k"`
+	}
+	if err := json.Unmarshal(message, &event); err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	if len(event.Data) > 0 {
+		return b.Parse(event.Data)
+	}
+	if event.Type != "kline" {
+		return nil, nil
+	}
+
+	k := event.Kline
+	candle, err := parseJSONRow([]json.RawMessage{k.Start, k.Open, k.High, k.Low, k.Close, k.Volume}, [6]int{0, 1, 2, 3, 4, 5}, time.Millisecond)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", b.Name(), err)
+	}
+	candle.Trades = jsonCount([]json.RawMessage{k.Trades}, 0)
+	return []KlineUpdate{{Candle: candle, Closed: k.Closed}}, nil
+}
+
+// KrakenKlineStream reads the Kraken v2 websocket ohlc channel
+type KrakenKlineStream struct {
+	BaseURL string // Defaults to wss://ws.kraken.com/v2
+}
+
+// Name returns the stream name
+func (k KrakenKlineStream) Name() string { return "kraken" }
+
+// Endpoint returns the public v2 websocket URL
+func (k KrakenKlineStream) Endpoint(symbol string, interval time.Duration) (string, error) {
+	if _, err := sourceInterval(k.Name(), interval, krakenIntervals); err != nil {
+		return "", err
+	}
+	if k.BaseURL == "" {
+		return "wss://ws.kraken.com/v2", nil
+	}
+	return k.BaseURL, nil
+}
+
+// Subscribe returns the ohlc subscription for a pair such as "BTC/USD"
+func (k KrakenKlineStream) Subscribe(symbol string, interval time.Duration) ([][]byte, error) {
+	message, err := json.Marshal(map[string]any{
+		"method": "subscribe",
+		"params": map[string]any{
+			"channel":  "ohlc",
+			"symbol":   []string{symbol},
+			"interval": int(interval / time.Minute),
+		},
+	})
+	return [][]byte{message}, err
+}
+
+// Parse decodes ohlc snapshots and updates; Kraken does not flag closed candles
+func (k KrakenKlineStream) Parse(message []byte) ([]KlineUpdate, error) {
+	var event struct {
+		Channel string `json:"channel"`
+		Data    []struct {
+			Open          float64   `json:"open"`
+			High          float64   `json:"high"`
+			Low           float64   `json:"low"`
+			Close         float64   `json:"close"`
+			Volume        float64   `json:"volume"`
+			IntervalBegin time.Time `json:"interval_begin"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(message, &event); err != nil {
+		return nil, fmt.Errorf("%s: %w", k.Name(), err)
+	}
+	if event.Channel != "ohlc" {
+		return nil, nil
+	}
+
+	updates := make([]KlineUpdate, len(event.Data))
+	for i, d := range event.Data {
+		updates[i] = KlineUpdate{Candle: OHLCV{
+			Timestamp: d.IntervalBegin.UTC(),
+			Open:      d.Open,
+			High:      d.High,
+			Low:       d.Low,
+			Close:     d.Close,
+			Volume:    d.Volume,
+		}}
+	}
+	return updates, nil
+}
@@ -0,0 +1,159 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ManipulationKind identifies how a price move was made on a thin order book
+type ManipulationKind string
+
+const (
+	ThinBookMove   ManipulationKind = "thin_book_move"   // Price moved on a negligible number of trades
+	WideSpreadMove ManipulationKind = "wide_spread_move" // Price moved while the bid-ask spread was wide
+)
+
+// ManipulationEvent is one candle whose price move the order flow does not support
+type ManipulationEvent struct {
+	Index     int              `json:"index"`
+	Timestamp time.Time        `json:"timestamp"`
+	Kind      ManipulationKind `json:"kind"`
+	Move      float64          `json:"move"`             // Close-to-open change, signed
+	Trades    int              `json:"trades,omitempty"` // Trades in the candle, 0 when unknown
+	Spread    float64          `json:"spread,omitempty"` // Bid-ask spread relative to the mid price, 0 when unknown
+}
+
+// String describes the event, e.g. "+4.2% on 3 trades"
+func (e ManipulationEvent) String() string {
+	if e.Kind == WideSpreadMove {
+		return fmt.Sprintf("%+.1f%% across a %.1f%% spread", 100*e.Move, 100*e.Spread)
+	}
+	return fmt.Sprintf("%+.1f%% on %d trades", 100*e.Move, e.Trades)
+}
+
+// ManipulationReport is the result of DetectManipulation
+type ManipulationReport struct {
+	Covered   int                 `json:"covered"`   // Candles in the window with a trade count or bid and ask
+	Suspected bool                `json:"suspected"` // Enough events to treat the price action as manipulated
+	Events    []ManipulationEvent `json:"events,omitempty"`
+}
+
+// Largest returns the event with the biggest absolute move; ok is false without events
+func (r ManipulationReport) Largest() (event ManipulationEvent, ok bool) {
+	for _, e := range r.Events {
+		if !ok || math.Abs(e.Move) > math.Abs(event.Move) {
+			event, ok = e, true
+		}
+	}
+	return event, ok
+}
+
+// ManipulationConfig configures DetectManipulation. Zero fields take the defaults in brackets.
+type ManipulationConfig struct {
+	Window    int     `json:"window"`     // Latest candles examined [20]
+	MinMove   float64 `json:"min_move"`   // Close-to-open move worth explaining [0.02]
+	MaxTrades int     `json:"max_trades"` // Trade count at or below which a move is thin-book [5]
+	MaxSpread float64 `json:"max_spread"` // Relative spread at or above which a move is wide-spread [0.02]
+	MinEvents int     `json:"min_events"` // Events that make manipulation suspected [2]
+}
+
+// DefaultManipulationConfig returns the thresholds UltimateAnalysis uses by default
+func DefaultManipulationConfig() ManipulationConfig {
+	return ManipulationConfig{
+		Window:    20,
+		MinMove:   0.02,
+		MaxTrades: 5,
+		MaxSpread: 0.02,
+		MinEvents: 2,
+	}
+}
+
+// withDefaults fills zero fields
+func (c ManipulationConfig) withDefaults() ManipulationConfig {
+	defaults := DefaultManipulationConfig()
+	if c.Window == 0 {
+		c.Window = defaults.Window
+	}
+	if c.MinMove == 0 {
+		c.MinMove = defaults.MinMove
+	}
+	if c.MaxTrades == 0 {
+		c.MaxTrades = defaults.MaxTrades
+	}
+	if c.MaxSpread == 0 {
+		c.MaxSpread = defaults.MaxSpread
+	}
+	if c.MinEvents == 0 {
+		c.MinEvents = defaults.MinEvents
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c ManipulationConfig) validate() error {
+	switch {
+	case c.Window < 1:
+		return invalidPeriod("manipulation window must be greater than 0, got %d", c.Window)
+	case c.MinMove < 0 || c.MaxTrades < 0 || c.MaxSpread < 0:
+		return invalidParameter("manipulation thresholds must not be negative")
+	case c.MinEvents < 1:
+		return invalidParameter("manipulation min events must be at least 1, got %d", c.MinEvents)
+	}
+	return nil
+}
+
+// DetectManipulation flags the latest candles whose price moved at least MinMove on a thin order book:
+// on MaxTrades or fewer trades, or while the bid-ask spread was at least MaxSpread. Only candles that
+// carry a trade count or a bid and ask are examined; Covered reports how many did, and a report with
+// no coverage says nothing about the token. Manipulation is suspected at MinEvents events.
+func DetectManipulation(dataset []OHLCV, config ManipulationConfig) (ManipulationReport, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return ManipulationReport{}, err
+	}
+	if len(dataset) == 0 {
+		return ManipulationReport{}, ErrEmptyDataset
+	}
+
+	var report ManipulationReport
+	start := max(0, len(dataset)-config.Window)
+	for i := start; i < len(dataset); i++ {
+		candle := dataset[i]
+		spread, quoted := candleSpread(candle)
+		if candle.Trades <= 0 && !quoted {
+			continue
+		}
+		report.Covered++
+
+		if candle.Open <= 0 {
+			continue
+		}
+		move := candle.Close/candle.Open - 1
+		if math.IsNaN(move) || math.Abs(move) < config.MinMove {
+			continue
+		}
+
+		event := ManipulationEvent{Index: i, Timestamp: candle.Timestamp, Move: move, Trades: candle.Trades, Spread: spread}
+		switch {
+		case candle.Trades > 0 && candle.Trades <= config.MaxTrades:
+			event.Kind = ThinBookMove
+		case quoted && spread >= config.MaxSpread:
+			event.Kind = WideSpreadMove
+		default:
+			continue
+		}
+		report.Events = append(report.Events, event)
+	}
+
+	report.Suspected = len(report.Events) >= config.MinEvents
+	return report, nil
+}
+
+// candleSpread is the bid-ask spread relative to the mid price; ok is false without a valid bid and ask
+func candleSpread(candle OHLCV) (spread float64, ok bool) {
+	if candle.Bid <= 0 || candle.Ask < candle.Bid {
+		return 0, false
+	}
+	return (candle.Ask - candle.Bid) / ((candle.Ask + candle.Bid) / 2), true
+}
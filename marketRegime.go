@@ -0,0 +1,186 @@
+package techindicators
+
+import (
+	"math"
+	"time"
+)
+
+// TrendRegime labels whether a market is trending, ranging, or volatile
+type TrendRegime string
+
+const (
+	RegimeTrendingUp   TrendRegime = "trending_up"   // Strong ADX with a rising moving average
+	RegimeTrendingDown TrendRegime = "trending_down" // Strong ADX with a falling moving average
+	RegimeRanging      TrendRegime = "ranging"       // Weak trend and ordinary band width
+	RegimeVolatile     TrendRegime = "volatile"      // Weak trend but unusually wide bands
+)
+
+// RegimeResult is the regime of the latest candle with the readings behind it
+type RegimeResult struct {
+	Timestamp           time.Time   `json:"timestamp"`
+	Regime              TrendRegime `json:"regime"`
+	ADX                 float64     `json:"adx"`
+	PlusDI              float64     `json:"plus_di"`
+	MinusDI             float64     `json:"minus_di"`
+	Slope               float64     `json:"slope"`                 // Moving average change per candle, relative to its value
+	BandWidthPercentile float64     `json:"band_width_percentile"` // Percentile rank (0-100) of the band width in the lookback
+}
+
+// RegimeConfig configures ClassifyRegime. Zero fields take the defaults in brackets.
+type RegimeConfig struct {
+	ADXPeriod          int       `json:"adx_period"`          // [14]
+	MAPeriod           int       `json:"ma_period"`           // SMA whose slope gives the direction [20]
+	SlopeLookback      int       `json:"slope_lookback"`      // Candles the slope is measured over [5]
+	BandPeriod         int       `json:"band_period"`         // [20]
+	BandMultiplier     float64   `json:"band_multiplier"`     // [2]
+	WidthLookback      int       `json:"width_lookback"`      // Band widths ranked for the percentile [100]
+	TrendADX           float64   `json:"trend_adx"`           // ADX at which the market is trending [25]
+	MinSlope           float64   `json:"min_slope"`           // Relative slope per candle a trend needs [0.001]
+	VolatilePercentile float64   `json:"volatile_percentile"` // Band width percentile of a volatile market [80]
+	PriceType          PriceType `json:"price_type"`
+}
+
+// DefaultRegimeConfig returns the standard regime configuration (ADX-14, SMA-20 slope over 5, BB-20/2.0)
+func DefaultRegimeConfig() RegimeConfig {
+	return RegimeConfig{
+		ADXPeriod:          14,
+		MAPeriod:           20,
+		SlopeLookback:      5,
+		BandPeriod:         20,
+		BandMultiplier:     2,
+		WidthLookback:      100,
+		TrendADX:           25,
+		MinSlope:           0.001,
+		VolatilePercentile: 80,
+		PriceType:          ClosePrice,
+	}
+}
+
+// withDefaults fills zero fields
+func (c RegimeConfig) withDefaults() RegimeConfig {
+	defaults := DefaultRegimeConfig()
+	if c.ADXPeriod == 0 {
+		c.ADXPeriod = defaults.ADXPeriod
+	}
+	if c.MAPeriod == 0 {
+		c.MAPeriod = defaults.MAPeriod
+	}
+	if c.SlopeLookback == 0 {
+		c.SlopeLookback = defaults.SlopeLookback
+	}
+	if c.BandPeriod == 0 {
+		c.BandPeriod = defaults.BandPeriod
+	}
+	if c.BandMultiplier == 0 {
+		c.BandMultiplier = defaults.BandMultiplier
+	}
+	if c.WidthLookback == 0 {
+		c.WidthLookback = defaults.WidthLookback
+	}
+	if c.TrendADX == 0 {
+		c.TrendADX = defaults.TrendADX
+	}
+	if c.MinSlope == 0 {
+		c.MinSlope = defaults.MinSlope
+	}
+	if c.VolatilePercentile == 0 {
+		c.VolatilePercentile = defaults.VolatilePercentile
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c RegimeConfig) validate() error {
+	switch {
+	case c.ADXPeriod < 1 || c.MAPeriod < 1 || c.SlopeLookback < 1 || c.BandPeriod < 1 || c.WidthLookback < 1:
+		return invalidPeriod("regime periods must be greater than 0")
+	case c.BandMultiplier < 0 || c.MinSlope < 0:
+		return invalidParameter("band multiplier and minimum slope must not be negative")
+	case c.TrendADX < 0 || c.TrendADX > 100 || c.VolatilePercentile < 0 || c.VolatilePercentile > 100:
+		return invalidParameter("trend ADX and volatile percentile must be between 0 and 100")
+	}
+	return nil
+}
+
+// minCandles is the dataset length ClassifyRegime needs
+func (c RegimeConfig) minCandles() int {
+	return max(2*c.ADXPeriod, c.MAPeriod+c.SlopeLookback, c.BandPeriod)
+}
+
+// ClassifyRegime labels the latest candle trending up, trending down, ranging, or volatile. A market trends
+// when the ADX reaches TrendADX and the moving average slopes at least MinSlope per candle in the direction
+// of the stronger directional indicator. Otherwise it is volatile when the Bollinger band width ranks at or
+// above VolatilePercentile among the last WidthLookback widths, and ranging when it does not.
+func ClassifyRegime(dataset []OHLCV, config RegimeConfig) (RegimeResult, error) {
+	return classifyRegime(dataset, config,
+		func(period int, priceType PriceType) ([]SMAResult, error) {
+			return CalculateSMA(dataset, period, priceType)
+		},
+		func(period int, multiplier float64, priceType PriceType) ([]BollingerBands, error) {
+			return CalculateBollingerBands(dataset, period, multiplier, priceType)
+		})
+}
+
+// classifyRegime implements ClassifyRegime over any moving average and Bollinger Bands series source
+func classifyRegime(dataset []OHLCV, config RegimeConfig,
+	average func(int, PriceType) ([]SMAResult, error),
+	bands func(int, float64, PriceType) ([]BollingerBands, error)) (RegimeResult, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return RegimeResult{}, err
+	}
+	if len(dataset) == 0 {
+		return RegimeResult{}, ErrEmptyDataset
+	}
+	if need := config.minCandles(); len(dataset) < need {
+		return RegimeResult{}, ErrInsufficientData{Need: need, Have: len(dataset)}
+	}
+
+	adx, err := CalculateADX(dataset, config.ADXPeriod)
+	if err != nil {
+		return RegimeResult{}, err
+	}
+	ma, err := average(config.MAPeriod, config.PriceType)
+	if err != nil {
+		return RegimeResult{}, err
+	}
+	widths, err := bands(config.BandPeriod, config.BandMultiplier, config.PriceType)
+	if err != nil {
+		return RegimeResult{}, err
+	}
+
+	latest := adx[len(adx)-1]
+	result := RegimeResult{
+		Timestamp: dataset[len(dataset)-1].Timestamp,
+		ADX:       latest.ADX,
+		PlusDI:    latest.PlusDI,
+		MinusDI:   latest.MinusDI,
+	}
+
+	if base := ma[len(ma)-1-config.SlopeLookback].Value; base != 0 {
+		result.Slope = (ma[len(ma)-1].Value/base - 1) / float64(config.SlopeLookback)
+	}
+
+	recent := widths[max(0, len(widths)-config.WidthLookback):]
+	current := recent[len(recent)-1].BandWidth
+	below := 0
+	for _, band := range recent {
+		if band.BandWidth <= current {
+			below++
+		}
+	}
+	result.BandWidthPercentile = 100 * float64(below) / float64(len(recent))
+
+	trending := result.ADX >= config.TrendADX && math.Abs(result.Slope) >= config.MinSlope
+	switch {
+	case trending && result.Slope > 0 && result.PlusDI > result.MinusDI:
+		result.Regime = RegimeTrendingUp
+	case trending && result.Slope < 0 && result.MinusDI > result.PlusDI:
+		result.Regime = RegimeTrendingDown
+	case result.BandWidthPercentile >= config.VolatilePercentile:
+		result.Regime = RegimeVolatile
+	default:
+		result.Regime = RegimeRanging
+	}
+	return result, nil
+}
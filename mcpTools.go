@@ -0,0 +1,373 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JulianToledano/goingecko/v3/api"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// The indicator tools accept market data in one of two ways: a CoinGecko coin ID (with vsCurrency
+// and days) fetched internally, or inline candles in the "ohlcv" argument. Results are returned as JSON text.
+
+// dataToolOptions are the input schema properties shared by the indicator tools
+func dataToolOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("coinID", mcp.Description("CoinGecko coin ID, e.g. \"bitcoin\" or \"pepe\"; ignored when ohlcv is given")),
+		mcp.WithString("vsCurrency", mcp.Description("Quote currency for coinID"), mcp.DefaultString("usd")),
+		mcp.WithString("days", mcp.Description("History length for coinID: 1, 7, 14, 30, 90, 180, 365, or max"), mcp.DefaultString("90")),
+		mcp.WithArray("ohlcv", mcp.Description("Inline candles, oldest first"), mcp.Items(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timestamp": map[string]any{"type": "string", "description": "RFC 3339 time"},
+				"open":      map[string]any{"type": "number"},
+				"high":      map[string]any{"type": "number"},
+				"low":       map[string]any{"type": "number"},
+				"close":     map[string]any{"type": "number"},
+				"volume":    map[string]any{"type": "number"},
+			},
+			"required": []string{"timestamp", "open", "high", "low", "close"},
+		})),
+	}
+}
+
+// newDataTool builds a tool with the shared data properties followed by its own
+func newDataTool(name, description string, options ...mcp.ToolOption) mcp.Tool {
+	all := append([]mcp.ToolOption{mcp.WithDescription(description), mcp.WithReadOnlyHintAnnotation(true), mcp.WithDestructiveHintAnnotation(false)}, dataToolOptions()...)
+	return mcp.NewTool(name, append(all, options...)...)
+}
+
+// toolDataset reads inline candles or fetches them from CoinGecko. Volume-based tools fetch the daily
+// market chart instead of /ohlc, because only it carries volume.
+func toolDataset(ctx context.Context, request mcp.CallToolRequest, needVolume bool) ([]OHLCV, error) {
+	if raw, ok := request.GetArguments()["ohlcv"]; ok && raw != nil {
+		var encoded []byte
+		if s, ok := raw.(string); ok {
+			encoded = []byte(s)
+		} else {
+			var err error
+			if encoded, err = json.Marshal(raw); err != nil {
+				return nil, err
+			}
+		}
+
+		var dataset []OHLCV
+		if err := json.Unmarshal(encoded, &dataset); err != nil {
+			return nil, fmt.Errorf("%w: ohlcv: %w", ErrInvalidDataset, err)
+		}
+		if len(dataset) == 0 {
+			return nil, ErrEmptyDataset
+		}
+		return dataset, ValidateOHLCV(dataset).Err()
+	}
+
+	coinID := request.GetString("coinID", "")
+	if coinID == "" {
+		return nil, invalidParameter("either coinID or ohlcv is required")
+	}
+	vsCurrency := request.GetString("vsCurrency", "usd")
+	days := request.GetString("days", "90")
+
+	if needVolume {
+		return fetchCoinGeckoDailyCandles(ctx, defaultCoinGeckoClient(), coinID, vsCurrency, days)
+	}
+	return FetchOHLCVFromCoinGecko(ctx, coinID, vsCurrency, days)
+}
+
+// fetchCoinGeckoDailyCandles builds daily candles with volume from the market chart: each candle opens at
+// the previous close and carries the reported 24h volume
+func fetchCoinGeckoDailyCandles(ctx context.Context, client *api.Client, coinID, vsCurrency, days string) ([]OHLCV, error) {
+	chart, err := client.CoinsIdMarketChart(ctx, coinID, vsCurrency, days)
+	if err != nil {
+		return nil, coinGeckoError(err)
+	}
+	if chart == nil || len(chart.Prices) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	volumes := make(map[int64]float64, len(chart.TotalVolumes))
+	for _, point := range chart.TotalVolumes {
+		if len(point) >= 2 {
+			volumes[int64(point[0])] = point[1]
+		}
+	}
+
+	candles := make([]OHLCV, 0, len(chart.Prices))
+	for _, point := range chart.Prices {
+		if len(point) < 2 {
+			continue
+		}
+		open := point[1]
+		if len(candles) > 0 {
+			open = candles[len(candles)-1].Close
+		}
+		candles = append(candles, OHLCV{
+			Timestamp: time.UnixMilli(int64(point[0])).UTC(),
+			Open:      open,
+			High:      max(open, point[1]),
+			Low:       min(open, point[1]),
+			Close:     point[1],
+			Volume:    volumes[int64(point[0])],
+		})
+	}
+	return dedupeCandles(candles), nil
+}
+
+// toolJSONResult marshals a tool result, reporting failures as tool errors
+func toolJSONResult(result any, err error) (*mcp.CallToolResult, error) {
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return mcp.NewToolResultText(string(encoded)), nil
+}
+
+// RSITool describes the RSI strategy tool
+func RSITool() mcp.Tool {
+	return newDataTool("rsi_strategy", "RSI condition, divergence, momentum, and trading signal",
+		mcp.WithNumber("period", mcp.Description("RSI period"), mcp.DefaultNumber(14), mcp.Min(2)),
+	)
+}
+
+// RSIHandler runs AnalyzeRSIStrategy on the requested data
+func RSIHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	dataset, err := toolDataset(ctx, request, false)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return toolJSONResult(AnalyzeRSIStrategy(dataset, request.GetInt("period", 14), ClosePrice))
+}
+
+// MovingAverageCrossover is the result of the moving average crossover tool
+type MovingAverageCrossover struct {
+	Average    string  `json:"average"` // sma or ema
+	FastPeriod int     `json:"fast_period"`
+	SlowPeriod int     `json:"slow_period"`
+	Fast       float64 `json:"fast"` // Latest fast average
+	Slow       float64 `json:"slow"` // Latest slow average
+	Trend      Signal  `json:"trend"`
+	Signal     Signal  `json:"signal"` // bullish_crossover, bearish_crossover, or no_signal
+}
+
+// CrossoverTool describes the SMA/EMA crossover tool
+func CrossoverTool() mcp.Tool {
+	return newDataTool("moving_average_crossover", "Fast/slow SMA or EMA crossover on the latest candle",
+		mcp.WithString("average", mcp.Description("Moving average type"), mcp.Enum("sma", "ema"), mcp.DefaultString("sma")),
+		mcp.WithNumber("fastPeriod", mcp.Description("Fast average period"), mcp.DefaultNumber(10), mcp.Min(1)),
+		mcp.WithNumber("slowPeriod", mcp.Description("Slow average period"), mcp.DefaultNumber(20), mcp.Min(2)),
+	)
+}
+
+// CrossoverHandler detects a fast/slow moving average crossover on the requested data
+func CrossoverHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	dataset, err := toolDataset(ctx, request, false)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return toolJSONResult(movingAverageCrossover(dataset, request.GetString("average", "sma"),
+		request.GetInt("fastPeriod", 10), request.GetInt("slowPeriod", 20)))
+}
+
+// movingAverageCrossover computes the crossover result for the tool
+func movingAverageCrossover(dataset []OHLCV, average string, fastPeriod, slowPeriod int) (MovingAverageCrossover, error) {
+	if fastPeriod >= slowPeriod {
+		return MovingAverageCrossover{}, invalidPeriod("fast period must be less than slow period")
+	}
+
+	calculate := CalculateSMA
+	switch average {
+	case "sma":
+	case "ema":
+		calculate = CalculateEMA
+	default:
+		return MovingAverageCrossover{}, invalidParameter("average must be sma or ema, got %q", average)
+	}
+
+	fast, err := calculate(dataset, fastPeriod, ClosePrice)
+	if err != nil {
+		return MovingAverageCrossover{}, err
+	}
+	slow, err := calculate(dataset, slowPeriod, ClosePrice)
+	if err != nil {
+		return MovingAverageCrossover{}, err
+	}
+
+	result := MovingAverageCrossover{
+		Average:    average,
+		FastPeriod: fastPeriod,
+		SlowPeriod: slowPeriod,
+		Fast:       fast[len(fast)-1].Value,
+		Slow:       slow[len(slow)-1].Value,
+		Trend:      SignalNeutral,
+		Signal:     smaCrossoverFromSeries(fast, slow),
+	}
+	switch {
+	case result.Fast > result.Slow:
+		result.Trend = SignalBullish
+	case result.Fast < result.Slow:
+		result.Trend = SignalBearish
+	}
+	return result, nil
+}
+
+// BollingerTool describes the Bollinger Bands strategy tool
+func BollingerTool() mcp.Tool {
+	return newDataTool("bollinger_strategy", "Bollinger Bands position, breakout, squeeze, and trading signal",
+		mcp.WithNumber("period", mcp.Description("Band period"), mcp.DefaultNumber(20), mcp.Min(2)),
+		mcp.WithNumber("multiplier", mcp.Description("Standard deviation multiplier"), mcp.DefaultNumber(2), mcp.Min(0)),
+	)
+}
+
+// BollingerHandler runs AnalyzeBollingerStrategy on the requested data
+func BollingerHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	dataset, err := toolDataset(ctx, request, false)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return toolJSONResult(AnalyzeBollingerStrategy(dataset, request.GetInt("period", 20), request.GetFloat("multiplier", 2), ClosePrice))
+}
+
+// VolumeTool describes the volume strategy tool
+func VolumeTool() mcp.Tool {
+	return newDataTool("volume_strategy", "Volume breakout, accumulation/distribution, OBV trend, and trading signal. "+
+		"With coinID, daily CoinGecko market chart data is used because it includes volume.",
+		mcp.WithNumber("vmaPeriod", mcp.Description("Volume moving average period"), mcp.DefaultNumber(20), mcp.Min(1)),
+		mcp.WithNumber("vrocPeriod", mcp.Description("Volume rate of change period"), mcp.DefaultNumber(5), mcp.Min(1)),
+	)
+}
+
+// VolumeHandler runs AnalyzeVolumeStrategy on the requested data
+func VolumeHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	dataset, err := toolDataset(ctx, request, true)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return toolJSONResult(AnalyzeVolumeStrategy(dataset, request.GetInt("vmaPeriod", 20), request.GetInt("vrocPeriod", 5)))
+}
+
+// UltimateTool describes the headline analysis tool
+func UltimateTool() mcp.Tool {
+	return newDataTool("ultimate_analysis", "Full memecoin analysis: combined SMA/Bollinger/RSI signal, volume confirmation, "+
+		"confidence, risk level, and rug pull risk. With coinID, daily CoinGecko market chart data is used.",
+		mcp.WithString("mode", mcp.Description("ultimate adds volume confirmation and rug pull risk; comprehensive is technical only"),
+			mcp.Enum("ultimate", "comprehensive"), mcp.DefaultString("ultimate")),
+		mcp.WithNumber("smaPeriod", mcp.Description("SMA period"), mcp.DefaultNumber(20), mcp.Min(2)),
+		mcp.WithNumber("bbPeriod", mcp.Description("Bollinger Bands period"), mcp.DefaultNumber(20), mcp.Min(2)),
+		mcp.WithNumber("bbMultiplier", mcp.Description("Bollinger Bands multiplier"), mcp.DefaultNumber(2), mcp.Min(0)),
+		mcp.WithNumber("rsiPeriod", mcp.Description("RSI period"), mcp.DefaultNumber(14), mcp.Min(2)),
+		mcp.WithNumber("vmaPeriod", mcp.Description("Volume moving average period"), mcp.DefaultNumber(20), mcp.Min(1)),
+	)
+}
+
+// UltimateHandler runs UltimateAnalysis or ComprehensiveAnalysis and returns the full result
+func UltimateHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	mode := request.GetString("mode", "ultimate")
+	if mode != "ultimate" && mode != "comprehensive" {
+		return mcp.NewToolResultError(invalidParameter("mode must be ultimate or comprehensive, got %q", mode).Error()), nil
+	}
+
+	dataset, err := toolDataset(ctx, request, mode == "ultimate")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	defaults := DefaultAnalysisConfig()
+	config := defaults
+	config.SMAPeriod = request.GetInt("smaPeriod", defaults.SMAPeriod)
+	config.BBPeriod = request.GetInt("bbPeriod", defaults.BBPeriod)
+	config.BBMultiplier = request.GetFloat("bbMultiplier", defaults.BBMultiplier)
+	config.RSIPeriod = request.GetInt("rsiPeriod", defaults.RSIPeriod)
+	config.VMAPeriod = request.GetInt("vmaPeriod", defaults.VMAPeriod)
+
+	if mode == "comprehensive" {
+		return toolJSONResult(ComprehensiveAnalysisContext(ctx, dataset, config))
+	}
+	return toolJSONResult(UltimateAnalysisContext(ctx, dataset, config))
+}
+
+// SharpeRatioTool describes the Sharpe ratio tool served by SharpeRatioHandler
+func SharpeRatioTool() mcp.Tool {
+	return mcp.NewTool("sharpe_ratio",
+		mcp.WithDescription("Average daily return, volatility, and daily/annualized Sharpe ratio of a CoinGecko coin"),
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithString("coinID", mcp.Required(), mcp.Description("CoinGecko coin ID, e.g. \"bitcoin\" or \"pepe\"")),
+		mcp.WithString("vsCurrency", mcp.Required(), mcp.Description("Quote currency, e.g. \"usd\"")),
+		mcp.WithString("days", mcp.Required(), mcp.Description("History length in days, e.g. \"90\"")),
+	)
+}
+
+// AllTools returns every indicator and strategy tool with its handler
+func AllTools() []server.ServerTool {
+	return []server.ServerTool{
+		{Tool: SharpeRatioTool(), Handler: SharpeRatioHandler},
+		{Tool: RSITool(), Handler: RSIHandler},
+		{Tool: CrossoverTool(), Handler: CrossoverHandler},
+		{Tool: BollingerTool(), Handler: BollingerHandler},
+		{Tool: VolumeTool(), Handler: VolumeHandler},
+		{Tool: UltimateTool(), Handler: UltimateHandler},
+		{Tool: ChartTool(), Handler: ChartHandler},
+	}
+}
+
+// RegisterAllTools adds every tool from AllTools to an MCP server
+func RegisterAllTools(s *server.MCPServer) {
+	s.AddTools(AllTools()...)
+}
+
+// ChartTool describes the rendered chart tool
+func ChartTool() mcp.Tool {
+	return newDataTool("render_chart", "Candlestick chart as a PNG image with optional moving averages, Bollinger Bands, and volume",
+		mcp.WithString("interval", mcp.Description("Resample candles to this timeframe before drawing, e.g. 4h, 1d, or 1w")),
+		mcp.WithArray("sma", mcp.Description("SMA periods to overlay"), mcp.WithNumberItems()),
+		mcp.WithArray("ema", mcp.Description("EMA periods to overlay"), mcp.WithNumberItems()),
+		mcp.WithBoolean("bollinger", mcp.Description("Overlay Bollinger Bands (20, 2)"), mcp.DefaultBool(true)),
+		mcp.WithNumber("candles", mcp.Description("Most recent candles to draw"), mcp.DefaultNumber(150), mcp.Min(10)),
+		mcp.WithNumber("width", mcp.Description("Image width in pixels"), mcp.DefaultNumber(1000), mcp.Min(100), mcp.Max(4000)),
+		mcp.WithNumber("height", mcp.Description("Image height in pixels"), mcp.DefaultNumber(600), mcp.Min(100), mcp.Max(4000)),
+	)
+}
+
+// ChartHandler renders the requested data with RenderChart and returns it as base64 PNG image content
+func ChartHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	dataset, err := toolDataset(ctx, request, false)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if s := request.GetString("interval", ""); s != "" {
+		interval, err := ParseInterval(s)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		if dataset, err = Resample(dataset, interval); err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
+
+	options := ChartOptions{
+		Width:      request.GetInt("width", 1000),
+		Height:     request.GetInt("height", 600),
+		MaxCandles: request.GetInt("candles", 150),
+		SMAPeriods: request.GetIntSlice("sma", []int{20}),
+		EMAPeriods: request.GetIntSlice("ema", nil),
+		Bollinger:  request.GetBool("bollinger", true),
+	}
+	image, err := RenderChart(dataset, options)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	last := dataset[len(dataset)-1]
+	caption := fmt.Sprintf("%d candles through %s, last close %g", min(len(dataset), options.MaxCandles),
+		last.Timestamp.Format(time.RFC3339), last.Close)
+	return mcp.NewToolResultImage(caption, base64.StdEncoding.EncodeToString(image), "image/png"), nil
+}
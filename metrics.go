@@ -0,0 +1,214 @@
+package techindicators
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SymbolMetrics is the latest analysis state of one tracked symbol
+type SymbolMetrics struct {
+	Close           float64
+	RSI             float64
+	BandWidth       float64
+	VolumeRatio     float64
+	FinalSignal     Signal
+	ConfidenceScore float64
+	RiskScore       float64
+	RugPullRisk     string
+	Updated         time.Time
+}
+
+// metricsSignals are the final signal states exported one-hot, ordered from bearish to bullish
+var metricsSignals = []Signal{SignalStrongSell, SignalSell, SignalHold, SignalBuy, SignalStrongBuy}
+
+// MetricsExporter serves the latest indicator values and signal states per symbol in the Prometheus
+// text exposition format, so it needs no Prometheus client library. It is safe for concurrent use.
+type MetricsExporter struct {
+	namespace string
+
+	mu      sync.RWMutex
+	symbols map[string]SymbolMetrics
+}
+
+// NewMetricsExporter returns an exporter whose metric names start with namespace (default "techindicators")
+func NewMetricsExporter(namespace string) *MetricsExporter {
+	if namespace == "" {
+		namespace = "techindicators"
+	}
+	return &MetricsExporter{namespace: namespace, symbols: make(map[string]SymbolMetrics)}
+}
+
+// Set replaces the metrics of a symbol
+func (e *MetricsExporter) Set(symbol string, metrics SymbolMetrics) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.symbols[symbol] = metrics
+}
+
+// Remove stops exporting a symbol
+func (e *MetricsExporter) Remove(symbol string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.symbols, symbol)
+}
+
+// Observe runs the ultimate analysis on the dataset and records the symbol's latest values
+func (e *MetricsExporter) Observe(symbol string, dataset []OHLCV, config AnalysisConfig) error {
+	return e.ObserveContext(context.Background(), symbol, dataset, config)
+}
+
+// ObserveContext is Observe with cancellation
+func (e *MetricsExporter) ObserveContext(ctx context.Context, symbol string, dataset []OHLCV, config AnalysisConfig) error {
+	config = config.withDefaults()
+
+	analysis, err := UltimateAnalysisContext(ctx, dataset, config)
+	if err != nil {
+		return err
+	}
+	rsi, err := CalculateRSI(dataset, config.RSIPeriod, config.PriceType)
+	if err != nil {
+		return err
+	}
+	bands, err := CalculateBollingerBands(dataset, config.BBPeriod, config.BBMultiplier, config.PriceType)
+	if err != nil {
+		return err
+	}
+
+	e.Set(symbol, SymbolMetrics{
+		Close:           dataset[len(dataset)-1].Close,
+		RSI:             rsi[len(rsi)-1].Value,
+		BandWidth:       bands[len(bands)-1].BandWidth,
+		VolumeRatio:     analysis.Volume.VolumeRatio,
+		FinalSignal:     analysis.FinalSignal,
+		ConfidenceScore: analysis.ConfidenceScore,
+		RiskScore:       analysis.RiskScore,
+		RugPullRisk:     analysis.RugPullRisk,
+		Updated:         dataset[len(dataset)-1].Timestamp,
+	})
+	return nil
+}
+
+// FeedMetrics returns a LiveStream handler that keeps the last capacity candles and re-observes the
+// symbol after each one. Candles before the configured indicators have enough history are only buffered.
+func FeedMetrics(exporter *MetricsExporter, symbol string, capacity int, config AnalysisConfig) (func(OHLCV) error, error) {
+	if capacity < config.UltimateMinCandles() {
+		return nil, invalidParameter("capacity %d is below the %d candles the analysis needs", capacity, config.UltimateMinCandles())
+	}
+	buffer, err := NewOHLCVBuffer(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataset []OHLCV
+	return func(candle OHLCV) error {
+		buffer.Append(candle)
+		if buffer.Len() < config.UltimateMinCandles() {
+			return nil
+		}
+		dataset = buffer.AppendTo(dataset[:0])
+		if err := exporter.Observe(symbol, dataset, config); err != nil && !errors.Is(err, ErrInsufficientData{}) {
+			return err
+		}
+		return nil
+	}, nil
+}
+
+// ServeHTTP writes the metrics for a Prometheus scrape
+func (e *MetricsExporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	e.WriteMetrics(w)
+}
+
+// WriteMetrics writes every symbol's metrics in the Prometheus text exposition format
+func (e *MetricsExporter) WriteMetrics(w io.Writer) error {
+	e.mu.RLock()
+	symbols := make([]string, 0, len(e.symbols))
+	for symbol := range e.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	snapshot := make([]SymbolMetrics, len(symbols))
+	for i, symbol := range symbols {
+		snapshot[i] = e.symbols[symbol]
+	}
+	e.mu.RUnlock()
+
+	out := bufio.NewWriter(w)
+	gauge := func(name, help string, value func(SymbolMetrics) float64) {
+		name = e.namespace + "_" + name
+		fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+		for i, symbol := range symbols {
+			fmt.Fprintf(out, "%s{symbol=%s} %s\n", name, metricsLabel(symbol), metricsValue(value(snapshot[i])))
+		}
+	}
+
+	gauge("close_price", "Close of the latest candle.", func(m SymbolMetrics) float64 { return m.Close })
+	gauge("rsi", "Latest relative strength index (0-100).", func(m SymbolMetrics) float64 { return m.RSI })
+	gauge("bollinger_band_width", "Latest Bollinger Band width relative to the middle band.", func(m SymbolMetrics) float64 { return m.BandWidth })
+	gauge("volume_ratio", "Latest volume divided by its moving average.", func(m SymbolMetrics) float64 { return m.VolumeRatio })
+	gauge("final_signal", "Final signal as a number: -2 strong sell, -1 sell, 0 hold, 1 buy, 2 strong buy.", func(m SymbolMetrics) float64 {
+		return signalLevel(m.FinalSignal)
+	})
+	gauge("confidence_score", "Confidence of the final signal (0-1).", func(m SymbolMetrics) float64 { return m.ConfidenceScore })
+	gauge("risk_score", "Risk of the final signal (0-1, higher is riskier).", func(m SymbolMetrics) float64 { return m.RiskScore })
+	gauge("rug_pull_risk", "Rug pull risk: 0 low, 0.5 medium, 0.75 high, 1 extreme.", func(m SymbolMetrics) float64 {
+		return rugPullRiskScore(m.RugPullRisk)
+	})
+	gauge("last_update_timestamp_seconds", "Timestamp of the latest analyzed candle.", func(m SymbolMetrics) float64 {
+		if m.Updated.IsZero() {
+			return 0
+		}
+		return float64(m.Updated.UnixMilli()) / 1000
+	})
+
+	name := e.namespace + "_final_signal_state"
+	fmt.Fprintf(out, "# HELP %s Final signal as a state set: 1 for the current signal, 0 otherwise.\n# TYPE %s gauge\n", name, name)
+	for i, symbol := range symbols {
+		for _, signal := range metricsSignals {
+			value := 0
+			if snapshot[i].FinalSignal == signal {
+				value = 1
+			}
+			fmt.Fprintf(out, "%s{symbol=%s,signal=%q} %d\n", name, metricsLabel(symbol), signal, value)
+		}
+	}
+
+	return out.Flush()
+}
+
+// signalLevel maps a signal to -2..2 by direction and strength
+func signalLevel(signal Signal) float64 {
+	level := float64(signal.Direction())
+	if signal.IsStrong() {
+		level *= 2
+	}
+	return level
+}
+
+// metricsLabel quotes a label value, escaping backslashes, quotes, and newlines
+func metricsLabel(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value) + `"`
+}
+
+// metricsValue formats a sample value, using the exposition format's spelling of NaN and infinities
+func metricsValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
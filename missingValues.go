@@ -0,0 +1,86 @@
+package techindicators
+
+import "math"
+
+// MissingValuePolicy controls how calculations treat candles with missing or degenerate values:
+// NaN/Inf or non-positive prices, zero high-low range, and zero volume used as a divisor
+type MissingValuePolicy string
+
+const (
+	SkipMissing    MissingValuePolicy = "skip"          // Leave the affected candle or term out (default)
+	PropagateNaN   MissingValuePolicy = "propagate_nan" // Carry NaN into the affected outputs
+	ErrorOnMissing MissingValuePolicy = "error"         // Fail with ErrMissingValue
+)
+
+// validate checks that the policy is one of the known values ("" means SkipMissing)
+func (p MissingValuePolicy) validate() error {
+	switch p {
+	case "", SkipMissing, PropagateNaN, ErrorOnMissing:
+		return nil
+	}
+	return invalidParameter("unknown missing value policy: %s", p)
+}
+
+// HasMissingPrice reports whether any price field is NaN, infinite, or not positive
+func (o OHLCV) HasMissingPrice() bool {
+	for _, price := range []float64{o.Open, o.High, o.Low, o.Close} {
+		if math.IsNaN(price) || math.IsInf(price, 0) || price <= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyMissingValuePolicy prepares a dataset for the price indicators according to the policy.
+// SkipMissing drops candles with missing prices, PropagateNaN sets their prices to NaN so every
+// indicator window containing them yields NaN, and ErrorOnMissing fails on the first one.
+// The input slice is never modified; it is returned as is when no candle is affected.
+func ApplyMissingValuePolicy(dataset []OHLCV, policy MissingValuePolicy) ([]OHLCV, error) {
+	if err := policy.validate(); err != nil {
+		return nil, err
+	}
+
+	var cleaned []OHLCV
+	for i, candle := range dataset {
+		if !candle.HasMissingPrice() {
+			if cleaned != nil {
+				cleaned = append(cleaned, candle)
+			}
+			continue
+		}
+
+		if policy == ErrorOnMissing {
+			return nil, missingValue("missing price at index %d", i)
+		}
+
+		// Copy the untouched prefix on the first affected candle
+		if cleaned == nil {
+			cleaned = make([]OHLCV, i, len(dataset))
+			copy(cleaned, dataset[:i])
+		}
+
+		if policy == PropagateNaN {
+			nan := math.NaN()
+			candle.Open, candle.High, candle.Low, candle.Close = nan, nan, nan, nan
+			cleaned = append(cleaned, candle)
+		}
+	}
+
+	if cleaned == nil {
+		return dataset, nil
+	}
+	return cleaned, nil
+}
+
+// missingTerm resolves a term that cannot be computed for candle i under the policy.
+// It returns the value to use in place of the term (0 when skipping, NaN when propagating) or an error.
+func missingTerm(policy MissingValuePolicy, what string, i int) (float64, error) {
+	switch policy {
+	case PropagateNaN:
+		return math.NaN(), nil
+	case ErrorOnMissing:
+		return 0, missingValue("%s at index %d", what, i)
+	default:
+		return 0, nil
+	}
+}
@@ -0,0 +1,157 @@
+package techindicators
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// SimulationMethod selects how future returns are sampled
+type SimulationMethod string
+
+const (
+	BootstrapSimulation  SimulationMethod = "bootstrap"  // Resample historical log returns with replacement
+	ParametricSimulation SimulationMethod = "parametric" // Draw normal log returns (geometric Brownian motion)
+)
+
+// MonteCarloConfig configures a Monte Carlo price simulation
+type MonteCarloConfig struct {
+	Paths   int              `json:"paths"`   // Number of simulated price paths
+	Horizon int              `json:"horizon"` // Number of candles to project forward
+	Method  SimulationMethod `json:"method"`  // bootstrap, parametric
+	Seed    int64            `json:"seed"`    // Random seed for reproducible simulations
+}
+
+// PercentileBand represents a distribution summarized by percentiles
+type PercentileBand struct {
+	P5  float64 `json:"p5"`
+	P25 float64 `json:"p25"`
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P95 float64 `json:"p95"`
+}
+
+// MonteCarloResult represents the projected price and drawdown distributions
+type MonteCarloResult struct {
+	StartPrice  float64          `json:"start_price"`
+	PriceBands  []PercentileBand `json:"price_bands"`  // Price percentiles for each projected candle
+	FinalPrice  PercentileBand   `json:"final_price"`  // Price percentiles at the end of the horizon
+	MaxDrawdown PercentileBand   `json:"max_drawdown"` // Max drawdown percentiles per path (0.3 = 30%)
+	Method      SimulationMethod `json:"method"`
+	Paths       int              `json:"paths"`
+}
+
+// SimulateMonteCarlo projects future price paths from historical close-to-close log returns
+func SimulateMonteCarlo(dataset []OHLCV, config MonteCarloConfig) (MonteCarloResult, error) {
+	return SimulateMonteCarloContext(context.Background(), dataset, config)
+}
+
+// SimulateMonteCarloContext is SimulateMonteCarlo with cancellation, checked before each simulated path
+func SimulateMonteCarloContext(ctx context.Context, dataset []OHLCV, config MonteCarloConfig) (MonteCarloResult, error) {
+	if len(dataset) < 3 {
+		return MonteCarloResult{}, ErrInsufficientData{Need: 3, Have: len(dataset)}
+	}
+
+	if config.Paths <= 0 || config.Horizon <= 0 {
+		return MonteCarloResult{}, invalidParameter("paths and horizon must be greater than 0")
+	}
+
+	if config.Method != BootstrapSimulation && config.Method != ParametricSimulation {
+		return MonteCarloResult{}, invalidParameter("unknown simulation method: %s", config.Method)
+	}
+
+	logReturns, err := LogReturnsFromOHLCV(dataset, 1)
+	if err != nil {
+		return MonteCarloResult{}, err
+	}
+
+	mean := average(logReturns)
+	sd := stdDev(logReturns, mean)
+	rng := rand.New(rand.NewSource(config.Seed))
+	startPrice := dataset[len(dataset)-1].Close
+
+	// prices[step][path] holds the simulated price at each projected candle
+	prices := make([][]float64, config.Horizon)
+	for step := range prices {
+		prices[step] = make([]float64, config.Paths)
+	}
+	drawdowns := make([]float64, config.Paths)
+
+	for path := 0; path < config.Paths; path++ {
+		if err := ctx.Err(); err != nil {
+			return MonteCarloResult{}, err
+		}
+
+		price := startPrice
+		peak := startPrice
+		maxDrawdown := 0.0
+
+		for step := 0; step < config.Horizon; step++ {
+			var r float64
+			if config.Method == BootstrapSimulation {
+				r = logReturns[rng.Intn(len(logReturns))]
+			} else {
+				r = mean + sd*rng.NormFloat64()
+			}
+
+			price *= math.Exp(r)
+			prices[step][path] = price
+
+			if price > peak {
+				peak = price
+			}
+			if drawdown := (peak - price) / peak; drawdown > maxDrawdown {
+				maxDrawdown = drawdown
+			}
+		}
+
+		drawdowns[path] = maxDrawdown
+	}
+
+	bands := make([]PercentileBand, config.Horizon)
+	for step := range prices {
+		bands[step] = percentileBand(prices[step])
+	}
+
+	return MonteCarloResult{
+		StartPrice:  startPrice,
+		PriceBands:  bands,
+		FinalPrice:  bands[len(bands)-1],
+		MaxDrawdown: percentileBand(drawdowns),
+		Method:      config.Method,
+		Paths:       config.Paths,
+	}, nil
+}
+
+// percentileBand summarizes values by their 5th, 25th, 50th, 75th, and 95th percentiles
+func percentileBand(values []float64) PercentileBand {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	return PercentileBand{
+		P5:  percentile(sorted, 5),
+		P25: percentile(sorted, 25),
+		P50: percentile(sorted, 50),
+		P75: percentile(sorted, 75),
+		P95: percentile(sorted, 95),
+	}
+}
+
+// percentile returns the p-th percentile (0-100) of sorted values using linear interpolation
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (rank-float64(lower))*(sorted[upper]-sorted[lower])
+}
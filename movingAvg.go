@@ -1,8 +1,10 @@
 package techindicators
 
 import (
-	"errors"
 	"fmt"
+	"math"
+	"slices"
+	"time"
 )
 
 // PriceType represents which price to use for SMA calculation
@@ -15,38 +17,50 @@ const (
 	LowPrice
 	TypicalPrice  // (High + Low + Close) / 3
 	WeightedPrice // (High + Low + 2*Close) / 4
+	MedianPrice   // (High + Low) / 2
+	OHLC4Price    // (Open + High + Low + Close) / 4
 )
 
 // SMAResult represents the result of SMA calculation
 type SMAResult struct {
-	Timestamp string  `json:"timestamp"`
-	Value     float64 `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
 }
 
 // CalculateSMA calculates Simple Moving Average for the given dataset
 func CalculateSMA(dataset []OHLCV, period int, priceType PriceType) ([]SMAResult, error) {
+	return AppendSMA(nil, dataset, period, priceType)
+}
+
+// AppendSMA appends the SMA series for the dataset to dst and returns the extended slice.
+// Passing a reused buffer (e.g. dst[:0]) avoids allocating a new result slice on every call.
+func AppendSMA(dst []SMAResult, dataset []OHLCV, period int, priceType PriceType) ([]SMAResult, error) {
 	if len(dataset) == 0 {
-		return nil, errors.New("dataset is empty")
+		return dst, ErrEmptyDataset
 	}
 
 	if period <= 0 {
-		return nil, errors.New("period must be greater than 0")
+		return dst, invalidPeriod("period must be greater than 0")
 	}
 
 	if period > len(dataset) {
-		return nil, fmt.Errorf("period (%d) cannot be greater than dataset length (%d)", period, len(dataset))
+		return dst, ErrInsufficientData{Need: period, Have: len(dataset)}
 	}
 
-	var results []SMAResult
+	results := slices.Grow(dst, len(dataset)-period+1)
+
+	// Running sum of the window: add the newest price and drop the oldest
+	sum := 0.0
 
 	// Calculate SMA for each possible position
 	for i := period - 1; i < len(dataset); i++ {
-		sum := 0.0
-
-		// Sum the last 'period' values
-		for j := i - period + 1; j <= i; j++ {
-			price := dataset[j].ExtractPrice(priceType)
-			sum += price
+		if start := i - period + 1; rebuildWindow(start, period) || nonFinite(dataset[start-1].ExtractPrice(priceType)) {
+			sum = 0
+			for j := start; j <= i; j++ {
+				sum += dataset[j].ExtractPrice(priceType)
+			}
+		} else {
+			sum += dataset[i].ExtractPrice(priceType) - dataset[start-1].ExtractPrice(priceType)
 		}
 
 		// Calculate average
@@ -54,7 +68,7 @@ func CalculateSMA(dataset []OHLCV, period int, priceType PriceType) ([]SMAResult
 
 		// Add result with corresponding timestamp
 		results = append(results, SMAResult{
-			Timestamp: dataset[i].Timestamp.Format("2006-01-02T15:04:05Z"),
+			Timestamp: dataset[i].Timestamp,
 			Value:     smaValue,
 		})
 	}
@@ -62,6 +76,19 @@ func CalculateSMA(dataset []OHLCV, period int, priceType PriceType) ([]SMAResult
 	return results, nil
 }
 
+// rebuildWindow reports whether a sliding window starting at start is recomputed from its values instead
+// of updated by adding the newest and subtracting the oldest. Rebuilding once per period keeps the rounding
+// error of the running updates from accumulating as prices drift, while the cost stays O(n) overall.
+// A window is also rebuilt when a nonFinite value leaves it, since that value poisoned the running state.
+func rebuildWindow(start, period int) bool {
+	return start%period == 0
+}
+
+// nonFinite reports whether a value is NaN or infinite, e.g. a price set to NaN by PropagateNaN
+func nonFinite(value float64) bool {
+	return math.IsNaN(value) || math.IsInf(value, 0)
+}
+
 // CalculateMultipleSMA calculates multiple SMAs with different periods
 func CalculateMultipleSMA(dataset []OHLCV, periods []int, priceType PriceType) (map[int][]SMAResult, error) {
 	results := make(map[int][]SMAResult)
@@ -85,7 +112,7 @@ func GetLatestSMA(dataset []OHLCV, period int, priceType PriceType) (float64, er
 	}
 
 	if len(smaResults) == 0 {
-		return 0, errors.New("no SMA results calculated")
+		return 0, noResults("SMA")
 	}
 
 	return smaResults[len(smaResults)-1].Value, nil
@@ -94,7 +121,7 @@ func GetLatestSMA(dataset []OHLCV, period int, priceType PriceType) (float64, er
 // IsPriceAboveSMA checks if current price is above the SMA
 func IsPriceAboveSMA(dataset []OHLCV, period int, priceType PriceType) (bool, error) {
 	if len(dataset) == 0 {
-		return false, errors.New("dataset is empty")
+		return false, ErrEmptyDataset
 	}
 
 	// Get latest SMA
@@ -110,13 +137,13 @@ func IsPriceAboveSMA(dataset []OHLCV, period int, priceType PriceType) (bool, er
 }
 
 // SMACrossover detects if there's a bullish/bearish crossover between two SMAs
-func SMACrossover(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceType) (string, error) {
+func SMACrossover(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceType) (Signal, error) {
 	if fastPeriod >= slowPeriod {
-		return "", errors.New("fast period must be less than slow period")
+		return "", invalidPeriod("fast period must be less than slow period")
 	}
 
 	if len(dataset) < slowPeriod+1 {
-		return "", errors.New("insufficient data for crossover analysis")
+		return "", ErrInsufficientData{Need: slowPeriod + 1, Have: len(dataset)}
 	}
 
 	// Calculate both SMAs
@@ -130,9 +157,14 @@ func SMACrossover(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceTy
 		return "", err
 	}
 
+	return smaCrossoverFromSeries(fastSMA, slowSMA), nil
+}
+
+// smaCrossoverFromSeries detects a crossover between the last two points of the fast and slow SMA series
+func smaCrossoverFromSeries(fastSMA, slowSMA []SMAResult) Signal {
 	// Need at least 2 points to detect crossover
 	if len(fastSMA) < 2 || len(slowSMA) < 2 {
-		return "no_signal", nil
+		return SignalNoSignal
 	}
 
 	// Get current and previous values (aligned by timestamp)
@@ -142,11 +174,107 @@ func SMACrossover(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceTy
 	slowPrevious := slowSMA[len(slowSMA)-2].Value
 
 	// Check for crossover
-	if fastPrevious <= slowPrevious && fastCurrent > slowCurrent {
-		return "bullish_crossover", nil
-	} else if fastPrevious >= slowPrevious && fastCurrent < slowCurrent {
-		return "bearish_crossover", nil
+	if crossedAbove(fastPrevious, fastCurrent, slowPrevious, slowCurrent) {
+		return SignalBullishCrossover
+	} else if crossedBelow(fastPrevious, fastCurrent, slowPrevious, slowCurrent) {
+		return SignalBearishCrossover
+	}
+
+	return SignalNoSignal
+}
+
+// CrossoverEvent is one crossing of the fast moving average over the slow one
+type CrossoverEvent struct {
+	Index     int       `json:"index"` // Dataset index of the candle the crossing completed on
+	Timestamp time.Time `json:"timestamp"`
+	Signal    Signal    `json:"signal"` // SignalBullishCrossover or SignalBearishCrossover
+	Fast      float64   `json:"fast"`
+	Slow      float64   `json:"slow"`
+}
+
+// SMACrossoverHistory returns every crossover between the two SMAs in the dataset, in dataset order.
+// Each candle is judged like SMACrossover judges the latest one, so the last event is on the latest
+// candle exactly when SMACrossover reports a crossover.
+func SMACrossoverHistory(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceType) ([]CrossoverEvent, error) {
+	fastSMA, slowSMA, err := crossoverSMAs(dataset, fastPeriod, slowPeriod, priceType)
+	if err != nil {
+		return nil, err
+	}
+	return crossoverHistory(fastSMA, slowSMA, len(dataset)), nil
+}
+
+// crossoverSMAs validates the periods of a crossover history and calculates its two SMA series
+func crossoverSMAs(dataset []OHLCV, fastPeriod, slowPeriod int, priceType PriceType) (fastSMA, slowSMA []SMAResult, err error) {
+	if fastPeriod >= slowPeriod {
+		return nil, nil, invalidPeriod("fast period must be less than slow period")
+	}
+
+	if len(dataset) < slowPeriod+1 {
+		return nil, nil, ErrInsufficientData{Need: slowPeriod + 1, Have: len(dataset)}
+	}
+
+	if fastSMA, err = CalculateSMA(dataset, fastPeriod, priceType); err != nil {
+		return nil, nil, err
+	}
+	if slowSMA, err = CalculateSMA(dataset, slowPeriod, priceType); err != nil {
+		return nil, nil, err
+	}
+	return fastSMA, slowSMA, nil
+}
+
+// crossoverHistory lists the crossovers of two moving average series aligned at their ends, indexed into
+// a dataset of n candles
+func crossoverHistory(fast, slow []SMAResult, n int) []CrossoverEvent {
+	event := func(i int, signal Signal) CrossoverEvent {
+		return CrossoverEvent{
+			Index:     n - len(fast) + i,
+			Timestamp: fast[i].Timestamp,
+			Signal:    signal,
+			Fast:      fast[i].Value,
+			Slow:      slow[i-len(fast)+len(slow)].Value,
+		}
+	}
+
+	var events []CrossoverEvent
+	for _, i := range CrossAboveSMA(fast, slow) {
+		events = append(events, event(i, SignalBullishCrossover))
+	}
+	for _, i := range CrossBelowSMA(fast, slow) {
+		events = append(events, event(i, SignalBearishCrossover))
+	}
+	slices.SortFunc(events, func(a, b CrossoverEvent) int { return a.Index - b.Index })
+	return events
+}
+
+// CalculateEMA calculates the Exponential Moving Average, seeded with the SMA of the first period
+// candles and smoothed with alpha = 2 / (period + 1)
+func CalculateEMA(dataset []OHLCV, period int, priceType PriceType) ([]SMAResult, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
 	}
 
-	return "no_signal", nil
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	if period > len(dataset) {
+		return nil, ErrInsufficientData{Need: period, Have: len(dataset)}
+	}
+
+	results := make([]SMAResult, 0, len(dataset)-period+1)
+
+	ema := 0.0
+	for _, candle := range dataset[:period] {
+		ema += candle.ExtractPrice(priceType)
+	}
+	ema /= float64(period)
+	results = append(results, SMAResult{Timestamp: dataset[period-1].Timestamp, Value: ema})
+
+	alpha := 2 / float64(period+1)
+	for i := period; i < len(dataset); i++ {
+		ema += alpha * (dataset[i].ExtractPrice(priceType) - ema)
+		results = append(results, SMAResult{Timestamp: dataset[i].Timestamp, Value: ema})
+	}
+
+	return results, nil
 }
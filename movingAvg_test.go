@@ -0,0 +1,111 @@
+package techindicators
+
+import (
+	"math"
+	"testing"
+)
+
+// benchmarkCandles is the dataset length of the sliding-window benchmarks
+const benchmarkCandles = 100_000
+
+// slidingTolerance is the relative error allowed between a sliding-window series and its naive recomputation
+const slidingTolerance = 1e-9
+
+// syntheticCandles generates n candles with a fixed seed
+func syntheticCandles(tb testing.TB, n int) []OHLCV {
+	tb.Helper()
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: n})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return dataset
+}
+
+// assertClose fails when got differs from want by more than slidingTolerance relative to scale
+func assertClose(tb testing.TB, name string, i int, got, want, scale float64) {
+	tb.Helper()
+	if math.Abs(got-want) > slidingTolerance*math.Max(math.Abs(scale), 1e-12) {
+		tb.Fatalf("%s[%d] = %v; naive recomputation %v", name, i, got, want)
+	}
+}
+
+// naiveSMA recomputes every window sum from scratch
+func naiveSMA(dataset []OHLCV, period int) []SMAResult {
+	results := make([]SMAResult, 0, len(dataset)-period+1)
+	for i := period - 1; i < len(dataset); i++ {
+		sum := 0.0
+		for _, candle := range dataset[i-period+1 : i+1] {
+			sum += candle.ExtractPrice(ClosePrice)
+		}
+		results = append(results, SMAResult{Timestamp: dataset[i].Timestamp, Value: sum / float64(period)})
+	}
+	return results
+}
+
+func TestCalculateSMAMatchesNaive(t *testing.T) {
+	dataset := syntheticCandles(t, benchmarkCandles)
+	for _, period := range []int{1, 7, 20, 200} {
+		sma, err := CalculateSMA(dataset, period, ClosePrice)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, want := range naiveSMA(dataset, period) {
+			assertClose(t, "SMA", i, sma[i].Value, want.Value, want.Value)
+		}
+	}
+}
+
+func BenchmarkCalculateSMA(b *testing.B) {
+	dataset := syntheticCandles(b, benchmarkCandles)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := CalculateSMA(dataset, 20, ClosePrice); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNaiveSMA(b *testing.B) {
+	dataset := syntheticCandles(b, benchmarkCandles)
+	b.ResetTimer()
+	for range b.N {
+		naiveSMA(dataset, 20)
+	}
+}
+
+// nanCandles returns 40 candles with the close of candle 12 missing under PropagateNaN
+func nanCandles(tb testing.TB) []OHLCV {
+	tb.Helper()
+	dataset := syntheticCandles(tb, 40)
+	dataset[12].Close = math.NaN()
+	dataset, err := ApplyMissingValuePolicy(dataset, PropagateNaN)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return dataset
+}
+
+// windowHoldsNaN reports whether the window of period candles ending at dataset index end contains candle 12
+func windowHoldsNaN(end, period int) bool {
+	return end >= 12 && end-period+1 <= 12
+}
+
+func TestCalculateSMAPropagateNaNOnlyInAffectedWindows(t *testing.T) {
+	dataset := nanCandles(t)
+	const period = 10
+	sma, err := CalculateSMA(dataset, period, ClosePrice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range sma {
+		end := i + period - 1
+		if got, want := math.IsNaN(r.Value), windowHoldsNaN(end, period); got != want {
+			t.Fatalf("SMA at candle %d is NaN = %v; want %v", end, got, want)
+		}
+	}
+	for i, want := range naiveSMA(dataset, period) {
+		if !windowHoldsNaN(i+period-1, period) {
+			assertClose(t, "SMA", i, sma[i].Value, want.Value, want.Value)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package techindicators
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// TimeframeAnalysis is the comprehensive analysis of the dataset resampled to one interval
+type TimeframeAnalysis struct {
+	Interval time.Duration             `json:"interval"`
+	Candles  int                       `json:"candles"`
+	Signal   Signal                    `json:"signal"` // Final signal, or insufficient_data when the timeframe is too short
+	Analysis CombinedTechnicalAnalysis `json:"analysis"`
+	Supplied bool                      `json:"supplied,omitempty"` // From AnalysisConfig.HigherTimeframe instead of resampled
+}
+
+// MultiTimeframeAnalysis reports per-timeframe signals and how well they agree
+type MultiTimeframeAnalysis struct {
+	Timeframes     []TimeframeAnalysis `json:"timeframes"`      // Ordered from the shortest to the longest interval
+	Direction      float64             `json:"direction"`       // Mean signal direction of the analyzed timeframes (-1 to 1)
+	AlignmentScore float64             `json:"alignment_score"` // Share of analyzed timeframes agreeing with the overall direction (0-1)
+	Signal         Signal              `json:"signal"`          // bullish, bearish, or neutral overall bias
+}
+
+// AnalyzeMultiTimeframe resamples the dataset to each interval and runs the same comprehensive
+// analysis on every timeframe. Timeframes with too few candles for the configured indicators are
+// reported as insufficient_data and left out of the direction and alignment score.
+func AnalyzeMultiTimeframe(dataset []OHLCV, intervals []time.Duration, config AnalysisConfig) (MultiTimeframeAnalysis, error) {
+	return AnalyzeMultiTimeframeContext(context.Background(), dataset, intervals, config)
+}
+
+// AnalyzeMultiTimeframeContext is AnalyzeMultiTimeframe with cancellation, checked before each timeframe
+func AnalyzeMultiTimeframeContext(ctx context.Context, dataset []OHLCV, intervals []time.Duration, config AnalysisConfig) (MultiTimeframeAnalysis, error) {
+	if len(intervals) == 0 {
+		return MultiTimeframeAnalysis{}, invalidParameter("at least one timeframe is required")
+	}
+
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return MultiTimeframeAnalysis{}, err
+	}
+
+	sorted := make([]time.Duration, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var timeframes []TimeframeAnalysis
+	for _, interval := range sorted {
+		if err := ctx.Err(); err != nil {
+			return MultiTimeframeAnalysis{}, err
+		}
+
+		candles, err := Resample(dataset, interval)
+		if err != nil {
+			return MultiTimeframeAnalysis{}, err
+		}
+
+		timeframe, err := analyzeTimeframe(ctx, candles, interval, config)
+		if err != nil {
+			return MultiTimeframeAnalysis{}, err
+		}
+		timeframes = append(timeframes, timeframe)
+	}
+
+	return summarizeTimeframes(timeframes), nil
+}
+
+// analyzeTimeframe runs the comprehensive analysis on the candles of one timeframe, reporting
+// insufficient_data when there are too few of them
+func analyzeTimeframe(ctx context.Context, candles []OHLCV, interval time.Duration, config AnalysisConfig) (TimeframeAnalysis, error) {
+	timeframe := TimeframeAnalysis{Interval: interval, Candles: len(candles), Signal: SignalInsufficientData}
+	if len(candles) < config.MinCandles() {
+		return timeframe, nil
+	}
+
+	analysis, err := ComprehensiveAnalysisContext(ctx, candles, config)
+	if err != nil {
+		return TimeframeAnalysis{}, err
+	}
+	timeframe.Analysis = analysis
+	timeframe.Signal = analysis.FinalSignal
+	return timeframe, nil
+}
+
+// summarizeTimeframes combines the per-timeframe signals into the overall bias and alignment score
+func summarizeTimeframes(timeframes []TimeframeAnalysis) MultiTimeframeAnalysis {
+	result := MultiTimeframeAnalysis{Timeframes: timeframes, Signal: SignalNeutral}
+	analyzed, directionSum := 0, 0
+	for _, timeframe := range timeframes {
+		if timeframe.Signal != SignalInsufficientData {
+			analyzed++
+			directionSum += timeframe.Signal.Direction()
+		}
+	}
+	if analyzed == 0 {
+		return result
+	}
+
+	result.Direction = float64(directionSum) / float64(analyzed)
+	overall := 0
+	switch {
+	case result.Direction > 0:
+		overall = 1
+		result.Signal = SignalBullish
+	case result.Direction < 0:
+		overall = -1
+		result.Signal = SignalBearish
+	}
+
+	agreeing := 0
+	for _, timeframe := range result.Timeframes {
+		if timeframe.Signal != SignalInsufficientData && timeframe.Signal.Direction() == overall {
+			agreeing++
+		}
+	}
+	result.AlignmentScore = float64(agreeing) / float64(analyzed)
+
+	return result
+}
+
+// confirmationTimeframes analyzes the higher timeframes of an ultimate analysis: the dataset resampled
+// to ConfirmTimeframes plus the HigherTimeframe dataset. Only the supplied candles that have closed by the
+// close of the latest candle of the dataset are used, so a still-forming higher-timeframe candle cannot leak
+// its final close into a backtest. Each candle closes one interval after it opens; the supplied interval is
+// the shortest spacing of its candles and the dataset's the spacing of its latest two.
+func confirmationTimeframes(ctx context.Context, dataset []OHLCV, config AnalysisConfig) (MultiTimeframeAnalysis, error) {
+	var result MultiTimeframeAnalysis
+	if len(config.ConfirmTimeframes) > 0 {
+		var err error
+		if result, err = AnalyzeMultiTimeframeContext(ctx, dataset, config.ConfirmTimeframes, config); err != nil {
+			return MultiTimeframeAnalysis{}, err
+		}
+	}
+	if len(config.HigherTimeframe) == 0 {
+		return result, nil
+	}
+
+	latestClose := dataset[len(dataset)-1].Timestamp
+	if n := len(dataset); n >= 2 {
+		latestClose = latestClose.Add(dataset[n-1].Timestamp.Sub(dataset[n-2].Timestamp))
+	}
+	interval := time.Duration(0)
+	for i := 1; i < len(config.HigherTimeframe); i++ {
+		if spacing := config.HigherTimeframe[i].Timestamp.Sub(config.HigherTimeframe[i-1].Timestamp); interval == 0 || spacing < interval {
+			interval = spacing
+		}
+	}
+	candles := config.HigherTimeframe[:sort.Search(len(config.HigherTimeframe), func(i int) bool {
+		return config.HigherTimeframe[i].Timestamp.Add(interval).After(latestClose)
+	})]
+
+	supplied, err := analyzeTimeframe(ctx, candles, interval, config)
+	if err != nil {
+		return MultiTimeframeAnalysis{}, err
+	}
+	supplied.Supplied = true
+
+	timeframes := append(result.Timeframes, supplied)
+	sort.SliceStable(timeframes, func(i, j int) bool { return timeframes[i].Interval < timeframes[j].Interval })
+	return summarizeTimeframes(timeframes), nil
+}
+
+// confirmWithTimeframes adjusts an ultimate analysis by the higher-timeframe bias: a signal against the
+// higher timeframes becomes HOLD, and a strong signal without their agreement is downgraded to a regular one
+func confirmWithTimeframes(analysis *UltimateMemecoinAnalysis, timeframes MultiTimeframeAnalysis) {
+	direction := analysis.FinalSignal.Direction()
+	if direction == 0 {
+		return
+	}
+
+	htfDirection := timeframes.Signal.Direction()
+	switch {
+	case htfDirection == -direction:
+		analysis.FinalSignal = SignalHold
+		analysis.Confidence = "LOW"
+		analysis.ConfidenceScore = math.Min(analysis.ConfidenceScore, 0.3)
+	case htfDirection == 0 && analysis.FinalSignal == SignalStrongBuy:
+		analysis.FinalSignal = SignalBuy
+	case htfDirection == 0 && analysis.FinalSignal == SignalStrongSell:
+		analysis.FinalSignal = SignalSell
+	}
+}
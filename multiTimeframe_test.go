@@ -0,0 +1,61 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestConfirmationTimeframesIgnoresFormingCandle(t *testing.T) {
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	higher, err := Resample(dataset, 4*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := NewAnalysisConfig(WithHigherTimeframe(higher))
+
+	// The latest candle opens one hour into a 4h candle that is still forming
+	history := dataset[:len(dataset)-2]
+	for len(history)%4 != 2 {
+		history = history[:len(history)-1]
+	}
+	result, err := confirmationTimeframes(context.Background(), history, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := result.Timeframes[0].Candles, len(history)/4; got != want {
+		t.Fatalf("supplied timeframe used %d candles; want the %d closed ones", got, want)
+	}
+
+	// Once the latest candle closes the 4h candle, it is used
+	history = dataset[:len(history)+2]
+	result, err = confirmationTimeframes(context.Background(), history, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := result.Timeframes[0].Candles, len(history)/4; got != want {
+		t.Fatalf("supplied timeframe used %d candles; want %d", got, want)
+	}
+}
+
+func TestHigherTimeframeMustBeSorted(t *testing.T) {
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: 300})
+	if err != nil {
+		t.Fatal(err)
+	}
+	higher, err := Resample(dataset, 4*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Reverse(higher)
+
+	_, err = UltimateAnalysisWithConfig(dataset, NewAnalysisConfig(WithHigherTimeframe(higher)))
+	if !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("error = %v; want ErrInvalidParameter", err)
+	}
+}
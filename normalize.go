@@ -0,0 +1,52 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+)
+
+// DuplicatePolicy selects how NormalizeDataset resolves candles that share a timestamp
+type DuplicatePolicy string
+
+const (
+	KeepFirstDuplicate DuplicatePolicy = "keep_first" // Keep the candle that appeared first in the input
+	KeepLastDuplicate  DuplicatePolicy = "keep_last"  // Keep the candle that appeared last in the input
+	MergeDuplicates    DuplicatePolicy = "merge"      // First open, last close, max high, min low, summed volume
+)
+
+// NormalizeDataset sorts candles by timestamp and resolves duplicate timestamps according to the policy.
+// Mixed API sources often deliver candles out of order or more than once; the input slice is not modified.
+// Among duplicates, "first" and "last" refer to their order in the input.
+func NormalizeDataset(dataset []OHLCV, duplicates DuplicatePolicy) ([]OHLCV, error) {
+	if duplicates != KeepFirstDuplicate && duplicates != KeepLastDuplicate && duplicates != MergeDuplicates {
+		return nil, invalidParameter("unknown duplicate policy: %s", duplicates)
+	}
+
+	sorted := make([]OHLCV, len(dataset))
+	copy(sorted, dataset)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	normalized := make([]OHLCV, 0, len(sorted))
+	for _, candle := range sorted {
+		last := len(normalized) - 1
+		if last < 0 || !normalized[last].Timestamp.Equal(candle.Timestamp) {
+			normalized = append(normalized, candle)
+			continue
+		}
+
+		switch duplicates {
+		case KeepLastDuplicate:
+			normalized[last] = candle
+		case MergeDuplicates:
+			merged := &normalized[last]
+			merged.High = math.Max(merged.High, candle.High)
+			merged.Low = math.Min(merged.Low, candle.Low)
+			merged.Close = candle.Close
+			merged.Volume += candle.Volume
+		}
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,291 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Notifier delivers an analysis alert for a symbol to a chat or messaging service
+type Notifier interface {
+	Notify(ctx context.Context, symbol string, analysis UltimateMemecoinAnalysis) error
+}
+
+// Recommendation returns the emoji trading recommendation for the final signal, one line per entry
+func (a UltimateMemecoinAnalysis) Recommendation() []string {
+	switch a.FinalSignal {
+	case SignalStrongBuy:
+		return []string{
+			"🚀 EXECUTE AGGRESSIVE BUY",
+			"   ✅ All technical indicators bullish",
+			"   ✅ Volume confirms breakout/accumulation",
+			"   ✅ Low rug pull risk",
+			fmt.Sprintf("   📊 Position: 3-5%% of portfolio (Risk: %s)", a.RiskLevel),
+		}
+	case SignalBuy:
+		volume := "   ⚠️ Volume doesn't fully confirm"
+		if a.VolumeConfirm {
+			volume = "   ✅ Volume supports the move"
+		}
+		return []string{
+			"📈 EXECUTE STANDARD BUY",
+			"   ✅ Majority indicators bullish",
+			volume,
+			fmt.Sprintf("   📊 Position: 2-3%% of portfolio (Risk: %s)", a.RiskLevel),
+		}
+	case SignalStrongSell:
+		return []string{
+			"🔴 EXECUTE IMMEDIATE SELL",
+			"   ❌ All indicators bearish",
+			"   ❌ High distribution detected",
+			fmt.Sprintf("   🚨 Rug Pull Risk: %s", a.RugPullRisk),
+		}
+	case SignalSell:
+		return []string{
+			"📉 EXECUTE GRADUAL SELL",
+			"   ❌ Majority indicators bearish",
+			fmt.Sprintf("   🚨 Rug Pull Risk: %s", a.RugPullRisk),
+		}
+	case SignalWait:
+		return []string{
+			"⏳ WAIT FOR OPTIMAL ENTRY",
+			"   🔄 Low volatility squeeze detected",
+			"   📊 Prepare for potential breakout",
+			"   🔔 Set alerts for volume spikes",
+		}
+	case SignalLongLiquidation, SignalShortLiquidation:
+		side, move := "LONG", "flush"
+		if a.FinalSignal == SignalShortLiquidation {
+			side, move = "SHORT", "squeeze"
+		}
+		lines := []string{fmt.Sprintf("💥 %s LIQUIDATION CASCADE", side)}
+		if c := a.Liquidation; c != nil {
+			lines = append(lines, fmt.Sprintf("   📊 %+.1f%% wick on %.1fx volume, %.0f%% retraced", 100*c.Wick, c.VolumeRatio, 100*c.Retrace))
+		}
+		return append(lines,
+			fmt.Sprintf("   ⚠️ Forced %s, not an organic trend move", move),
+			"   ⏳ Wait for the order book to settle before trading",
+		)
+	case SignalSuspicious:
+		switch {
+		case a.Honeypot:
+			return []string{
+				"🍯 HONEYPOT SUSPECTED",
+				"   ⚠️ Price only rises: buys fill, sells may be blocked",
+				"   🚫 DO NOT BUY",
+			}
+		case a.Illiquid:
+			return []string{
+				"💧 ILLIQUID MARKET",
+				"   ⚠️ Price moves on near-zero volume",
+				"   📉 Orders may not fill near the charted price",
+				"   🚫 AVOID TRADING",
+			}
+		}
+		if a.Manipulation != nil {
+			if event, ok := a.Manipulation.Largest(); ok {
+				return []string{
+					"🚨 SUSPICIOUS ACTIVITY DETECTED",
+					fmt.Sprintf("   ⚠️ %d price moves on a thin order book", len(a.Manipulation.Events)),
+					fmt.Sprintf("   🤖 Bot manipulation: %s", event),
+					"   🚫 AVOID TRADING",
+				}
+			}
+		}
+		return []string{
+			"🚨 SUSPICIOUS ACTIVITY DETECTED",
+			"   ⚠️ Low volume on price moves",
+			"   🤖 Potential bot manipulation",
+			"   🚫 AVOID TRADING",
+		}
+	default:
+		return []string{
+			"🤔 MAINTAIN CURRENT POSITION",
+			"   📊 Mixed or weak signals",
+			fmt.Sprintf("   📈 Volume Confirmation: %v", a.VolumeConfirm),
+		}
+	}
+}
+
+// FormatAnalysisMessage renders an analysis as a plain-text chat message with the signal summary and recommendation
+func FormatAnalysisMessage(symbol string, analysis UltimateMemecoinAnalysis) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "🎯 %s: %s\n\n", symbol, analysis.FinalSignal.Label())
+	for _, line := range analysisSummary(analysis) {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	b.WriteString(strings.Join(analysis.Recommendation(), "\n"))
+	return b.String()
+}
+
+// analysisSummary lists the signal details shown under the message title
+func analysisSummary(analysis UltimateMemecoinAnalysis) []string {
+	lines := []string{
+		fmt.Sprintf("📈 Technical: %s (%s confidence)", analysis.Technical.FinalSignal.Label(), analysis.Technical.Confidence),
+		fmt.Sprintf("📊 SMA: %s · Bollinger: %s · RSI: %s", analysis.Technical.SMASignal, analysis.Technical.BollingerSignal, analysis.Technical.RSISignal),
+		fmt.Sprintf("🔊 Volume: %s (ratio %.2f, confirms: %v)", analysis.Volume.Signal, analysis.Volume.VolumeRatio, analysis.VolumeConfirm),
+		fmt.Sprintf("🔥 Confidence: %s (%.0f%%)", analysis.Confidence, analysis.ConfidenceScore*100),
+		fmt.Sprintf("🎯 Strength: %.0f/100", analysis.SignalStrength),
+		fmt.Sprintf("⚠️ Risk: %s (%.0f%%)", analysis.RiskLevel, analysis.RiskScore*100),
+		fmt.Sprintf("🚨 Rug pull risk: %s", analysis.RugPullRisk),
+	}
+	if young := analysis.YoungToken; young != nil {
+		lines = append(lines, fmt.Sprintf("🐣 Young token: %d of %d candles, shortened periods", young.Candles, young.Required))
+	}
+	return lines
+}
+
+// TelegramNotifier sends analyses through a Telegram bot with the Bot API sendMessage method
+type TelegramNotifier struct {
+	BaseURL    string // Defaults to https://api.telegram.org
+	HTTPClient *http.Client
+	BotToken   string
+	ChatID     string // Numeric chat ID or @channelusername
+
+	DisableNotification bool // Deliver silently
+}
+
+// NewTelegramNotifier returns a notifier posting to the chat with the bot token from @BotFather
+func NewTelegramNotifier(botToken, chatID string) *TelegramNotifier {
+	return &TelegramNotifier{BaseURL: "https://api.telegram.org", BotToken: botToken, ChatID: chatID}
+}
+
+// Notify sends the formatted analysis, with the title in bold
+func (t *TelegramNotifier) Notify(ctx context.Context, symbol string, analysis UltimateMemecoinAnalysis) error {
+	if t.BotToken == "" || t.ChatID == "" {
+		return invalidParameter("telegram bot token and chat ID are required")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "🎯 <b>%s: %s</b>\n\n", html.EscapeString(symbol), analysis.FinalSignal.Label())
+	for _, line := range analysisSummary(analysis) {
+		b.WriteString(html.EscapeString(line))
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	b.WriteString(html.EscapeString(strings.Join(analysis.Recommendation(), "\n")))
+
+	payload := map[string]any{
+		"chat_id":              t.ChatID,
+		"text":                 b.String(),
+		"parse_mode":           "HTML",
+		"disable_notification": t.DisableNotification,
+	}
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimRight(t.BaseURL, "/"), t.BotToken)
+	return postJSON(ctx, t.HTTPClient, "telegram", url, payload)
+}
+
+// DiscordNotifier sends analyses to a Discord channel through an incoming webhook
+type DiscordNotifier struct {
+	WebhookURL string
+	HTTPClient *http.Client
+	Username   string // Overrides the webhook's default name when set
+}
+
+// NewDiscordNotifier returns a notifier posting to the webhook URL from the channel's integration settings
+func NewDiscordNotifier(webhookURL string) *DiscordNotifier {
+	return &DiscordNotifier{WebhookURL: webhookURL}
+}
+
+// discordEmbed is the subset of a Discord embed object used for alerts
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Color       int            `json:"color"`
+	Fields      []discordField `json:"fields"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// Notify posts the analysis as an embed colored by signal direction
+func (d *DiscordNotifier) Notify(ctx context.Context, symbol string, analysis UltimateMemecoinAnalysis) error {
+	if d.WebhookURL == "" {
+		return invalidParameter("discord webhook URL is required")
+	}
+
+	color := 0x95a5a6 // Grey for hold and other neutral signals
+	switch analysis.FinalSignal.Direction() {
+	case 1:
+		color = 0x2ecc71
+	case -1:
+		color = 0xe74c3c
+	}
+
+	embed := discordEmbed{
+		Title:       fmt.Sprintf("🎯 %s: %s", symbol, analysis.FinalSignal.Label()),
+		Description: strings.Join(analysis.Recommendation(), "\n"),
+		Color:       color,
+		Fields: []discordField{
+			{Name: "Technical", Value: fmt.Sprintf("%s (%s)", analysis.Technical.FinalSignal.Label(), analysis.Technical.Confidence), Inline: true},
+			{Name: "Volume", Value: fmt.Sprintf("%s (ratio %.2f)", analysis.Volume.Signal, analysis.Volume.VolumeRatio), Inline: true},
+			{Name: "Volume confirms", Value: fmt.Sprint(analysis.VolumeConfirm), Inline: true},
+			{Name: "Confidence", Value: fmt.Sprintf("%s (%.0f%%)", analysis.Confidence, analysis.ConfidenceScore*100), Inline: true},
+			{Name: "Risk", Value: fmt.Sprintf("%s (%.0f%%)", analysis.RiskLevel, analysis.RiskScore*100), Inline: true},
+			{Name: "Rug pull risk", Value: analysis.RugPullRisk, Inline: true},
+		},
+	}
+
+	payload := map[string]any{"embeds": []discordEmbed{embed}}
+	if d.Username != "" {
+		payload["username"] = d.Username
+	}
+	return postJSON(ctx, d.HTTPClient, "discord", d.WebhookURL, payload)
+}
+
+// SignalChangeNotifier forwards an analysis only when the symbol's final signal differs from the last
+// one forwarded, so a periodic analysis loop alerts on transitions instead of on every run
+type SignalChangeNotifier struct {
+	Notifier Notifier
+	SendHold bool // Also alert on changes to HOLD (skipped by default)
+
+	mu   sync.Mutex
+	last map[string]Signal
+}
+
+// Notify forwards the analysis if its final signal changed; a failed delivery is retried on the next call
+func (n *SignalChangeNotifier) Notify(ctx context.Context, symbol string, analysis UltimateMemecoinAnalysis) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.last == nil {
+		n.last = make(map[string]Signal)
+	}
+	previous, seen := n.last[symbol]
+	if seen && previous == analysis.FinalSignal {
+		return nil
+	}
+	if analysis.FinalSignal == SignalHold && !n.SendHold {
+		n.last[symbol] = analysis.FinalSignal
+		return nil
+	}
+
+	if err := n.Notifier.Notify(ctx, symbol, analysis); err != nil {
+		return err
+	}
+	n.last[symbol] = analysis.FinalSignal
+	return nil
+}
+
+// MultiNotifier sends every alert to each notifier in turn, continuing past failures
+type MultiNotifier []Notifier
+
+// Notify delivers to all notifiers and returns their joined errors
+func (m MultiNotifier) Notify(ctx context.Context, symbol string, analysis UltimateMemecoinAnalysis) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, symbol, analysis); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
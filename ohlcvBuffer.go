@@ -0,0 +1,97 @@
+package techindicators
+
+// OHLCVBuffer is a fixed-capacity ring buffer of candles with O(1) append and eviction of the oldest
+// candle, for long-running bots that must not grow memory. Size it with AnalysisConfig.UltimateMinCandles
+// (or MinCandles of the indicators in use) to keep enough history for the largest period.
+// It is not safe for concurrent use.
+type OHLCVBuffer struct {
+	data  []OHLCV
+	start int // Index of the oldest candle
+	size  int
+}
+
+// NewOHLCVBuffer creates an empty buffer holding at most capacity candles
+func NewOHLCVBuffer(capacity int) (*OHLCVBuffer, error) {
+	if capacity <= 0 {
+		return nil, invalidParameter("capacity must be greater than 0")
+	}
+	return &OHLCVBuffer{data: make([]OHLCV, capacity)}, nil
+}
+
+// Append adds a candle, evicting and returning the oldest one when the buffer is full
+func (b *OHLCVBuffer) Append(candle OHLCV) (evicted OHLCV, ok bool) {
+	if b.size < len(b.data) {
+		b.data[(b.start+b.size)%len(b.data)] = candle
+		b.size++
+		return OHLCV{}, false
+	}
+
+	evicted = b.data[b.start]
+	b.data[b.start] = candle
+	b.start = (b.start + 1) % len(b.data)
+	return evicted, true
+}
+
+// ReplaceLast overwrites the newest candle, e.g. with an update of the in-progress candle
+func (b *OHLCVBuffer) ReplaceLast(candle OHLCV) bool {
+	if b.size == 0 {
+		return false
+	}
+	b.data[(b.start+b.size-1)%len(b.data)] = candle
+	return true
+}
+
+// Len returns the number of candles held
+func (b *OHLCVBuffer) Len() int {
+	return b.size
+}
+
+// Cap returns the maximum number of candles held
+func (b *OHLCVBuffer) Cap() int {
+	return len(b.data)
+}
+
+// At returns the i-th candle, oldest first; it panics if i is out of range
+func (b *OHLCVBuffer) At(i int) OHLCV {
+	if i < 0 || i >= b.size {
+		panic("techindicators: OHLCVBuffer index out of range")
+	}
+	return b.data[(b.start+i)%len(b.data)]
+}
+
+// Last returns the newest candle
+func (b *OHLCVBuffer) Last() (OHLCV, bool) {
+	if b.size == 0 {
+		return OHLCV{}, false
+	}
+	return b.At(b.size - 1), true
+}
+
+// AppendTo appends the candles, oldest first, to dst and returns the extended slice.
+// Reusing dst (e.g. dst[:0]) avoids allocating on every call.
+func (b *OHLCVBuffer) AppendTo(dst []OHLCV) []OHLCV {
+	end := b.start + b.size
+	if end <= len(b.data) {
+		return append(dst, b.data[b.start:end]...)
+	}
+	dst = append(dst, b.data[b.start:]...)
+	return append(dst, b.data[:end-len(b.data)]...)
+}
+
+// Candles returns a copy of the candles, oldest first, usable with every batch function
+func (b *OHLCVBuffer) Candles() []OHLCV {
+	return b.AppendTo(make([]OHLCV, 0, b.size))
+}
+
+// Reset empties the buffer
+func (b *OHLCVBuffer) Reset() {
+	b.start, b.size = 0, 0
+}
+
+// WarmUp feeds every buffered candle to a streaming indicator, oldest first, and returns its latest value
+func (b *OHLCVBuffer) WarmUp(indicator StreamingIndicator) (float64, bool) {
+	for i := 0; i < b.size; i++ {
+		indicator.Update(b.At(i))
+	}
+	return indicator.Value()
+}
@@ -0,0 +1,196 @@
+package techindicators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// PaperTradingConfig controls a paper-trading simulation
+type PaperTradingConfig struct {
+	InitialCash  float64 `json:"initial_cash"`
+	FeeRate      float64 `json:"fee_rate"`      // Fraction of each fill charged as fee (0.001 = 0.1%)
+	PositionSize float64 `json:"position_size"` // Fraction of cash spent on each entry (defaults to 1)
+	MaxHistory   int     `json:"max_history"`   // Candles kept for the strategy (defaults to 500)
+}
+
+// Execution records one simulated fill
+type Execution struct {
+	Timestamp time.Time `json:"timestamp"`
+	Side      string    `json:"side"` // buy or sell
+	Signal    Signal    `json:"signal"`
+	Price     float64   `json:"price"`
+	Units     float64   `json:"units"`
+	Fee       float64   `json:"fee"`
+	Cash      float64   `json:"cash"` // Cash after the fill
+	PnL       float64   `json:"pnl"`  // Realized profit of a sell, net of both fees
+}
+
+// PaperPortfolio is a snapshot of the virtual portfolio
+type PaperPortfolio struct {
+	Cash          float64 `json:"cash"`
+	Units         float64 `json:"units"`       // Size of the open long position
+	EntryPrice    float64 `json:"entry_price"` // 0 when flat
+	LastPrice     float64 `json:"last_price"`
+	Equity        float64 `json:"equity"` // Cash plus the position marked at the last price
+	RealizedPnL   float64 `json:"realized_pnl"`
+	UnrealizedPnL float64 `json:"unrealized_pnl"`
+}
+
+// PaperTrader applies a strategy to live candles and keeps a virtual long-only portfolio.
+// It uses the same rules as Backtest: bullish signals buy at the candle's close and bearish signals sell.
+// It is safe for concurrent use.
+type PaperTrader struct {
+	strategy Strategy
+	config   PaperTradingConfig
+
+	mu         sync.Mutex
+	history    *OHLCVBuffer
+	scratch    []OHLCV // Reused view of history handed to the strategy
+	cash       float64
+	units      float64
+	entryCost  float64 // Cash spent on the open position, including the entry fee
+	entryPrice float64
+	realized   float64
+	executions []Execution
+}
+
+// NewPaperTrader creates a paper trader starting fully in cash
+func NewPaperTrader(strategy Strategy, config PaperTradingConfig) (*PaperTrader, error) {
+	if strategy == nil {
+		return nil, invalidParameter("strategy is required")
+	}
+	if config.InitialCash <= 0 {
+		return nil, invalidParameter("initial cash must be greater than 0")
+	}
+	if config.FeeRate < 0 || config.FeeRate >= 1 {
+		return nil, invalidParameter("fee rate must be between 0 and 1")
+	}
+	if config.PositionSize == 0 {
+		config.PositionSize = 1
+	}
+	if config.PositionSize < 0 || config.PositionSize > 1 {
+		return nil, invalidParameter("position size must be between 0 and 1")
+	}
+	if config.MaxHistory == 0 {
+		config.MaxHistory = 500
+	}
+	if config.MaxHistory < 0 {
+		return nil, invalidParameter("max history must be greater than 0")
+	}
+
+	history, err := NewOHLCVBuffer(config.MaxHistory)
+	if err != nil {
+		return nil, err
+	}
+	return &PaperTrader{strategy: strategy, config: config, history: history, cash: config.InitialCash}, nil
+}
+
+// OnCandle feeds one closed candle and returns the resulting fill, if any. A candle with the same
+// timestamp as the previous one replaces it; older candles are rejected with ErrInvalidDataset.
+func (t *PaperTrader) OnCandle(candle OHLCV) (*Execution, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if last, ok := t.history.Last(); ok {
+		switch {
+		case candle.Timestamp.Equal(last.Timestamp):
+			t.history.ReplaceLast(candle)
+		case candle.Timestamp.Before(last.Timestamp):
+			return nil, fmt.Errorf("%w: candle at %s is older than the last candle at %s", ErrInvalidDataset, candle.Timestamp, last.Timestamp)
+		default:
+			t.history.Append(candle)
+		}
+	} else {
+		t.history.Append(candle)
+	}
+
+	t.scratch = t.history.AppendTo(t.scratch[:0])
+	signal, err := t.strategy(t.scratch)
+	if err != nil {
+		if errors.Is(err, ErrInsufficientData{}) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var execution *Execution
+	switch {
+	case t.units == 0 && signal.IsBullish():
+		spend := t.cash * t.config.PositionSize
+		fee := spend * t.config.FeeRate
+		t.units = (spend - fee) / candle.Close
+		t.cash -= spend
+		t.entryCost = spend
+		t.entryPrice = candle.Close
+		execution = &Execution{Side: "buy", Units: t.units, Fee: fee}
+	case t.units > 0 && signal.IsBearish():
+		proceeds := t.units * candle.Close
+		fee := proceeds * t.config.FeeRate
+		pnl := proceeds - fee - t.entryCost
+		t.cash += proceeds - fee
+		t.realized += pnl
+		execution = &Execution{Side: "sell", Units: t.units, Fee: fee, PnL: pnl}
+		t.units, t.entryCost, t.entryPrice = 0, 0, 0
+	}
+
+	if execution == nil {
+		return nil, nil
+	}
+	execution.Timestamp = candle.Timestamp
+	execution.Signal = signal
+	execution.Price = candle.Close
+	execution.Cash = t.cash
+	t.executions = append(t.executions, *execution)
+	return execution, nil
+}
+
+// Run consumes candles until the channel is closed or the context is done
+func (t *PaperTrader) Run(ctx context.Context, candles <-chan OHLCV) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case candle, ok := <-candles:
+			if !ok {
+				return nil
+			}
+			if _, err := t.OnCandle(candle); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Portfolio returns the current portfolio marked at the last candle's close
+func (t *PaperTrader) Portfolio() PaperPortfolio {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	portfolio := PaperPortfolio{
+		Cash:        t.cash,
+		Units:       t.units,
+		EntryPrice:  t.entryPrice,
+		Equity:      t.cash,
+		RealizedPnL: t.realized,
+	}
+	if last, ok := t.history.Last(); ok {
+		portfolio.LastPrice = last.Close
+		value := t.units * portfolio.LastPrice
+		portfolio.Equity += value
+		if t.units > 0 {
+			portfolio.UnrealizedPnL = value - t.entryCost
+		}
+	}
+	return portfolio
+}
+
+// Executions returns a copy of the execution log
+func (t *PaperTrader) Executions() []Execution {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]Execution(nil), t.executions...)
+}
@@ -0,0 +1,687 @@
+package techindicators
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+)
+
+// Parquet support is implemented on the standard library. The writer produces a single row group
+// of required, PLAIN-encoded, uncompressed columns (timestamp as INT64 milliseconds, prices and volume
+// as DOUBLE). The reader handles what pandas/pyarrow write by default: PLAIN and dictionary encodings,
+// uncompressed, Snappy, or GZIP pages, data page v1 and v2, and required or optional flat columns.
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types, encodings, and codecs used here
+const (
+	parquetInt32  = 1
+	parquetInt64  = 2
+	parquetFloat  = 4
+	parquetDouble = 5
+
+	parquetPlain           = 0
+	parquetPlainDictionary = 2
+	parquetRLE             = 3
+	parquetRLEDictionary   = 8
+
+	parquetUncompressed = 0
+	parquetSnappy       = 1
+	parquetGzip         = 2
+
+	parquetDataPage       = 0
+	parquetDictionaryPage = 2
+	parquetDataPageV2     = 3
+
+	parquetRequired        = 0
+	parquetOptional        = 1
+	parquetTimestampMillis = 9
+	parquetTimestampMicros = 10
+)
+
+// WriteParquet writes candles as a Parquet file readable by pandas, pyarrow, and Spark.
+// The columns are named by the mapping (DefaultCSVColumns when empty).
+func WriteParquet(w io.Writer, dataset []OHLCV, columns CSVColumns) error {
+	if len(dataset) == 0 {
+		return ErrEmptyDataset
+	}
+	names := CSVOptions{Columns: columns}.names()
+
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	type chunk struct {
+		offset, size int64
+		physical     int32
+	}
+	chunks := make([]chunk, len(names))
+
+	values := make([]byte, 8*len(dataset))
+	for c := range names {
+		physical := int32(parquetDouble)
+		for i, candle := range dataset {
+			var bits uint64
+			switch c {
+			case 0:
+				physical = parquetInt64
+				bits = uint64(candle.Timestamp.UnixMilli())
+			case 1:
+				bits = math.Float64bits(candle.Open)
+			case 2:
+				bits = math.Float64bits(candle.High)
+			case 3:
+				bits = math.Float64bits(candle.Low)
+			case 4:
+				bits = math.Float64bits(candle.Close)
+			case 5:
+				bits = math.Float64bits(candle.Volume)
+			}
+			binary.LittleEndian.PutUint64(values[8*i:], bits)
+		}
+
+		var header thriftWriter
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(values)))
+		header.i32(3, int32(len(values)))
+		header.structBegin(5)
+		header.i32(1, int32(len(dataset)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.stop()
+
+		chunks[c] = chunk{offset: int64(file.Len()), size: int64(header.buf.Len() + len(values)), physical: physical}
+		file.Write(header.buf.Bytes())
+		file.Write(values)
+	}
+
+	// File metadata
+	var meta thriftWriter
+	meta.i32(1, 1)
+	meta.listBegin(2, thriftStruct, len(names)+1)
+	meta.elemStructBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(names)))
+	meta.elemStructEnd()
+	for c, name := range names {
+		meta.elemStructBegin()
+		meta.i32(1, chunks[c].physical)
+		meta.i32(3, parquetRequired)
+		meta.binary(4, name)
+		if c == 0 {
+			meta.i32(6, parquetTimestampMillis)
+		}
+		meta.elemStructEnd()
+	}
+	meta.i64(3, int64(len(dataset)))
+
+	var totalSize int64
+	for _, c := range chunks {
+		totalSize += c.size
+	}
+	meta.listBegin(4, thriftStruct, 1)
+	meta.elemStructBegin()
+	meta.listBegin(1, thriftStruct, len(names))
+	for c, name := range names {
+		meta.elemStructBegin()
+		meta.i64(2, chunks[c].offset)
+		meta.structBegin(3)
+		meta.i32(1, chunks[c].physical)
+		meta.listBegin(2, thriftI32, 2)
+		meta.varint(parquetPlain)
+		meta.varint(parquetRLE)
+		meta.listBegin(3, thriftBinary, 1)
+		meta.rawBinary(name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, int64(len(dataset)))
+		meta.i64(6, chunks[c].size)
+		meta.i64(7, chunks[c].size)
+		meta.i64(9, chunks[c].offset)
+		meta.structEnd()
+		meta.elemStructEnd()
+	}
+	meta.i64(2, totalSize)
+	meta.i64(3, int64(len(dataset)))
+	meta.elemStructEnd()
+	meta.binary(6, "techindicators")
+	meta.stop()
+
+	file.Write(meta.buf.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(meta.buf.Len()))
+	file.WriteString(parquetMagic)
+
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// WriteParquetFile writes candles to a Parquet file
+func WriteParquetFile(path string, dataset []OHLCV, columns CSVColumns) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteParquet(file, dataset, columns); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// LoadOHLCVFromParquet reads candles from Parquet. Columns are matched case-insensitively by the mapping
+// (DefaultCSVColumns when empty); other columns such as a pandas index are ignored and a missing volume
+// column reads as 0. Timestamps may be annotated INT64 millis/micros/nanos or plain Unix seconds.
+func LoadOHLCVFromParquet(r io.Reader, columns CSVColumns) ([]OHLCV, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 12 || string(data[:4]) != parquetMagic || string(data[len(data)-4:]) != parquetMagic {
+		return nil, invalidParameter("not a Parquet file")
+	}
+
+	metaLength := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if metaLength <= 0 || metaLength > len(data)-12 {
+		return nil, invalidParameter("corrupt Parquet footer")
+	}
+	meta, err := newThriftReader(data[len(data)-8-metaLength : len(data)-8]).readStruct()
+	if err != nil {
+		return nil, fmt.Errorf("%w: Parquet footer: %w", ErrInvalidDataset, err)
+	}
+
+	// Locate the mapped columns among the top-level schema elements
+	schema := thriftList(meta[2])
+	names := CSVOptions{Columns: columns}.names()
+	type columnInfo struct {
+		index     int // Position among leaf columns
+		element   map[int16]any
+		optional  bool
+		tsDivisor float64 // Nanoseconds per stored unit for annotated timestamps, 0 otherwise
+	}
+	found := make([]*columnInfo, len(names))
+	for leaf, element := range schema[min(1, len(schema)):] {
+		fields, _ := element.(map[int16]any)
+		name := string(thriftBytes(fields[4]))
+		for c, want := range names {
+			if found[c] == nil && strings.EqualFold(name, want) {
+				info := &columnInfo{index: leaf, element: fields, optional: thriftInt(fields[3]) == parquetOptional}
+				if c == 0 {
+					info.tsDivisor = parquetTimestampUnit(fields)
+				}
+				found[c] = info
+			}
+		}
+	}
+	for c, info := range found {
+		if info == nil && c != 5 {
+			return nil, invalidParameter("Parquet file has no %q column", names[c])
+		}
+	}
+
+	var dataset []OHLCV
+	for _, group := range thriftList(meta[4]) {
+		groupFields, _ := group.(map[int16]any)
+		// Every row takes at least a byte of the file, so larger counts are corrupt and not allocated
+		rows := thriftInt(groupFields[3])
+		if rows < 0 || rows > int64(len(data)-len(dataset)) {
+			return nil, fmt.Errorf("%w: Parquet row group has an invalid row count %d", ErrInvalidDataset, rows)
+		}
+		chunks := thriftList(groupFields[1])
+		start := len(dataset)
+		dataset = append(dataset, make([]OHLCV, int(rows))...)
+
+		for c, info := range found {
+			if info == nil {
+				continue
+			}
+			if info.index >= len(chunks) {
+				return nil, invalidParameter("Parquet row group is missing column %q", names[c])
+			}
+			chunkFields, _ := chunks[info.index].(map[int16]any)
+			columnMeta, _ := chunkFields[3].(map[int16]any)
+
+			values, err := readParquetColumn(data, columnMeta, info.optional, int(rows))
+			if err != nil {
+				return nil, fmt.Errorf("%w: column %q: %w", ErrInvalidDataset, names[c], err)
+			}
+
+			for i, value := range values {
+				candle := &dataset[start+i]
+				switch c {
+				case 0:
+					candle.Timestamp = parquetTimestamp(value, info.tsDivisor)
+				case 1:
+					candle.Open = value.float
+				case 2:
+					candle.High = value.float
+				case 3:
+					candle.Low = value.float
+				case 4:
+					candle.Close = value.float
+				case 5:
+					candle.Volume = value.float
+				}
+			}
+		}
+	}
+
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	return dataset, nil
+}
+
+// LoadOHLCVFromParquetFile reads candles from a Parquet file
+func LoadOHLCVFromParquetFile(path string, columns CSVColumns) ([]OHLCV, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return LoadOHLCVFromParquet(file, columns)
+}
+
+// parquetValue holds a decoded number both as stored integer and as float
+type parquetValue struct {
+	integer int64
+	float   float64
+}
+
+// parquetTimestampUnit returns nanoseconds per unit for an annotated timestamp column, or 0
+func parquetTimestampUnit(element map[int16]any) float64 {
+	if logical, ok := element[10].(map[int16]any); ok {
+		if timestamp, ok := logical[8].(map[int16]any); ok {
+			if unit, ok := timestamp[2].(map[int16]any); ok {
+				switch {
+				case unit[1] != nil:
+					return 1e6
+				case unit[2] != nil:
+					return 1e3
+				case unit[3] != nil:
+					return 1
+				}
+			}
+		}
+	}
+	switch thriftInt(element[6]) {
+	case parquetTimestampMillis:
+		return 1e6
+	case parquetTimestampMicros:
+		return 1e3
+	}
+	return 0
+}
+
+// parquetTimestamp converts a stored timestamp; unannotated numbers are Unix seconds
+func parquetTimestamp(value parquetValue, nanosPerUnit float64) time.Time {
+	if nanosPerUnit > 0 {
+		return time.Unix(0, value.integer*int64(nanosPerUnit)).UTC()
+	}
+	return time.Unix(0, int64(value.float*1e9)).UTC()
+}
+
+// readParquetColumn decodes every value of one column chunk
+func readParquetColumn(data []byte, meta map[int16]any, optional bool, rows int) ([]parquetValue, error) {
+	physical := thriftInt(meta[1])
+	codec := thriftInt(meta[4])
+	total := int(thriftInt(meta[5]))
+
+	offset := thriftInt(meta[9])
+	if dictionaryOffset, ok := meta[11]; ok && thriftInt(dictionaryOffset) > 0 && thriftInt(dictionaryOffset) < offset {
+		offset = thriftInt(dictionaryOffset)
+	}
+
+	var dictionary []parquetValue
+	values := make([]parquetValue, 0, rows)
+	seen := 0
+
+	for seen < total {
+		if offset < 0 || offset >= int64(len(data)) {
+			return nil, fmt.Errorf("page offset %d out of range", offset)
+		}
+		reader := newThriftReader(data[offset:])
+		header, err := reader.readStruct()
+		if err != nil {
+			return nil, err
+		}
+		compressedSize := int(thriftInt(header[3]))
+		bodyStart := offset + int64(reader.pos)
+		if compressedSize < 0 || bodyStart+int64(compressedSize) > int64(len(data)) {
+			return nil, fmt.Errorf("page exceeds file")
+		}
+		body := data[bodyStart : bodyStart+int64(compressedSize)]
+		offset = bodyStart + int64(compressedSize)
+		uncompressedSize := int(thriftInt(header[2]))
+
+		switch thriftInt(header[1]) {
+		case parquetDictionaryPage:
+			page, err := parquetDecompress(codec, body, uncompressedSize)
+			if err != nil {
+				return nil, err
+			}
+			dictHeader, _ := header[7].(map[int16]any)
+			dictionary, err = parquetPlainValues(page, physical, thriftInt(dictHeader[1]))
+			if err != nil {
+				return nil, err
+			}
+
+		case parquetDataPage:
+			pageHeader, _ := header[5].(map[int16]any)
+			count, err := parquetPageCount(thriftInt(pageHeader[1]), rows-seen)
+			if err != nil {
+				return nil, err
+			}
+			page, err := parquetDecompress(codec, body, uncompressedSize)
+			if err != nil {
+				return nil, err
+			}
+
+			present := count
+			if optional {
+				if len(page) < 4 {
+					return nil, fmt.Errorf("truncated definition levels")
+				}
+				length := int(binary.LittleEndian.Uint32(page))
+				if 4+length > len(page) {
+					return nil, fmt.Errorf("truncated definition levels")
+				}
+				if present, err = parquetCountPresent(page[4:4+length], count); err != nil {
+					return nil, err
+				}
+				page = page[4+length:]
+			}
+
+			decoded, err := parquetDecodeValues(page, thriftInt(pageHeader[2]), physical, present, dictionary)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, decoded...)
+			seen += count
+
+		case parquetDataPageV2:
+			pageHeader, _ := header[8].(map[int16]any)
+			count, err := parquetPageCount(thriftInt(pageHeader[1]), rows-seen)
+			if err != nil {
+				return nil, err
+			}
+			nulls := thriftInt(pageHeader[2])
+			if nulls < 0 || nulls > int64(count) {
+				return nil, fmt.Errorf("invalid null count %d", nulls)
+			}
+			repetitionLength, definitionLength := thriftInt(pageHeader[6]), thriftInt(pageHeader[5])
+			if repetitionLength < 0 || definitionLength < 0 || repetitionLength+definitionLength > int64(len(body)) {
+				return nil, fmt.Errorf("truncated levels")
+			}
+			levelsLength := int(repetitionLength + definitionLength)
+
+			page := body[levelsLength:]
+			if compressed, ok := pageHeader[7].(bool); !ok || compressed {
+				if page, err = parquetDecompress(codec, page, uncompressedSize-levelsLength); err != nil {
+					return nil, err
+				}
+			}
+
+			decoded, err := parquetDecodeValues(page, thriftInt(pageHeader[4]), physical, count-int(nulls), dictionary)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, decoded...)
+			seen += count
+
+		default:
+			// Index pages and unknown pages carry no values
+		}
+	}
+
+	if len(values) != rows {
+		return nil, missingValue("%d of %d values present; null candles are not supported", len(values), rows)
+	}
+	return values, nil
+}
+
+// parquetPageCount checks the value count of a data page against the rows still to be read
+func parquetPageCount(count int64, remaining int) (int, error) {
+	if count < 0 || count > int64(remaining) {
+		return 0, fmt.Errorf("invalid page value count %d with %d rows remaining", count, remaining)
+	}
+	return int(count), nil
+}
+
+// parquetDecompress decompresses a page body
+func parquetDecompress(codec int64, body []byte, size int) ([]byte, error) {
+	switch codec {
+	case parquetUncompressed:
+		return body, nil
+	case parquetSnappy:
+		return snappyDecode(body)
+	case parquetGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		// Deflate expands at most 1032 to 1, so a larger size hint is corrupt and only preallocates less
+		out := bytes.NewBuffer(make([]byte, 0, min(max(size, 0), 1032*len(body))))
+		_, err = io.Copy(out, reader)
+		return out.Bytes(), err
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %d", codec)
+	}
+}
+
+// parquetCountPresent counts the non-null values from RLE-encoded definition levels of a flat column
+func parquetCountPresent(levels []byte, count int) (int, error) {
+	decoded, err := rleHybridDecode(levels, 1, count)
+	if err != nil {
+		return 0, err
+	}
+	present := 0
+	for _, level := range decoded {
+		if level == 1 {
+			present++
+		}
+	}
+	return present, nil
+}
+
+// parquetDecodeValues decodes count values of a data page
+func parquetDecodeValues(page []byte, encoding, physical int64, count int, dictionary []parquetValue) ([]parquetValue, error) {
+	switch encoding {
+	case parquetPlain:
+		return parquetPlainValues(page, physical, int64(count))
+	case parquetPlainDictionary, parquetRLEDictionary:
+		if len(page) == 0 {
+			if count == 0 {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("empty dictionary page data")
+		}
+		indices, err := rleHybridDecode(page[1:], int(page[0]), count)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]parquetValue, count)
+		for i, index := range indices {
+			if int(index) >= len(dictionary) {
+				return nil, fmt.Errorf("dictionary index %d out of range", index)
+			}
+			values[i] = dictionary[index]
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding %d", encoding)
+	}
+}
+
+// parquetPlainValues decodes PLAIN-encoded numbers
+func parquetPlainValues(page []byte, physical int64, count int64) ([]parquetValue, error) {
+	width := map[int64]int{parquetInt32: 4, parquetInt64: 8, parquetFloat: 4, parquetDouble: 8}[physical]
+	if width == 0 {
+		return nil, fmt.Errorf("unsupported physical type %d", physical)
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("invalid value count %d", count)
+	}
+	if count > int64(len(page)/width) {
+		return nil, fmt.Errorf("truncated page: %d values need more than %d bytes", count, len(page))
+	}
+
+	values := make([]parquetValue, count)
+	for i := range values {
+		raw := page[i*width:]
+		switch physical {
+		case parquetInt32:
+			v := int64(int32(binary.LittleEndian.Uint32(raw)))
+			values[i] = parquetValue{integer: v, float: float64(v)}
+		case parquetInt64:
+			v := int64(binary.LittleEndian.Uint64(raw))
+			values[i] = parquetValue{integer: v, float: float64(v)}
+		case parquetFloat:
+			v := float64(math.Float32frombits(binary.LittleEndian.Uint32(raw)))
+			values[i] = parquetValue{integer: int64(v), float: v}
+		case parquetDouble:
+			v := math.Float64frombits(binary.LittleEndian.Uint64(raw))
+			values[i] = parquetValue{integer: int64(v), float: v}
+		}
+	}
+	return values, nil
+}
+
+// rleHybridDecode decodes count values of the Parquet RLE/bit-packing hybrid encoding
+func rleHybridDecode(data []byte, bitWidth, count int) ([]uint32, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, fmt.Errorf("invalid bit width %d", bitWidth)
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("invalid value count %d", count)
+	}
+	values := make([]uint32, 0, count)
+	pos := 0
+	byteWidth := (bitWidth + 7) / 8
+
+	for len(values) < count {
+		header, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, fmt.Errorf("truncated RLE run")
+		}
+		pos += n
+
+		if header&1 == 0 {
+			// RLE run: one value repeated
+			run := int(header >> 1)
+			if pos+byteWidth > len(data) {
+				return nil, fmt.Errorf("truncated RLE value")
+			}
+			var value uint32
+			for b := 0; b < byteWidth; b++ {
+				value |= uint32(data[pos+b]) << (8 * b)
+			}
+			pos += byteWidth
+			for k := 0; k < run && len(values) < count; k++ {
+				values = append(values, value)
+			}
+			continue
+		}
+
+		// Bit-packed run: groups of 8 values, least significant bit first. Each group takes bitWidth
+		// bytes, so the group count is bounded by the remaining bytes before multiplying.
+		groups := header >> 1
+		if bitWidth > 0 && groups > uint64((len(data)-pos)/bitWidth) {
+			return nil, fmt.Errorf("truncated bit-packed run")
+		}
+		groups = min(groups, uint64(count)) // Zero-width runs take no bytes; only count values are read
+		end := pos + int(groups)*bitWidth
+		for k := 0; k < int(groups)*8 && len(values) < count; k++ {
+			var value uint32
+			for b := 0; b < bitWidth; b++ {
+				bit := k*bitWidth + b
+				if data[pos+bit/8]&(1<<(bit%8)) != 0 {
+					value |= 1 << b
+				}
+			}
+			values = append(values, value)
+		}
+		pos = end
+	}
+	return values, nil
+}
+
+// snappyDecode decodes a raw Snappy block as used by Parquet pages
+func snappyDecode(src []byte) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	// A 3-byte copy tag yields at most 64 bytes, so longer outputs cannot come from this block
+	if n <= 0 || length > 1<<31 || length > 32*uint64(len(src)) {
+		return nil, fmt.Errorf("invalid snappy header")
+	}
+	dst := make([]byte, 0, length)
+	pos := n
+
+	for pos < len(src) {
+		tag := src[pos]
+		pos++
+
+		var literal, copyLength, copyOffset int
+		switch tag & 3 {
+		case 0:
+			literal = int(tag>>2) + 1
+			if extra := literal - 60; extra > 0 {
+				if pos+extra > len(src) {
+					return nil, fmt.Errorf("truncated snappy literal")
+				}
+				literal = 0
+				for b := 0; b < extra; b++ {
+					literal |= int(src[pos+b]) << (8 * b)
+				}
+				literal++
+				pos += extra
+			}
+			if pos+literal > len(src) {
+				return nil, fmt.Errorf("truncated snappy literal")
+			}
+			dst = append(dst, src[pos:pos+literal]...)
+			pos += literal
+			continue
+		case 1:
+			if pos >= len(src) {
+				return nil, fmt.Errorf("truncated snappy copy")
+			}
+			copyLength = int(tag>>2&7) + 4
+			copyOffset = int(tag>>5)<<8 | int(src[pos])
+			pos++
+		case 2:
+			if pos+2 > len(src) {
+				return nil, fmt.Errorf("truncated snappy copy")
+			}
+			copyLength = int(tag>>2) + 1
+			copyOffset = int(binary.LittleEndian.Uint16(src[pos:]))
+			pos += 2
+		case 3:
+			if pos+4 > len(src) {
+				return nil, fmt.Errorf("truncated snappy copy")
+			}
+			copyLength = int(tag>>2) + 1
+			copyOffset = int(binary.LittleEndian.Uint32(src[pos:]))
+			pos += 4
+		}
+
+		if copyOffset <= 0 || copyOffset > len(dst) {
+			return nil, fmt.Errorf("invalid snappy copy offset")
+		}
+		start := len(dst) - copyOffset
+		for k := 0; k < copyLength; k++ { // Byte by byte: copies may overlap their output
+			dst = append(dst, dst[start+k])
+		}
+	}
+
+	if len(dst) != int(length) {
+		return nil, fmt.Errorf("snappy length mismatch")
+	}
+	return dst, nil
+}
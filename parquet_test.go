@@ -0,0 +1,87 @@
+package techindicators
+
+import (
+	"bytes"
+	"testing"
+)
+
+// parquetSample writes a small dataset as a Parquet file
+func parquetSample(tb testing.TB) []byte {
+	tb.Helper()
+	var file bytes.Buffer
+	if err := WriteParquet(&file, syntheticCandles(tb, 20), CSVColumns{}); err != nil {
+		tb.Fatal(err)
+	}
+	return file.Bytes()
+}
+
+func TestLoadOHLCVFromParquetRoundTrip(t *testing.T) {
+	dataset := syntheticCandles(t, 20)
+	var file bytes.Buffer
+	if err := WriteParquet(&file, dataset, CSVColumns{}); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadOHLCVFromParquet(&file, CSVColumns{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded) != len(dataset) {
+		t.Fatalf("loaded %d candles; want %d", len(loaded), len(dataset))
+	}
+	for i := range dataset {
+		if !loaded[i].Timestamp.Equal(dataset[i].Timestamp) || loaded[i].Close != dataset[i].Close {
+			t.Fatalf("candle %d = %+v; want %+v", i, loaded[i], dataset[i])
+		}
+	}
+}
+
+func TestLoadOHLCVFromParquetTruncated(t *testing.T) {
+	file := parquetSample(t)
+	for n := range len(file) {
+		if _, err := LoadOHLCVFromParquet(bytes.NewReader(file[:n]), CSVColumns{}); err == nil {
+			t.Fatalf("truncated to %d of %d bytes: expected an error", n, len(file))
+		}
+	}
+}
+
+// Overwriting each byte with values that decode as large or negative varints must not panic
+func TestLoadOHLCVFromParquetCorrupted(t *testing.T) {
+	file := parquetSample(t)
+	corrupted := make([]byte, len(file))
+	for i := range file {
+		for _, b := range []byte{0x00, 0x7f, 0x80, 0xfe, 0xff} {
+			copy(corrupted, file)
+			corrupted[i] = b
+			LoadOHLCVFromParquet(bytes.NewReader(corrupted), CSVColumns{})
+		}
+	}
+}
+
+func TestRLEHybridDecodeOverflow(t *testing.T) {
+	// A bit-packed header whose group count times the bit width overflows int
+	data := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00}
+	if _, err := rleHybridDecode(data, 8, 4); err == nil {
+		t.Fatal("expected an error for a bit-packed run longer than the data")
+	}
+	if _, err := rleHybridDecode([]byte{0x03}, 1, -1); err == nil {
+		t.Fatal("expected an error for a negative count")
+	}
+}
+
+func TestParquetPlainValuesInvalidCount(t *testing.T) {
+	page := make([]byte, 16)
+	for _, count := range []int64{-1, 3, 1 << 62} {
+		if _, err := parquetPlainValues(page, parquetDouble, count); err == nil {
+			t.Fatalf("count %d: expected an error", count)
+		}
+	}
+}
+
+func FuzzLoadOHLCVFromParquet(f *testing.F) {
+	file := parquetSample(f)
+	f.Add(file)
+	f.Add(file[:len(file)/2])
+	f.Fuzz(func(t *testing.T, data []byte) {
+		LoadOHLCVFromParquet(bytes.NewReader(data), CSVColumns{})
+	})
+}
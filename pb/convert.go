@@ -0,0 +1,552 @@
+// Package pb holds the protobuf storage and interchange schema for candles, indicator series, and analysis
+// results (techindicators_data.proto), with converters to and from the techindicators types.
+package pb
+
+import (
+	"maps"
+	"time"
+
+	ti "github.com/luislaredovelazquez/techindicators"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromOHLCV converts a candle
+func FromOHLCV(candle ti.OHLCV) *Candle {
+	return &Candle{
+		Timestamp: timestamppb.New(candle.Timestamp),
+		Open:      candle.Open,
+		High:      candle.High,
+		Low:       candle.Low,
+		Close:     candle.Close,
+		Volume:    candle.Volume,
+		Trades:    int64(candle.Trades),
+		Bid:       candle.Bid,
+		Ask:       candle.Ask,
+	}
+}
+
+// ToOHLCV converts a candle back; a missing timestamp becomes the zero time
+func ToOHLCV(candle *Candle) ti.OHLCV {
+	return ti.OHLCV{
+		Timestamp: toTime(candle.GetTimestamp()),
+		Open:      candle.GetOpen(),
+		High:      candle.GetHigh(),
+		Low:       candle.GetLow(),
+		Close:     candle.GetClose(),
+		Volume:    candle.GetVolume(),
+		Trades:    int(candle.GetTrades()),
+		Bid:       candle.GetBid(),
+		Ask:       candle.GetAsk(),
+	}
+}
+
+// FromDataset converts candles to the column-oriented CandleSeries. Timestamps keep millisecond precision;
+// the trade count and quote columns are only filled when some candle carries them.
+func FromDataset(symbol string, interval time.Duration, dataset []ti.OHLCV) *CandleSeries {
+	series := &CandleSeries{
+		Symbol:     symbol,
+		Interval:   ti.FormatInterval(interval),
+		TimeUnixMs: make([]int64, len(dataset)),
+		Open:       make([]float64, len(dataset)),
+		High:       make([]float64, len(dataset)),
+		Low:        make([]float64, len(dataset)),
+		Close:      make([]float64, len(dataset)),
+		Volume:     make([]float64, len(dataset)),
+	}
+	for i, candle := range dataset {
+		series.TimeUnixMs[i] = candle.Timestamp.UnixMilli()
+		series.Open[i] = candle.Open
+		series.High[i] = candle.High
+		series.Low[i] = candle.Low
+		series.Close[i] = candle.Close
+		series.Volume[i] = candle.Volume
+	}
+
+	for i, candle := range dataset {
+		if candle.Trades != 0 && series.Trades == nil {
+			series.Trades = make([]int64, len(dataset))
+		}
+		if (candle.Bid != 0 || candle.Ask != 0) && series.Bid == nil {
+			series.Bid = make([]float64, len(dataset))
+			series.Ask = make([]float64, len(dataset))
+		}
+		if series.Trades != nil {
+			series.Trades[i] = int64(candle.Trades)
+		}
+		if series.Bid != nil {
+			series.Bid[i] = candle.Bid
+			series.Ask[i] = candle.Ask
+		}
+	}
+	return series
+}
+
+// ToDataset converts a CandleSeries back to UTC candles, failing with ErrInvalidDataset when the columns differ in length
+func ToDataset(series *CandleSeries) ([]ti.OHLCV, error) {
+	n := len(series.GetTimeUnixMs())
+	for _, column := range [][]float64{series.GetOpen(), series.GetHigh(), series.GetLow(), series.GetClose(), series.GetVolume()} {
+		if len(column) != n {
+			return nil, ti.ErrInvalidDataset
+		}
+	}
+	trades, bid, ask := series.GetTrades(), series.GetBid(), series.GetAsk()
+	if (len(trades) != 0 && len(trades) != n) || len(bid) != len(ask) || (len(bid) != 0 && len(bid) != n) {
+		return nil, ti.ErrInvalidDataset
+	}
+
+	dataset := make([]ti.OHLCV, n)
+	for i := range dataset {
+		dataset[i] = ti.OHLCV{
+			Timestamp: time.UnixMilli(series.TimeUnixMs[i]).UTC(),
+			Open:      series.Open[i],
+			High:      series.High[i],
+			Low:       series.Low[i],
+			Close:     series.Close[i],
+			Volume:    series.Volume[i],
+		}
+		if len(trades) > 0 {
+			dataset[i].Trades = int(trades[i])
+		}
+		if len(bid) > 0 {
+			dataset[i].Bid, dataset[i].Ask = bid[i], ask[i]
+		}
+	}
+	return dataset, nil
+}
+
+// FromPoints converts an indicator's output with the parameters it was computed with
+func FromPoints(name string, params ti.IndicatorParams, points []ti.Point) *IndicatorSeries {
+	series := &IndicatorSeries{Name: name, Params: maps.Clone(params), Points: make([]*IndicatorPoint, len(points))}
+	for i, point := range points {
+		series.Points[i] = &IndicatorPoint{
+			Timestamp:  timestamppb.New(point.Timestamp),
+			Value:      point.Value,
+			Components: maps.Clone(point.Components),
+		}
+	}
+	return series
+}
+
+// ToPoints converts an indicator series back to its name, parameters, and points
+func ToPoints(series *IndicatorSeries) (string, ti.IndicatorParams, []ti.Point) {
+	points := make([]ti.Point, len(series.GetPoints()))
+	for i, point := range series.GetPoints() {
+		points[i] = ti.Point{
+			Timestamp:  toTime(point.GetTimestamp()),
+			Value:      point.GetValue(),
+			Components: maps.Clone(point.GetComponents()),
+		}
+	}
+	return series.GetName(), ti.IndicatorParams(maps.Clone(series.GetParams())), points
+}
+
+// FromSMA converts a moving average series (CalculateSMA, CalculateEMA) to an indicator series
+func FromSMA(name string, params ti.IndicatorParams, results []ti.SMAResult) *IndicatorSeries {
+	points := make([]ti.Point, len(results))
+	for i, result := range results {
+		points[i] = ti.Point{Timestamp: result.Timestamp, Value: result.Value}
+	}
+	return FromPoints(name, params, points)
+}
+
+// FromRSI converts an RSI result
+func FromRSI(result ti.RSIResult) *RSIResult {
+	return &RSIResult{Timestamp: timestamppb.New(result.Timestamp), Value: result.Value, Signal: result.Signal}
+}
+
+// ToRSI converts an RSI result back
+func ToRSI(result *RSIResult) ti.RSIResult {
+	return ti.RSIResult{Timestamp: toTime(result.GetTimestamp()), Value: result.GetValue(), Signal: result.GetSignal()}
+}
+
+// FromBollinger converts one Bollinger Bands value
+func FromBollinger(bands ti.BollingerBands) *BollingerBands {
+	return &BollingerBands{
+		Timestamp:  timestamppb.New(bands.Timestamp),
+		UpperBand:  bands.UpperBand,
+		MiddleBand: bands.MiddleBand,
+		LowerBand:  bands.LowerBand,
+		BandWidth:  bands.BandWidth,
+	}
+}
+
+// ToBollinger converts one Bollinger Bands value back
+func ToBollinger(bands *BollingerBands) ti.BollingerBands {
+	return ti.BollingerBands{
+		Timestamp:  toTime(bands.GetTimestamp()),
+		UpperBand:  bands.GetUpperBand(),
+		MiddleBand: bands.GetMiddleBand(),
+		LowerBand:  bands.GetLowerBand(),
+		BandWidth:  bands.GetBandWidth(),
+	}
+}
+
+// FromVolume converts a volume analysis result
+func FromVolume(result ti.VolumeResult) *VolumeResult {
+	return &VolumeResult{
+		Timestamp: timestamppb.New(result.Timestamp),
+		Volume:    result.Volume,
+		Vma:       result.VMA,
+		Obv:       result.OBV,
+		Vpt:       result.VPT,
+		Vroc:      result.VROC,
+		Adl:       result.ADL,
+	}
+}
+
+// ToVolume converts a volume analysis result back
+func ToVolume(result *VolumeResult) ti.VolumeResult {
+	return ti.VolumeResult{
+		Timestamp: toTime(result.GetTimestamp()),
+		Volume:    result.GetVolume(),
+		VMA:       result.GetVma(),
+		OBV:       result.GetObv(),
+		VPT:       result.GetVpt(),
+		VROC:      result.GetVroc(),
+		ADL:       result.GetAdl(),
+	}
+}
+
+// FromTechnical converts a comprehensive analysis
+func FromTechnical(analysis ti.CombinedTechnicalAnalysis) *CombinedTechnicalAnalysis {
+	result := &CombinedTechnicalAnalysis{
+		SmaSignal:       string(analysis.SMASignal),
+		BollingerSignal: string(analysis.BollingerSignal),
+		RsiSignal:       string(analysis.RSISignal),
+		FinalSignal:     string(analysis.FinalSignal),
+		Confidence:      analysis.Confidence,
+		RiskLevel:       analysis.RiskLevel,
+		ConfidenceScore: analysis.ConfidenceScore,
+		RiskScore:       analysis.RiskScore,
+		Regime:          string(analysis.Regime),
+		WeightedScore:   analysis.WeightedScore,
+	}
+	if len(analysis.ExtraSignals) > 0 {
+		result.ExtraSignals = make(map[string]string, len(analysis.ExtraSignals))
+		for name, signal := range analysis.ExtraSignals {
+			result.ExtraSignals[name] = string(signal)
+		}
+	}
+	for _, c := range analysis.Breakdown {
+		result.Breakdown = append(result.Breakdown, &Contribution{
+			Name:       c.Name,
+			Signal:     string(c.Signal),
+			Weight:     c.Weight,
+			Confidence: c.Confidence,
+			Direction:  int32(c.Direction),
+			Share:      c.Share,
+		})
+	}
+	if d := analysis.Derivatives; d != nil {
+		result.Derivatives = &DerivativesAnalysis{
+			Timestamp:       timestamppb.New(d.Timestamp),
+			OpenInterest:    d.OpenInterest,
+			OiChange:        d.OIChange,
+			FundingRate:     d.FundingRate,
+			WeightedFunding: d.WeightedFunding,
+			Crowding:        d.Crowding,
+			Signal:          string(d.Signal),
+			Confidence:      d.Confidence,
+		}
+	}
+	return result
+}
+
+// ToTechnical converts a comprehensive analysis back
+func ToTechnical(analysis *CombinedTechnicalAnalysis) ti.CombinedTechnicalAnalysis {
+	result := ti.CombinedTechnicalAnalysis{
+		SMASignal:       ti.Signal(analysis.GetSmaSignal()),
+		BollingerSignal: ti.Signal(analysis.GetBollingerSignal()),
+		RSISignal:       ti.Signal(analysis.GetRsiSignal()),
+		FinalSignal:     ti.Signal(analysis.GetFinalSignal()),
+		Confidence:      analysis.GetConfidence(),
+		RiskLevel:       analysis.GetRiskLevel(),
+		ConfidenceScore: analysis.GetConfidenceScore(),
+		RiskScore:       analysis.GetRiskScore(),
+		Regime:          ti.TrendRegime(analysis.GetRegime()),
+		WeightedScore:   analysis.GetWeightedScore(),
+	}
+	if len(analysis.GetExtraSignals()) > 0 {
+		result.ExtraSignals = make(map[string]ti.Signal, len(analysis.GetExtraSignals()))
+		for name, signal := range analysis.GetExtraSignals() {
+			result.ExtraSignals[name] = ti.Signal(signal)
+		}
+	}
+	for _, c := range analysis.GetBreakdown() {
+		result.Breakdown = append(result.Breakdown, ti.Contribution{
+			Name:       c.GetName(),
+			Signal:     ti.Signal(c.GetSignal()),
+			Weight:     c.GetWeight(),
+			Confidence: c.GetConfidence(),
+			Direction:  int(c.GetDirection()),
+			Share:      c.GetShare(),
+		})
+	}
+	if d := analysis.GetDerivatives(); d != nil {
+		result.Derivatives = &ti.DerivativesAnalysis{
+			DerivativesResult: ti.DerivativesResult{
+				Timestamp:       toTime(d.GetTimestamp()),
+				OpenInterest:    d.GetOpenInterest(),
+				OIChange:        d.GetOiChange(),
+				FundingRate:     d.GetFundingRate(),
+				WeightedFunding: d.GetWeightedFunding(),
+			},
+			Crowding:   d.GetCrowding(),
+			Signal:     ti.Signal(d.GetSignal()),
+			Confidence: d.GetConfidence(),
+		}
+	}
+	return result
+}
+
+// FromUltimate converts an ultimate memecoin analysis, including the timeframe confirmation when present
+func FromUltimate(analysis ti.UltimateMemecoinAnalysis) *UltimateMemecoinAnalysis {
+	result := &UltimateMemecoinAnalysis{
+		Technical: FromTechnical(analysis.Technical),
+		Volume: &VolumeStrategy{
+			Current:            FromVolume(analysis.Volume.Current),
+			BreakoutSignal:     fromVolumeSignal(analysis.Volume.BreakoutSignal),
+			AccumulationSignal: fromVolumeSignal(analysis.Volume.AccumulationSignal),
+			VolumeRatio:        analysis.Volume.VolumeRatio,
+			ObvTrend:           analysis.Volume.OBVTrend,
+			Signal:             string(analysis.Volume.Signal),
+			Quality:            fromVolumeQuality(analysis.Volume.Quality),
+
+			Divergences:         fromVolumeDivergences(analysis.Volume.Divergences),
+			DistributionWarning: analysis.Volume.DistributionWarning,
+		},
+		FinalSignal:     string(analysis.FinalSignal),
+		Confidence:      analysis.Confidence,
+		RiskLevel:       analysis.RiskLevel,
+		RugPullRisk:     analysis.RugPullRisk,
+		VolumeConfirm:   analysis.VolumeConfirm,
+		ConfidenceScore: analysis.ConfidenceScore,
+		RiskScore:       analysis.RiskScore,
+		SignalStrength:  analysis.SignalStrength,
+		RugPullScore:    analysis.RugPullScore,
+		Honeypot:        analysis.Honeypot,
+		Illiquid:        analysis.Illiquid,
+	}
+	for _, reason := range analysis.RugPullReasons {
+		result.RugPullReasons = append(result.RugPullReasons, &RugPullReason{Code: string(reason.Code), Severity: reason.Severity, Detail: reason.Detail})
+	}
+	for _, reason := range analysis.TradabilityReasons {
+		result.TradabilityReasons = append(result.TradabilityReasons, &TradabilityReason{Code: string(reason.Code), Detail: reason.Detail})
+	}
+	if m := analysis.Manipulation; m != nil {
+		result.Manipulation = &ManipulationReport{Covered: int32(m.Covered), Suspected: m.Suspected}
+		for _, event := range m.Events {
+			result.Manipulation.Events = append(result.Manipulation.Events, &ManipulationEvent{
+				Index:     int32(event.Index),
+				Timestamp: timestamppb.New(event.Timestamp),
+				Kind:      string(event.Kind),
+				Move:      event.Move,
+				Trades:    int64(event.Trades),
+				Spread:    event.Spread,
+			})
+		}
+	}
+	if c := analysis.Liquidation; c != nil {
+		result.Liquidation = &LiquidationCascade{
+			Index:       int32(c.Index),
+			Timestamp:   timestamppb.New(c.Timestamp),
+			Signal:      string(c.Signal),
+			Wick:        c.Wick,
+			VolumeRatio: c.VolumeRatio,
+			Retrace:     c.Retrace,
+		}
+	}
+	if y := analysis.YoungToken; y != nil {
+		result.YoungToken = &YoungTokenMode{
+			Candles:       int32(y.Candles),
+			Required:      int32(y.Required),
+			SmaPeriod:     int32(y.SMAPeriod),
+			BbPeriod:      int32(y.BBPeriod),
+			RsiPeriod:     int32(y.RSIPeriod),
+			VmaPeriod:     int32(y.VMAPeriod),
+			VrocPeriod:    int32(y.VROCPeriod),
+			SkipBollinger: y.SkipBollinger,
+			Bars:          y.Bars,
+		}
+	}
+	if tf := analysis.Timeframes; tf != nil {
+		result.Timeframes = &MultiTimeframeAnalysis{Direction: tf.Direction, AlignmentScore: tf.AlignmentScore, Signal: string(tf.Signal)}
+		for _, frame := range tf.Timeframes {
+			result.Timeframes.Timeframes = append(result.Timeframes.Timeframes, &TimeframeAnalysis{
+				IntervalMs: frame.Interval.Milliseconds(),
+				Candles:    int32(frame.Candles),
+				Signal:     string(frame.Signal),
+				Analysis:   FromTechnical(frame.Analysis),
+				Supplied:   frame.Supplied,
+			})
+		}
+	}
+	return result
+}
+
+// ToUltimate converts an ultimate memecoin analysis back
+func ToUltimate(analysis *UltimateMemecoinAnalysis) ti.UltimateMemecoinAnalysis {
+	volume := analysis.GetVolume()
+	result := ti.UltimateMemecoinAnalysis{
+		Technical: ToTechnical(analysis.GetTechnical()),
+		Volume: ti.VolumeStrategy{
+			Current:            ToVolume(volume.GetCurrent()),
+			BreakoutSignal:     toVolumeSignal(volume.GetBreakoutSignal()),
+			AccumulationSignal: toVolumeSignal(volume.GetAccumulationSignal()),
+			VolumeRatio:        volume.GetVolumeRatio(),
+			OBVTrend:           volume.GetObvTrend(),
+			Signal:             ti.Signal(volume.GetSignal()),
+			Quality:            toVolumeQuality(volume.GetQuality()),
+
+			Divergences:         toVolumeDivergences(volume.GetDivergences()),
+			DistributionWarning: volume.GetDistributionWarning(),
+		},
+		FinalSignal:     ti.Signal(analysis.GetFinalSignal()),
+		Confidence:      analysis.GetConfidence(),
+		RiskLevel:       analysis.GetRiskLevel(),
+		RugPullRisk:     analysis.GetRugPullRisk(),
+		VolumeConfirm:   analysis.GetVolumeConfirm(),
+		ConfidenceScore: analysis.GetConfidenceScore(),
+		RiskScore:       analysis.GetRiskScore(),
+		SignalStrength:  analysis.GetSignalStrength(),
+		RugPullScore:    analysis.GetRugPullScore(),
+		Honeypot:        analysis.GetHoneypot(),
+		Illiquid:        analysis.GetIlliquid(),
+	}
+	for _, reason := range analysis.GetRugPullReasons() {
+		result.RugPullReasons = append(result.RugPullReasons, ti.RugPullReason{Code: ti.RugPullCode(reason.GetCode()), Severity: reason.GetSeverity(), Detail: reason.GetDetail()})
+	}
+	for _, reason := range analysis.GetTradabilityReasons() {
+		result.TradabilityReasons = append(result.TradabilityReasons, ti.TradabilityReason{Code: ti.TradabilityCode(reason.GetCode()), Detail: reason.GetDetail()})
+	}
+	if m := analysis.GetManipulation(); m != nil {
+		result.Manipulation = &ti.ManipulationReport{Covered: int(m.GetCovered()), Suspected: m.GetSuspected()}
+		for _, event := range m.GetEvents() {
+			result.Manipulation.Events = append(result.Manipulation.Events, ti.ManipulationEvent{
+				Index:     int(event.GetIndex()),
+				Timestamp: toTime(event.GetTimestamp()),
+				Kind:      ti.ManipulationKind(event.GetKind()),
+				Move:      event.GetMove(),
+				Trades:    int(event.GetTrades()),
+				Spread:    event.GetSpread(),
+			})
+		}
+	}
+	if c := analysis.GetLiquidation(); c != nil {
+		result.Liquidation = &ti.LiquidationCascade{
+			Index:       int(c.GetIndex()),
+			Timestamp:   toTime(c.GetTimestamp()),
+			Signal:      ti.Signal(c.GetSignal()),
+			Wick:        c.GetWick(),
+			VolumeRatio: c.GetVolumeRatio(),
+			Retrace:     c.GetRetrace(),
+		}
+	}
+	if y := analysis.GetYoungToken(); y != nil {
+		result.YoungToken = &ti.YoungTokenMode{
+			Candles:       int(y.GetCandles()),
+			Required:      int(y.GetRequired()),
+			SMAPeriod:     int(y.GetSmaPeriod()),
+			BBPeriod:      int(y.GetBbPeriod()),
+			RSIPeriod:     int(y.GetRsiPeriod()),
+			VMAPeriod:     int(y.GetVmaPeriod()),
+			VROCPeriod:    int(y.GetVrocPeriod()),
+			SkipBollinger: y.GetSkipBollinger(),
+			Bars:          y.GetBars(),
+		}
+	}
+	if tf := analysis.GetTimeframes(); tf != nil {
+		result.Timeframes = &ti.MultiTimeframeAnalysis{Direction: tf.GetDirection(), AlignmentScore: tf.GetAlignmentScore(), Signal: ti.Signal(tf.GetSignal())}
+		for _, frame := range tf.GetTimeframes() {
+			result.Timeframes.Timeframes = append(result.Timeframes.Timeframes, ti.TimeframeAnalysis{
+				Interval: time.Duration(frame.GetIntervalMs()) * time.Millisecond,
+				Candles:  int(frame.GetCandles()),
+				Signal:   ti.Signal(frame.GetSignal()),
+				Analysis: ToTechnical(frame.GetAnalysis()),
+				Supplied: frame.GetSupplied(),
+			})
+		}
+	}
+	return result
+}
+
+// fromVolumeSignal converts a volume signal
+func fromVolumeSignal(signal ti.VolumeSignal) *VolumeSignal {
+	return &VolumeSignal{Type: signal.Type, Strength: signal.Strength, Trend: signal.Trend, Confidence: signal.Confidence}
+}
+
+// toVolumeSignal converts a volume signal back
+func toVolumeSignal(signal *VolumeSignal) ti.VolumeSignal {
+	return ti.VolumeSignal{Type: signal.GetType(), Strength: signal.GetStrength(), Trend: signal.GetTrend(), Confidence: signal.GetConfidence()}
+}
+
+// fromVolumeQuality converts a volume quality score
+func fromVolumeQuality(quality ti.VolumeQuality) *VolumeQuality {
+	result := &VolumeQuality{Score: quality.Score, WashTrading: quality.WashTrading}
+	for _, reason := range quality.Reasons {
+		result.Reasons = append(result.Reasons, &VolumeQualityReason{Code: string(reason.Code), Score: reason.Score, Detail: reason.Detail})
+	}
+	return result
+}
+
+// toVolumeQuality converts a volume quality score back
+func toVolumeQuality(quality *VolumeQuality) ti.VolumeQuality {
+	result := ti.VolumeQuality{Score: quality.GetScore(), WashTrading: quality.GetWashTrading()}
+	for _, reason := range quality.GetReasons() {
+		result.Reasons = append(result.Reasons, ti.VolumeQualityReason{Code: ti.VolumeQualityCode(reason.GetCode()), Score: reason.GetScore(), Detail: reason.GetDetail()})
+	}
+	return result
+}
+
+// fromVolumeDivergences converts OBV and ADL divergences
+func fromVolumeDivergences(divergences []ti.VolumeDivergence) []*VolumeDivergence {
+	var result []*VolumeDivergence
+	for _, d := range divergences {
+		result = append(result, &VolumeDivergence{
+			Line:            d.Line,
+			Type:            d.Type,
+			Strength:        d.Strength,
+			PriceStart:      fromPivot(d.PriceStart),
+			PriceEnd:        fromPivot(d.PriceEnd),
+			OscillatorStart: fromPivot(d.OscillatorStart),
+			OscillatorEnd:   fromPivot(d.OscillatorEnd),
+			Confidence:      d.Confidence,
+		})
+	}
+	return result
+}
+
+// toVolumeDivergences converts OBV and ADL divergences back
+func toVolumeDivergences(divergences []*VolumeDivergence) []ti.VolumeDivergence {
+	var result []ti.VolumeDivergence
+	for _, d := range divergences {
+		result = append(result, ti.VolumeDivergence{Line: d.GetLine(), Divergence: ti.Divergence{
+			Type:            d.GetType(),
+			Strength:        d.GetStrength(),
+			PriceStart:      toPivot(d.GetPriceStart()),
+			PriceEnd:        toPivot(d.GetPriceEnd()),
+			OscillatorStart: toPivot(d.GetOscillatorStart()),
+			OscillatorEnd:   toPivot(d.GetOscillatorEnd()),
+			Confidence:      d.GetConfidence(),
+		}})
+	}
+	return result
+}
+
+// fromPivot converts a swing pivot
+func fromPivot(p ti.Pivot) *Pivot {
+	return &Pivot{Index: int32(p.Index), Timestamp: timestamppb.New(p.Timestamp), Value: p.Value}
+}
+
+// toPivot converts a swing pivot back
+func toPivot(p *Pivot) ti.Pivot {
+	return ti.Pivot{Index: int(p.GetIndex()), Timestamp: toTime(p.GetTimestamp()), Value: p.GetValue()}
+}
+
+// toTime converts a timestamp to UTC time, mapping nil to the zero time
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
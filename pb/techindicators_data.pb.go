@@ -0,0 +1,2536 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: techindicators_data.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Candle mirrors techindicators.OHLCV
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Trades        int64                  `protobuf:"varint,7,opt,name=trades,proto3" json:"trades,omitempty"`
+	Bid           float64                `protobuf:"fixed64,8,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask           float64                `protobuf:"fixed64,9,opt,name=ask,proto3" json:"ask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_techindicators_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Candle) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Candle) GetTrades() int64 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *Candle) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *Candle) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+// CandleSeries stores candles column by column: packed repeated fields make long series far smaller
+// than repeated Candle messages. All columns have the same length, except that the order flow columns
+// (trades, bid, ask) are empty when no candle carries them.
+type CandleSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	TimeUnixMs    []int64                `protobuf:"varint,3,rep,packed,name=time_unix_ms,json=timeUnixMs,proto3" json:"time_unix_ms,omitempty"`
+	Open          []float64              `protobuf:"fixed64,4,rep,packed,name=open,proto3" json:"open,omitempty"`
+	High          []float64              `protobuf:"fixed64,5,rep,packed,name=high,proto3" json:"high,omitempty"`
+	Low           []float64              `protobuf:"fixed64,6,rep,packed,name=low,proto3" json:"low,omitempty"`
+	Close         []float64              `protobuf:"fixed64,7,rep,packed,name=close,proto3" json:"close,omitempty"`
+	Volume        []float64              `protobuf:"fixed64,8,rep,packed,name=volume,proto3" json:"volume,omitempty"`
+	Trades        []int64                `protobuf:"varint,9,rep,packed,name=trades,proto3" json:"trades,omitempty"`
+	Bid           []float64              `protobuf:"fixed64,10,rep,packed,name=bid,proto3" json:"bid,omitempty"`
+	Ask           []float64              `protobuf:"fixed64,11,rep,packed,name=ask,proto3" json:"ask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CandleSeries) Reset() {
+	*x = CandleSeries{}
+	mi := &file_techindicators_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CandleSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CandleSeries) ProtoMessage() {}
+
+func (x *CandleSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CandleSeries.ProtoReflect.Descriptor instead.
+func (*CandleSeries) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CandleSeries) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CandleSeries) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *CandleSeries) GetTimeUnixMs() []int64 {
+	if x != nil {
+		return x.TimeUnixMs
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetOpen() []float64 {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetHigh() []float64 {
+	if x != nil {
+		return x.High
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetLow() []float64 {
+	if x != nil {
+		return x.Low
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetClose() []float64 {
+	if x != nil {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetVolume() []float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetTrades() []int64 {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetBid() []float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return nil
+}
+
+func (x *CandleSeries) GetAsk() []float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return nil
+}
+
+// IndicatorPoint mirrors techindicators.Point
+type IndicatorPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Components    map[string]float64     `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndicatorPoint) Reset() {
+	*x = IndicatorPoint{}
+	mi := &file_techindicators_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndicatorPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndicatorPoint) ProtoMessage() {}
+
+func (x *IndicatorPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndicatorPoint.ProtoReflect.Descriptor instead.
+func (*IndicatorPoint) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IndicatorPoint) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *IndicatorPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *IndicatorPoint) GetComponents() map[string]float64 {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// IndicatorSeries is one computed indicator with the parameters it was computed with
+type IndicatorSeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Params        map[string]float64     `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Points        []*IndicatorPoint      `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndicatorSeries) Reset() {
+	*x = IndicatorSeries{}
+	mi := &file_techindicators_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndicatorSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndicatorSeries) ProtoMessage() {}
+
+func (x *IndicatorSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndicatorSeries.ProtoReflect.Descriptor instead.
+func (*IndicatorSeries) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IndicatorSeries) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IndicatorSeries) GetParams() map[string]float64 {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *IndicatorSeries) GetPoints() []*IndicatorPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// RSIResult mirrors techindicators.RSIResult
+type RSIResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Signal        string                 `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RSIResult) Reset() {
+	*x = RSIResult{}
+	mi := &file_techindicators_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RSIResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RSIResult) ProtoMessage() {}
+
+func (x *RSIResult) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RSIResult.ProtoReflect.Descriptor instead.
+func (*RSIResult) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RSIResult) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *RSIResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *RSIResult) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+// BollingerBands mirrors techindicators.BollingerBands
+type BollingerBands struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	UpperBand     float64                `protobuf:"fixed64,2,opt,name=upper_band,json=upperBand,proto3" json:"upper_band,omitempty"`
+	MiddleBand    float64                `protobuf:"fixed64,3,opt,name=middle_band,json=middleBand,proto3" json:"middle_band,omitempty"`
+	LowerBand     float64                `protobuf:"fixed64,4,opt,name=lower_band,json=lowerBand,proto3" json:"lower_band,omitempty"`
+	BandWidth     float64                `protobuf:"fixed64,5,opt,name=band_width,json=bandWidth,proto3" json:"band_width,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BollingerBands) Reset() {
+	*x = BollingerBands{}
+	mi := &file_techindicators_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BollingerBands) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BollingerBands) ProtoMessage() {}
+
+func (x *BollingerBands) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BollingerBands.ProtoReflect.Descriptor instead.
+func (*BollingerBands) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BollingerBands) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *BollingerBands) GetUpperBand() float64 {
+	if x != nil {
+		return x.UpperBand
+	}
+	return 0
+}
+
+func (x *BollingerBands) GetMiddleBand() float64 {
+	if x != nil {
+		return x.MiddleBand
+	}
+	return 0
+}
+
+func (x *BollingerBands) GetLowerBand() float64 {
+	if x != nil {
+		return x.LowerBand
+	}
+	return 0
+}
+
+func (x *BollingerBands) GetBandWidth() float64 {
+	if x != nil {
+		return x.BandWidth
+	}
+	return 0
+}
+
+// VolumeResult mirrors techindicators.VolumeResult
+type VolumeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Volume        float64                `protobuf:"fixed64,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Vma           float64                `protobuf:"fixed64,3,opt,name=vma,proto3" json:"vma,omitempty"`
+	Obv           float64                `protobuf:"fixed64,4,opt,name=obv,proto3" json:"obv,omitempty"`
+	Vpt           float64                `protobuf:"fixed64,5,opt,name=vpt,proto3" json:"vpt,omitempty"`
+	Vroc          float64                `protobuf:"fixed64,6,opt,name=vroc,proto3" json:"vroc,omitempty"`
+	Adl           float64                `protobuf:"fixed64,7,opt,name=adl,proto3" json:"adl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeResult) Reset() {
+	*x = VolumeResult{}
+	mi := &file_techindicators_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeResult) ProtoMessage() {}
+
+func (x *VolumeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeResult.ProtoReflect.Descriptor instead.
+func (*VolumeResult) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VolumeResult) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *VolumeResult) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *VolumeResult) GetVma() float64 {
+	if x != nil {
+		return x.Vma
+	}
+	return 0
+}
+
+func (x *VolumeResult) GetObv() float64 {
+	if x != nil {
+		return x.Obv
+	}
+	return 0
+}
+
+func (x *VolumeResult) GetVpt() float64 {
+	if x != nil {
+		return x.Vpt
+	}
+	return 0
+}
+
+func (x *VolumeResult) GetVroc() float64 {
+	if x != nil {
+		return x.Vroc
+	}
+	return 0
+}
+
+func (x *VolumeResult) GetAdl() float64 {
+	if x != nil {
+		return x.Adl
+	}
+	return 0
+}
+
+// Contribution mirrors techindicators.Contribution
+type Contribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signal        string                 `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Direction     int32                  `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	Share         float64                `protobuf:"fixed64,6,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contribution) Reset() {
+	*x = Contribution{}
+	mi := &file_techindicators_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contribution) ProtoMessage() {}
+
+func (x *Contribution) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contribution.ProtoReflect.Descriptor instead.
+func (*Contribution) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Contribution) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contribution) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *Contribution) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Contribution) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Contribution) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Contribution) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+// CombinedTechnicalAnalysis mirrors techindicators.CombinedTechnicalAnalysis
+type CombinedTechnicalAnalysis struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SmaSignal       string                 `protobuf:"bytes,1,opt,name=sma_signal,json=smaSignal,proto3" json:"sma_signal,omitempty"`
+	BollingerSignal string                 `protobuf:"bytes,2,opt,name=bollinger_signal,json=bollingerSignal,proto3" json:"bollinger_signal,omitempty"`
+	RsiSignal       string                 `protobuf:"bytes,3,opt,name=rsi_signal,json=rsiSignal,proto3" json:"rsi_signal,omitempty"`
+	FinalSignal     string                 `protobuf:"bytes,4,opt,name=final_signal,json=finalSignal,proto3" json:"final_signal,omitempty"`
+	Confidence      string                 `protobuf:"bytes,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	RiskLevel       string                 `protobuf:"bytes,6,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	ConfidenceScore float64                `protobuf:"fixed64,7,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	RiskScore       float64                `protobuf:"fixed64,8,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	ExtraSignals    map[string]string      `protobuf:"bytes,9,rep,name=extra_signals,json=extraSignals,proto3" json:"extra_signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	WeightedScore   float64                `protobuf:"fixed64,10,opt,name=weighted_score,json=weightedScore,proto3" json:"weighted_score,omitempty"`
+	Breakdown       []*Contribution        `protobuf:"bytes,11,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	Regime          string                 `protobuf:"bytes,12,opt,name=regime,proto3" json:"regime,omitempty"`
+	Derivatives     *DerivativesAnalysis   `protobuf:"bytes,13,opt,name=derivatives,proto3" json:"derivatives,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CombinedTechnicalAnalysis) Reset() {
+	*x = CombinedTechnicalAnalysis{}
+	mi := &file_techindicators_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CombinedTechnicalAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombinedTechnicalAnalysis) ProtoMessage() {}
+
+func (x *CombinedTechnicalAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombinedTechnicalAnalysis.ProtoReflect.Descriptor instead.
+func (*CombinedTechnicalAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CombinedTechnicalAnalysis) GetSmaSignal() string {
+	if x != nil {
+		return x.SmaSignal
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetBollingerSignal() string {
+	if x != nil {
+		return x.BollingerSignal
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetRsiSignal() string {
+	if x != nil {
+		return x.RsiSignal
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetFinalSignal() string {
+	if x != nil {
+		return x.FinalSignal
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *CombinedTechnicalAnalysis) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *CombinedTechnicalAnalysis) GetExtraSignals() map[string]string {
+	if x != nil {
+		return x.ExtraSignals
+	}
+	return nil
+}
+
+func (x *CombinedTechnicalAnalysis) GetWeightedScore() float64 {
+	if x != nil {
+		return x.WeightedScore
+	}
+	return 0
+}
+
+func (x *CombinedTechnicalAnalysis) GetBreakdown() []*Contribution {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *CombinedTechnicalAnalysis) GetRegime() string {
+	if x != nil {
+		return x.Regime
+	}
+	return ""
+}
+
+func (x *CombinedTechnicalAnalysis) GetDerivatives() *DerivativesAnalysis {
+	if x != nil {
+		return x.Derivatives
+	}
+	return nil
+}
+
+// DerivativesAnalysis mirrors techindicators.DerivativesAnalysis
+type DerivativesAnalysis struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OpenInterest    float64                `protobuf:"fixed64,2,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	OiChange        float64                `protobuf:"fixed64,3,opt,name=oi_change,json=oiChange,proto3" json:"oi_change,omitempty"`
+	FundingRate     float64                `protobuf:"fixed64,4,opt,name=funding_rate,json=fundingRate,proto3" json:"funding_rate,omitempty"`
+	WeightedFunding float64                `protobuf:"fixed64,5,opt,name=weighted_funding,json=weightedFunding,proto3" json:"weighted_funding,omitempty"`
+	Crowding        string                 `protobuf:"bytes,6,opt,name=crowding,proto3" json:"crowding,omitempty"`
+	Signal          string                 `protobuf:"bytes,7,opt,name=signal,proto3" json:"signal,omitempty"`
+	Confidence      float64                `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DerivativesAnalysis) Reset() {
+	*x = DerivativesAnalysis{}
+	mi := &file_techindicators_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DerivativesAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivativesAnalysis) ProtoMessage() {}
+
+func (x *DerivativesAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivativesAnalysis.ProtoReflect.Descriptor instead.
+func (*DerivativesAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DerivativesAnalysis) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *DerivativesAnalysis) GetOpenInterest() float64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+func (x *DerivativesAnalysis) GetOiChange() float64 {
+	if x != nil {
+		return x.OiChange
+	}
+	return 0
+}
+
+func (x *DerivativesAnalysis) GetFundingRate() float64 {
+	if x != nil {
+		return x.FundingRate
+	}
+	return 0
+}
+
+func (x *DerivativesAnalysis) GetWeightedFunding() float64 {
+	if x != nil {
+		return x.WeightedFunding
+	}
+	return 0
+}
+
+func (x *DerivativesAnalysis) GetCrowding() string {
+	if x != nil {
+		return x.Crowding
+	}
+	return ""
+}
+
+func (x *DerivativesAnalysis) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *DerivativesAnalysis) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// VolumeSignal mirrors techindicators.VolumeSignal
+type VolumeSignal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Strength      string                 `protobuf:"bytes,2,opt,name=strength,proto3" json:"strength,omitempty"`
+	Trend         string                 `protobuf:"bytes,3,opt,name=trend,proto3" json:"trend,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeSignal) Reset() {
+	*x = VolumeSignal{}
+	mi := &file_techindicators_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeSignal) ProtoMessage() {}
+
+func (x *VolumeSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeSignal.ProtoReflect.Descriptor instead.
+func (*VolumeSignal) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VolumeSignal) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VolumeSignal) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *VolumeSignal) GetTrend() string {
+	if x != nil {
+		return x.Trend
+	}
+	return ""
+}
+
+func (x *VolumeSignal) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// VolumeStrategy mirrors techindicators.VolumeStrategy
+type VolumeStrategy struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Current             *VolumeResult          `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	BreakoutSignal      *VolumeSignal          `protobuf:"bytes,2,opt,name=breakout_signal,json=breakoutSignal,proto3" json:"breakout_signal,omitempty"`
+	AccumulationSignal  *VolumeSignal          `protobuf:"bytes,3,opt,name=accumulation_signal,json=accumulationSignal,proto3" json:"accumulation_signal,omitempty"`
+	VolumeRatio         float64                `protobuf:"fixed64,4,opt,name=volume_ratio,json=volumeRatio,proto3" json:"volume_ratio,omitempty"`
+	ObvTrend            string                 `protobuf:"bytes,5,opt,name=obv_trend,json=obvTrend,proto3" json:"obv_trend,omitempty"`
+	Signal              string                 `protobuf:"bytes,6,opt,name=signal,proto3" json:"signal,omitempty"`
+	Quality             *VolumeQuality         `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Divergences         []*VolumeDivergence    `protobuf:"bytes,8,rep,name=divergences,proto3" json:"divergences,omitempty"`
+	DistributionWarning bool                   `protobuf:"varint,9,opt,name=distribution_warning,json=distributionWarning,proto3" json:"distribution_warning,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VolumeStrategy) Reset() {
+	*x = VolumeStrategy{}
+	mi := &file_techindicators_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeStrategy) ProtoMessage() {}
+
+func (x *VolumeStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeStrategy.ProtoReflect.Descriptor instead.
+func (*VolumeStrategy) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VolumeStrategy) GetCurrent() *VolumeResult {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *VolumeStrategy) GetBreakoutSignal() *VolumeSignal {
+	if x != nil {
+		return x.BreakoutSignal
+	}
+	return nil
+}
+
+func (x *VolumeStrategy) GetAccumulationSignal() *VolumeSignal {
+	if x != nil {
+		return x.AccumulationSignal
+	}
+	return nil
+}
+
+func (x *VolumeStrategy) GetVolumeRatio() float64 {
+	if x != nil {
+		return x.VolumeRatio
+	}
+	return 0
+}
+
+func (x *VolumeStrategy) GetObvTrend() string {
+	if x != nil {
+		return x.ObvTrend
+	}
+	return ""
+}
+
+func (x *VolumeStrategy) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *VolumeStrategy) GetQuality() *VolumeQuality {
+	if x != nil {
+		return x.Quality
+	}
+	return nil
+}
+
+func (x *VolumeStrategy) GetDivergences() []*VolumeDivergence {
+	if x != nil {
+		return x.Divergences
+	}
+	return nil
+}
+
+func (x *VolumeStrategy) GetDistributionWarning() bool {
+	if x != nil {
+		return x.DistributionWarning
+	}
+	return false
+}
+
+// VolumeDivergence mirrors techindicators.VolumeDivergence
+type VolumeDivergence struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Line            string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Strength        string                 `protobuf:"bytes,3,opt,name=strength,proto3" json:"strength,omitempty"`
+	PriceStart      *Pivot                 `protobuf:"bytes,4,opt,name=price_start,json=priceStart,proto3" json:"price_start,omitempty"`
+	PriceEnd        *Pivot                 `protobuf:"bytes,5,opt,name=price_end,json=priceEnd,proto3" json:"price_end,omitempty"`
+	OscillatorStart *Pivot                 `protobuf:"bytes,6,opt,name=oscillator_start,json=oscillatorStart,proto3" json:"oscillator_start,omitempty"`
+	OscillatorEnd   *Pivot                 `protobuf:"bytes,7,opt,name=oscillator_end,json=oscillatorEnd,proto3" json:"oscillator_end,omitempty"`
+	Confidence      float64                `protobuf:"fixed64,8,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VolumeDivergence) Reset() {
+	*x = VolumeDivergence{}
+	mi := &file_techindicators_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeDivergence) ProtoMessage() {}
+
+func (x *VolumeDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeDivergence.ProtoReflect.Descriptor instead.
+func (*VolumeDivergence) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VolumeDivergence) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+func (x *VolumeDivergence) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VolumeDivergence) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *VolumeDivergence) GetPriceStart() *Pivot {
+	if x != nil {
+		return x.PriceStart
+	}
+	return nil
+}
+
+func (x *VolumeDivergence) GetPriceEnd() *Pivot {
+	if x != nil {
+		return x.PriceEnd
+	}
+	return nil
+}
+
+func (x *VolumeDivergence) GetOscillatorStart() *Pivot {
+	if x != nil {
+		return x.OscillatorStart
+	}
+	return nil
+}
+
+func (x *VolumeDivergence) GetOscillatorEnd() *Pivot {
+	if x != nil {
+		return x.OscillatorEnd
+	}
+	return nil
+}
+
+func (x *VolumeDivergence) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// Pivot mirrors techindicators.Pivot
+type Pivot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pivot) Reset() {
+	*x = Pivot{}
+	mi := &file_techindicators_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pivot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pivot) ProtoMessage() {}
+
+func (x *Pivot) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pivot.ProtoReflect.Descriptor instead.
+func (*Pivot) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Pivot) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pivot) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Pivot) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// VolumeQuality mirrors techindicators.VolumeQuality
+type VolumeQuality struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Score         float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	WashTrading   bool                   `protobuf:"varint,2,opt,name=wash_trading,json=washTrading,proto3" json:"wash_trading,omitempty"`
+	Reasons       []*VolumeQualityReason `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeQuality) Reset() {
+	*x = VolumeQuality{}
+	mi := &file_techindicators_data_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeQuality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeQuality) ProtoMessage() {}
+
+func (x *VolumeQuality) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeQuality.ProtoReflect.Descriptor instead.
+func (*VolumeQuality) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VolumeQuality) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VolumeQuality) GetWashTrading() bool {
+	if x != nil {
+		return x.WashTrading
+	}
+	return false
+}
+
+func (x *VolumeQuality) GetReasons() []*VolumeQualityReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// VolumeQualityReason mirrors techindicators.VolumeQualityReason
+type VolumeQualityReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeQualityReason) Reset() {
+	*x = VolumeQualityReason{}
+	mi := &file_techindicators_data_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeQualityReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeQualityReason) ProtoMessage() {}
+
+func (x *VolumeQualityReason) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeQualityReason.ProtoReflect.Descriptor instead.
+func (*VolumeQualityReason) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VolumeQualityReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VolumeQualityReason) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *VolumeQualityReason) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// TimeframeAnalysis mirrors techindicators.TimeframeAnalysis; the interval is in milliseconds
+type TimeframeAnalysis struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	IntervalMs    int64                      `protobuf:"varint,1,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	Candles       int32                      `protobuf:"varint,2,opt,name=candles,proto3" json:"candles,omitempty"`
+	Signal        string                     `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Analysis      *CombinedTechnicalAnalysis `protobuf:"bytes,4,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	Supplied      bool                       `protobuf:"varint,5,opt,name=supplied,proto3" json:"supplied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeframeAnalysis) Reset() {
+	*x = TimeframeAnalysis{}
+	mi := &file_techindicators_data_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeframeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeframeAnalysis) ProtoMessage() {}
+
+func (x *TimeframeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeframeAnalysis.ProtoReflect.Descriptor instead.
+func (*TimeframeAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TimeframeAnalysis) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *TimeframeAnalysis) GetCandles() int32 {
+	if x != nil {
+		return x.Candles
+	}
+	return 0
+}
+
+func (x *TimeframeAnalysis) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *TimeframeAnalysis) GetAnalysis() *CombinedTechnicalAnalysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
+func (x *TimeframeAnalysis) GetSupplied() bool {
+	if x != nil {
+		return x.Supplied
+	}
+	return false
+}
+
+// MultiTimeframeAnalysis mirrors techindicators.MultiTimeframeAnalysis
+type MultiTimeframeAnalysis struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timeframes     []*TimeframeAnalysis   `protobuf:"bytes,1,rep,name=timeframes,proto3" json:"timeframes,omitempty"`
+	Direction      float64                `protobuf:"fixed64,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	AlignmentScore float64                `protobuf:"fixed64,3,opt,name=alignment_score,json=alignmentScore,proto3" json:"alignment_score,omitempty"`
+	Signal         string                 `protobuf:"bytes,4,opt,name=signal,proto3" json:"signal,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MultiTimeframeAnalysis) Reset() {
+	*x = MultiTimeframeAnalysis{}
+	mi := &file_techindicators_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiTimeframeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTimeframeAnalysis) ProtoMessage() {}
+
+func (x *MultiTimeframeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTimeframeAnalysis.ProtoReflect.Descriptor instead.
+func (*MultiTimeframeAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MultiTimeframeAnalysis) GetTimeframes() []*TimeframeAnalysis {
+	if x != nil {
+		return x.Timeframes
+	}
+	return nil
+}
+
+func (x *MultiTimeframeAnalysis) GetDirection() float64 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *MultiTimeframeAnalysis) GetAlignmentScore() float64 {
+	if x != nil {
+		return x.AlignmentScore
+	}
+	return 0
+}
+
+func (x *MultiTimeframeAnalysis) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+// UltimateMemecoinAnalysis mirrors techindicators.UltimateMemecoinAnalysis
+type UltimateMemecoinAnalysis struct {
+	state              protoimpl.MessageState     `protogen:"open.v1"`
+	Technical          *CombinedTechnicalAnalysis `protobuf:"bytes,1,opt,name=technical,proto3" json:"technical,omitempty"`
+	Volume             *VolumeStrategy            `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	FinalSignal        string                     `protobuf:"bytes,3,opt,name=final_signal,json=finalSignal,proto3" json:"final_signal,omitempty"`
+	Confidence         string                     `protobuf:"bytes,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	RiskLevel          string                     `protobuf:"bytes,5,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	RugPullRisk        string                     `protobuf:"bytes,6,opt,name=rug_pull_risk,json=rugPullRisk,proto3" json:"rug_pull_risk,omitempty"`
+	VolumeConfirm      bool                       `protobuf:"varint,7,opt,name=volume_confirm,json=volumeConfirm,proto3" json:"volume_confirm,omitempty"`
+	ConfidenceScore    float64                    `protobuf:"fixed64,8,opt,name=confidence_score,json=confidenceScore,proto3" json:"confidence_score,omitempty"`
+	RiskScore          float64                    `protobuf:"fixed64,9,opt,name=risk_score,json=riskScore,proto3" json:"risk_score,omitempty"`
+	Timeframes         *MultiTimeframeAnalysis    `protobuf:"bytes,10,opt,name=timeframes,proto3" json:"timeframes,omitempty"`
+	RugPullScore       float64                    `protobuf:"fixed64,11,opt,name=rug_pull_score,json=rugPullScore,proto3" json:"rug_pull_score,omitempty"`
+	RugPullReasons     []*RugPullReason           `protobuf:"bytes,12,rep,name=rug_pull_reasons,json=rugPullReasons,proto3" json:"rug_pull_reasons,omitempty"`
+	Honeypot           bool                       `protobuf:"varint,13,opt,name=honeypot,proto3" json:"honeypot,omitempty"`
+	Illiquid           bool                       `protobuf:"varint,14,opt,name=illiquid,proto3" json:"illiquid,omitempty"`
+	TradabilityReasons []*TradabilityReason       `protobuf:"bytes,15,rep,name=tradability_reasons,json=tradabilityReasons,proto3" json:"tradability_reasons,omitempty"`
+	Manipulation       *ManipulationReport        `protobuf:"bytes,16,opt,name=manipulation,proto3" json:"manipulation,omitempty"`
+	Liquidation        *LiquidationCascade        `protobuf:"bytes,17,opt,name=liquidation,proto3" json:"liquidation,omitempty"`
+	YoungToken         *YoungTokenMode            `protobuf:"bytes,18,opt,name=young_token,json=youngToken,proto3" json:"young_token,omitempty"`
+	SignalStrength     float64                    `protobuf:"fixed64,19,opt,name=signal_strength,json=signalStrength,proto3" json:"signal_strength,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UltimateMemecoinAnalysis) Reset() {
+	*x = UltimateMemecoinAnalysis{}
+	mi := &file_techindicators_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UltimateMemecoinAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UltimateMemecoinAnalysis) ProtoMessage() {}
+
+func (x *UltimateMemecoinAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UltimateMemecoinAnalysis.ProtoReflect.Descriptor instead.
+func (*UltimateMemecoinAnalysis) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UltimateMemecoinAnalysis) GetTechnical() *CombinedTechnicalAnalysis {
+	if x != nil {
+		return x.Technical
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetVolume() *VolumeStrategy {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetFinalSignal() string {
+	if x != nil {
+		return x.FinalSignal
+	}
+	return ""
+}
+
+func (x *UltimateMemecoinAnalysis) GetConfidence() string {
+	if x != nil {
+		return x.Confidence
+	}
+	return ""
+}
+
+func (x *UltimateMemecoinAnalysis) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *UltimateMemecoinAnalysis) GetRugPullRisk() string {
+	if x != nil {
+		return x.RugPullRisk
+	}
+	return ""
+}
+
+func (x *UltimateMemecoinAnalysis) GetVolumeConfirm() bool {
+	if x != nil {
+		return x.VolumeConfirm
+	}
+	return false
+}
+
+func (x *UltimateMemecoinAnalysis) GetConfidenceScore() float64 {
+	if x != nil {
+		return x.ConfidenceScore
+	}
+	return 0
+}
+
+func (x *UltimateMemecoinAnalysis) GetRiskScore() float64 {
+	if x != nil {
+		return x.RiskScore
+	}
+	return 0
+}
+
+func (x *UltimateMemecoinAnalysis) GetTimeframes() *MultiTimeframeAnalysis {
+	if x != nil {
+		return x.Timeframes
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetRugPullScore() float64 {
+	if x != nil {
+		return x.RugPullScore
+	}
+	return 0
+}
+
+func (x *UltimateMemecoinAnalysis) GetRugPullReasons() []*RugPullReason {
+	if x != nil {
+		return x.RugPullReasons
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetHoneypot() bool {
+	if x != nil {
+		return x.Honeypot
+	}
+	return false
+}
+
+func (x *UltimateMemecoinAnalysis) GetIlliquid() bool {
+	if x != nil {
+		return x.Illiquid
+	}
+	return false
+}
+
+func (x *UltimateMemecoinAnalysis) GetTradabilityReasons() []*TradabilityReason {
+	if x != nil {
+		return x.TradabilityReasons
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetManipulation() *ManipulationReport {
+	if x != nil {
+		return x.Manipulation
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetLiquidation() *LiquidationCascade {
+	if x != nil {
+		return x.Liquidation
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetYoungToken() *YoungTokenMode {
+	if x != nil {
+		return x.YoungToken
+	}
+	return nil
+}
+
+func (x *UltimateMemecoinAnalysis) GetSignalStrength() float64 {
+	if x != nil {
+		return x.SignalStrength
+	}
+	return 0
+}
+
+// YoungTokenMode mirrors techindicators.YoungTokenMode
+type YoungTokenMode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candles       int32                  `protobuf:"varint,1,opt,name=candles,proto3" json:"candles,omitempty"`
+	Required      int32                  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	SmaPeriod     int32                  `protobuf:"varint,3,opt,name=sma_period,json=smaPeriod,proto3" json:"sma_period,omitempty"`
+	BbPeriod      int32                  `protobuf:"varint,4,opt,name=bb_period,json=bbPeriod,proto3" json:"bb_period,omitempty"`
+	RsiPeriod     int32                  `protobuf:"varint,5,opt,name=rsi_period,json=rsiPeriod,proto3" json:"rsi_period,omitempty"`
+	VmaPeriod     int32                  `protobuf:"varint,6,opt,name=vma_period,json=vmaPeriod,proto3" json:"vma_period,omitempty"`
+	VrocPeriod    int32                  `protobuf:"varint,7,opt,name=vroc_period,json=vrocPeriod,proto3" json:"vroc_period,omitempty"`
+	SkipBollinger bool                   `protobuf:"varint,8,opt,name=skip_bollinger,json=skipBollinger,proto3" json:"skip_bollinger,omitempty"`
+	Bars          string                 `protobuf:"bytes,9,opt,name=bars,proto3" json:"bars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *YoungTokenMode) Reset() {
+	*x = YoungTokenMode{}
+	mi := &file_techindicators_data_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *YoungTokenMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YoungTokenMode) ProtoMessage() {}
+
+func (x *YoungTokenMode) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YoungTokenMode.ProtoReflect.Descriptor instead.
+func (*YoungTokenMode) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *YoungTokenMode) GetCandles() int32 {
+	if x != nil {
+		return x.Candles
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetSmaPeriod() int32 {
+	if x != nil {
+		return x.SmaPeriod
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetBbPeriod() int32 {
+	if x != nil {
+		return x.BbPeriod
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetRsiPeriod() int32 {
+	if x != nil {
+		return x.RsiPeriod
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetVmaPeriod() int32 {
+	if x != nil {
+		return x.VmaPeriod
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetVrocPeriod() int32 {
+	if x != nil {
+		return x.VrocPeriod
+	}
+	return 0
+}
+
+func (x *YoungTokenMode) GetSkipBollinger() bool {
+	if x != nil {
+		return x.SkipBollinger
+	}
+	return false
+}
+
+func (x *YoungTokenMode) GetBars() string {
+	if x != nil {
+		return x.Bars
+	}
+	return ""
+}
+
+// RugPullReason mirrors techindicators.RugPullReason
+type RugPullReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Severity      float64                `protobuf:"fixed64,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RugPullReason) Reset() {
+	*x = RugPullReason{}
+	mi := &file_techindicators_data_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RugPullReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RugPullReason) ProtoMessage() {}
+
+func (x *RugPullReason) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RugPullReason.ProtoReflect.Descriptor instead.
+func (*RugPullReason) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RugPullReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RugPullReason) GetSeverity() float64 {
+	if x != nil {
+		return x.Severity
+	}
+	return 0
+}
+
+func (x *RugPullReason) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// TradabilityReason mirrors techindicators.TradabilityReason
+type TradabilityReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Detail        string                 `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradabilityReason) Reset() {
+	*x = TradabilityReason{}
+	mi := &file_techindicators_data_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradabilityReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradabilityReason) ProtoMessage() {}
+
+func (x *TradabilityReason) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradabilityReason.ProtoReflect.Descriptor instead.
+func (*TradabilityReason) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TradabilityReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TradabilityReason) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// ManipulationReport mirrors techindicators.ManipulationReport
+type ManipulationReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Covered       int32                  `protobuf:"varint,1,opt,name=covered,proto3" json:"covered,omitempty"`
+	Suspected     bool                   `protobuf:"varint,2,opt,name=suspected,proto3" json:"suspected,omitempty"`
+	Events        []*ManipulationEvent   `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManipulationReport) Reset() {
+	*x = ManipulationReport{}
+	mi := &file_techindicators_data_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManipulationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManipulationReport) ProtoMessage() {}
+
+func (x *ManipulationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManipulationReport.ProtoReflect.Descriptor instead.
+func (*ManipulationReport) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ManipulationReport) GetCovered() int32 {
+	if x != nil {
+		return x.Covered
+	}
+	return 0
+}
+
+func (x *ManipulationReport) GetSuspected() bool {
+	if x != nil {
+		return x.Suspected
+	}
+	return false
+}
+
+func (x *ManipulationReport) GetEvents() []*ManipulationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// LiquidationCascade mirrors techindicators.LiquidationCascade
+type LiquidationCascade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signal        string                 `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	Wick          float64                `protobuf:"fixed64,4,opt,name=wick,proto3" json:"wick,omitempty"`
+	VolumeRatio   float64                `protobuf:"fixed64,5,opt,name=volume_ratio,json=volumeRatio,proto3" json:"volume_ratio,omitempty"`
+	Retrace       float64                `protobuf:"fixed64,6,opt,name=retrace,proto3" json:"retrace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiquidationCascade) Reset() {
+	*x = LiquidationCascade{}
+	mi := &file_techindicators_data_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiquidationCascade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidationCascade) ProtoMessage() {}
+
+func (x *LiquidationCascade) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidationCascade.ProtoReflect.Descriptor instead.
+func (*LiquidationCascade) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LiquidationCascade) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LiquidationCascade) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LiquidationCascade) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *LiquidationCascade) GetWick() float64 {
+	if x != nil {
+		return x.Wick
+	}
+	return 0
+}
+
+func (x *LiquidationCascade) GetVolumeRatio() float64 {
+	if x != nil {
+		return x.VolumeRatio
+	}
+	return 0
+}
+
+func (x *LiquidationCascade) GetRetrace() float64 {
+	if x != nil {
+		return x.Retrace
+	}
+	return 0
+}
+
+// ManipulationEvent mirrors techindicators.ManipulationEvent
+type ManipulationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Move          float64                `protobuf:"fixed64,4,opt,name=move,proto3" json:"move,omitempty"`
+	Trades        int64                  `protobuf:"varint,5,opt,name=trades,proto3" json:"trades,omitempty"`
+	Spread        float64                `protobuf:"fixed64,6,opt,name=spread,proto3" json:"spread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManipulationEvent) Reset() {
+	*x = ManipulationEvent{}
+	mi := &file_techindicators_data_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManipulationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManipulationEvent) ProtoMessage() {}
+
+func (x *ManipulationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_techindicators_data_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManipulationEvent.ProtoReflect.Descriptor instead.
+func (*ManipulationEvent) Descriptor() ([]byte, []int) {
+	return file_techindicators_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ManipulationEvent) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ManipulationEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ManipulationEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ManipulationEvent) GetMove() float64 {
+	if x != nil {
+		return x.Move
+	}
+	return 0
+}
+
+func (x *ManipulationEvent) GetTrades() int64 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *ManipulationEvent) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+var File_techindicators_data_proto protoreflect.FileDescriptor
+
+const file_techindicators_data_proto_rawDesc = "" +
+	"\n" +
+	"\x19techindicators_data.proto\x12\x16techindicators.data.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x01\n" +
+	"\x06Candle\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x04 \x01(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\x05 \x01(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\x12\x16\n" +
+	"\x06trades\x18\a \x01(\x03R\x06trades\x12\x10\n" +
+	"\x03bid\x18\b \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\t \x01(\x01R\x03ask\"\x88\x02\n" +
+	"\fCandleSeries\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12 \n" +
+	"\ftime_unix_ms\x18\x03 \x03(\x03R\n" +
+	"timeUnixMs\x12\x12\n" +
+	"\x04open\x18\x04 \x03(\x01R\x04open\x12\x12\n" +
+	"\x04high\x18\x05 \x03(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x06 \x03(\x01R\x03low\x12\x14\n" +
+	"\x05close\x18\a \x03(\x01R\x05close\x12\x16\n" +
+	"\x06volume\x18\b \x03(\x01R\x06volume\x12\x16\n" +
+	"\x06trades\x18\t \x03(\x03R\x06trades\x12\x10\n" +
+	"\x03bid\x18\n" +
+	" \x03(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\v \x03(\x01R\x03ask\"\xf7\x01\n" +
+	"\x0eIndicatorPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12V\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v26.techindicators.data.v1.IndicatorPoint.ComponentsEntryR\n" +
+	"components\x1a=\n" +
+	"\x0fComponentsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xed\x01\n" +
+	"\x0fIndicatorSeries\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12K\n" +
+	"\x06params\x18\x02 \x03(\v23.techindicators.data.v1.IndicatorSeries.ParamsEntryR\x06params\x12>\n" +
+	"\x06points\x18\x03 \x03(\v2&.techindicators.data.v1.IndicatorPointR\x06points\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"s\n" +
+	"\tRSIResult\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06signal\x18\x03 \x01(\tR\x06signal\"\xc8\x01\n" +
+	"\x0eBollingerBands\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"upper_band\x18\x02 \x01(\x01R\tupperBand\x12\x1f\n" +
+	"\vmiddle_band\x18\x03 \x01(\x01R\n" +
+	"middleBand\x12\x1d\n" +
+	"\n" +
+	"lower_band\x18\x04 \x01(\x01R\tlowerBand\x12\x1d\n" +
+	"\n" +
+	"band_width\x18\x05 \x01(\x01R\tbandWidth\"\xbc\x01\n" +
+	"\fVolumeResult\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\x01R\x06volume\x12\x10\n" +
+	"\x03vma\x18\x03 \x01(\x01R\x03vma\x12\x10\n" +
+	"\x03obv\x18\x04 \x01(\x01R\x03obv\x12\x10\n" +
+	"\x03vpt\x18\x05 \x01(\x01R\x03vpt\x12\x12\n" +
+	"\x04vroc\x18\x06 \x01(\x01R\x04vroc\x12\x10\n" +
+	"\x03adl\x18\a \x01(\x01R\x03adl\"\xa6\x01\n" +
+	"\fContribution\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06signal\x18\x02 \x01(\tR\x06signal\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\x12\x1c\n" +
+	"\tdirection\x18\x05 \x01(\x05R\tdirection\x12\x14\n" +
+	"\x05share\x18\x06 \x01(\x01R\x05share\"\xad\x05\n" +
+	"\x19CombinedTechnicalAnalysis\x12\x1d\n" +
+	"\n" +
+	"sma_signal\x18\x01 \x01(\tR\tsmaSignal\x12)\n" +
+	"\x10bollinger_signal\x18\x02 \x01(\tR\x0fbollingerSignal\x12\x1d\n" +
+	"\n" +
+	"rsi_signal\x18\x03 \x01(\tR\trsiSignal\x12!\n" +
+	"\ffinal_signal\x18\x04 \x01(\tR\vfinalSignal\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\tR\n" +
+	"confidence\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x06 \x01(\tR\triskLevel\x12)\n" +
+	"\x10confidence_score\x18\a \x01(\x01R\x0fconfidenceScore\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\b \x01(\x01R\triskScore\x12h\n" +
+	"\rextra_signals\x18\t \x03(\v2C.techindicators.data.v1.CombinedTechnicalAnalysis.ExtraSignalsEntryR\fextraSignals\x12%\n" +
+	"\x0eweighted_score\x18\n" +
+	" \x01(\x01R\rweightedScore\x12B\n" +
+	"\tbreakdown\x18\v \x03(\v2$.techindicators.data.v1.ContributionR\tbreakdown\x12\x16\n" +
+	"\x06regime\x18\f \x01(\tR\x06regime\x12M\n" +
+	"\vderivatives\x18\r \x01(\v2+.techindicators.data.v1.DerivativesAnalysisR\vderivatives\x1a?\n" +
+	"\x11ExtraSignalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x02\n" +
+	"\x13DerivativesAnalysis\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12#\n" +
+	"\ropen_interest\x18\x02 \x01(\x01R\fopenInterest\x12\x1b\n" +
+	"\toi_change\x18\x03 \x01(\x01R\boiChange\x12!\n" +
+	"\ffunding_rate\x18\x04 \x01(\x01R\vfundingRate\x12)\n" +
+	"\x10weighted_funding\x18\x05 \x01(\x01R\x0fweightedFunding\x12\x1a\n" +
+	"\bcrowding\x18\x06 \x01(\tR\bcrowding\x12\x16\n" +
+	"\x06signal\x18\a \x01(\tR\x06signal\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\"t\n" +
+	"\fVolumeSignal\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\bstrength\x18\x02 \x01(\tR\bstrength\x12\x14\n" +
+	"\x05trend\x18\x03 \x01(\tR\x05trend\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\x01R\n" +
+	"confidence\"\x8e\x04\n" +
+	"\x0eVolumeStrategy\x12>\n" +
+	"\acurrent\x18\x01 \x01(\v2$.techindicators.data.v1.VolumeResultR\acurrent\x12M\n" +
+	"\x0fbreakout_signal\x18\x02 \x01(\v2$.techindicators.data.v1.VolumeSignalR\x0ebreakoutSignal\x12U\n" +
+	"\x13accumulation_signal\x18\x03 \x01(\v2$.techindicators.data.v1.VolumeSignalR\x12accumulationSignal\x12!\n" +
+	"\fvolume_ratio\x18\x04 \x01(\x01R\vvolumeRatio\x12\x1b\n" +
+	"\tobv_trend\x18\x05 \x01(\tR\bobvTrend\x12\x16\n" +
+	"\x06signal\x18\x06 \x01(\tR\x06signal\x12?\n" +
+	"\aquality\x18\a \x01(\v2%.techindicators.data.v1.VolumeQualityR\aquality\x12J\n" +
+	"\vdivergences\x18\b \x03(\v2(.techindicators.data.v1.VolumeDivergenceR\vdivergences\x121\n" +
+	"\x14distribution_warning\x18\t \x01(\bR\x13distributionWarning\"\x82\x03\n" +
+	"\x10VolumeDivergence\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\tR\x04line\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bstrength\x18\x03 \x01(\tR\bstrength\x12>\n" +
+	"\vprice_start\x18\x04 \x01(\v2\x1d.techindicators.data.v1.PivotR\n" +
+	"priceStart\x12:\n" +
+	"\tprice_end\x18\x05 \x01(\v2\x1d.techindicators.data.v1.PivotR\bpriceEnd\x12H\n" +
+	"\x10oscillator_start\x18\x06 \x01(\v2\x1d.techindicators.data.v1.PivotR\x0foscillatorStart\x12D\n" +
+	"\x0eoscillator_end\x18\a \x01(\v2\x1d.techindicators.data.v1.PivotR\roscillatorEnd\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\b \x01(\x01R\n" +
+	"confidence\"m\n" +
+	"\x05Pivot\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\x8f\x01\n" +
+	"\rVolumeQuality\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\x12!\n" +
+	"\fwash_trading\x18\x02 \x01(\bR\vwashTrading\x12E\n" +
+	"\areasons\x18\x03 \x03(\v2+.techindicators.data.v1.VolumeQualityReasonR\areasons\"W\n" +
+	"\x13VolumeQualityReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\xd1\x01\n" +
+	"\x11TimeframeAnalysis\x12\x1f\n" +
+	"\vinterval_ms\x18\x01 \x01(\x03R\n" +
+	"intervalMs\x12\x18\n" +
+	"\acandles\x18\x02 \x01(\x05R\acandles\x12\x16\n" +
+	"\x06signal\x18\x03 \x01(\tR\x06signal\x12M\n" +
+	"\banalysis\x18\x04 \x01(\v21.techindicators.data.v1.CombinedTechnicalAnalysisR\banalysis\x12\x1a\n" +
+	"\bsupplied\x18\x05 \x01(\bR\bsupplied\"\xc2\x01\n" +
+	"\x16MultiTimeframeAnalysis\x12I\n" +
+	"\n" +
+	"timeframes\x18\x01 \x03(\v2).techindicators.data.v1.TimeframeAnalysisR\n" +
+	"timeframes\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\x01R\tdirection\x12'\n" +
+	"\x0falignment_score\x18\x03 \x01(\x01R\x0ealignmentScore\x12\x16\n" +
+	"\x06signal\x18\x04 \x01(\tR\x06signal\"\x8d\b\n" +
+	"\x18UltimateMemecoinAnalysis\x12O\n" +
+	"\ttechnical\x18\x01 \x01(\v21.techindicators.data.v1.CombinedTechnicalAnalysisR\ttechnical\x12>\n" +
+	"\x06volume\x18\x02 \x01(\v2&.techindicators.data.v1.VolumeStrategyR\x06volume\x12!\n" +
+	"\ffinal_signal\x18\x03 \x01(\tR\vfinalSignal\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x04 \x01(\tR\n" +
+	"confidence\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x05 \x01(\tR\triskLevel\x12\"\n" +
+	"\rrug_pull_risk\x18\x06 \x01(\tR\vrugPullRisk\x12%\n" +
+	"\x0evolume_confirm\x18\a \x01(\bR\rvolumeConfirm\x12)\n" +
+	"\x10confidence_score\x18\b \x01(\x01R\x0fconfidenceScore\x12\x1d\n" +
+	"\n" +
+	"risk_score\x18\t \x01(\x01R\triskScore\x12N\n" +
+	"\n" +
+	"timeframes\x18\n" +
+	" \x01(\v2..techindicators.data.v1.MultiTimeframeAnalysisR\n" +
+	"timeframes\x12$\n" +
+	"\x0erug_pull_score\x18\v \x01(\x01R\frugPullScore\x12O\n" +
+	"\x10rug_pull_reasons\x18\f \x03(\v2%.techindicators.data.v1.RugPullReasonR\x0erugPullReasons\x12\x1a\n" +
+	"\bhoneypot\x18\r \x01(\bR\bhoneypot\x12\x1a\n" +
+	"\billiquid\x18\x0e \x01(\bR\billiquid\x12Z\n" +
+	"\x13tradability_reasons\x18\x0f \x03(\v2).techindicators.data.v1.TradabilityReasonR\x12tradabilityReasons\x12N\n" +
+	"\fmanipulation\x18\x10 \x01(\v2*.techindicators.data.v1.ManipulationReportR\fmanipulation\x12L\n" +
+	"\vliquidation\x18\x11 \x01(\v2*.techindicators.data.v1.LiquidationCascadeR\vliquidation\x12G\n" +
+	"\vyoung_token\x18\x12 \x01(\v2&.techindicators.data.v1.YoungTokenModeR\n" +
+	"youngToken\x12'\n" +
+	"\x0fsignal_strength\x18\x13 \x01(\x01R\x0esignalStrength\"\x9c\x02\n" +
+	"\x0eYoungTokenMode\x12\x18\n" +
+	"\acandles\x18\x01 \x01(\x05R\acandles\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\x05R\brequired\x12\x1d\n" +
+	"\n" +
+	"sma_period\x18\x03 \x01(\x05R\tsmaPeriod\x12\x1b\n" +
+	"\tbb_period\x18\x04 \x01(\x05R\bbbPeriod\x12\x1d\n" +
+	"\n" +
+	"rsi_period\x18\x05 \x01(\x05R\trsiPeriod\x12\x1d\n" +
+	"\n" +
+	"vma_period\x18\x06 \x01(\x05R\tvmaPeriod\x12\x1f\n" +
+	"\vvroc_period\x18\a \x01(\x05R\n" +
+	"vrocPeriod\x12%\n" +
+	"\x0eskip_bollinger\x18\b \x01(\bR\rskipBollinger\x12\x12\n" +
+	"\x04bars\x18\t \x01(\tR\x04bars\"W\n" +
+	"\rRugPullReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\x01R\bseverity\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"?\n" +
+	"\x11TradabilityReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06detail\x18\x02 \x01(\tR\x06detail\"\x8f\x01\n" +
+	"\x12ManipulationReport\x12\x18\n" +
+	"\acovered\x18\x01 \x01(\x05R\acovered\x12\x1c\n" +
+	"\tsuspected\x18\x02 \x01(\bR\tsuspected\x12A\n" +
+	"\x06events\x18\x03 \x03(\v2).techindicators.data.v1.ManipulationEventR\x06events\"\xcd\x01\n" +
+	"\x12LiquidationCascade\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06signal\x18\x03 \x01(\tR\x06signal\x12\x12\n" +
+	"\x04wick\x18\x04 \x01(\x01R\x04wick\x12!\n" +
+	"\fvolume_ratio\x18\x05 \x01(\x01R\vvolumeRatio\x12\x18\n" +
+	"\aretrace\x18\x06 \x01(\x01R\aretrace\"\xbb\x01\n" +
+	"\x11ManipulationEvent\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04move\x18\x04 \x01(\x01R\x04move\x12\x16\n" +
+	"\x06trades\x18\x05 \x01(\x03R\x06trades\x12\x16\n" +
+	"\x06spread\x18\x06 \x01(\x01R\x06spreadB2Z0github.com/luislaredovelazquez/techindicators/pbb\x06proto3"
+
+var (
+	file_techindicators_data_proto_rawDescOnce sync.Once
+	file_techindicators_data_proto_rawDescData []byte
+)
+
+func file_techindicators_data_proto_rawDescGZIP() []byte {
+	file_techindicators_data_proto_rawDescOnce.Do(func() {
+		file_techindicators_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_techindicators_data_proto_rawDesc), len(file_techindicators_data_proto_rawDesc)))
+	})
+	return file_techindicators_data_proto_rawDescData
+}
+
+var file_techindicators_data_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_techindicators_data_proto_goTypes = []any{
+	(*Candle)(nil),                    // 0: techindicators.data.v1.Candle
+	(*CandleSeries)(nil),              // 1: techindicators.data.v1.CandleSeries
+	(*IndicatorPoint)(nil),            // 2: techindicators.data.v1.IndicatorPoint
+	(*IndicatorSeries)(nil),           // 3: techindicators.data.v1.IndicatorSeries
+	(*RSIResult)(nil),                 // 4: techindicators.data.v1.RSIResult
+	(*BollingerBands)(nil),            // 5: techindicators.data.v1.BollingerBands
+	(*VolumeResult)(nil),              // 6: techindicators.data.v1.VolumeResult
+	(*Contribution)(nil),              // 7: techindicators.data.v1.Contribution
+	(*CombinedTechnicalAnalysis)(nil), // 8: techindicators.data.v1.CombinedTechnicalAnalysis
+	(*DerivativesAnalysis)(nil),       // 9: techindicators.data.v1.DerivativesAnalysis
+	(*VolumeSignal)(nil),              // 10: techindicators.data.v1.VolumeSignal
+	(*VolumeStrategy)(nil),            // 11: techindicators.data.v1.VolumeStrategy
+	(*VolumeDivergence)(nil),          // 12: techindicators.data.v1.VolumeDivergence
+	(*Pivot)(nil),                     // 13: techindicators.data.v1.Pivot
+	(*VolumeQuality)(nil),             // 14: techindicators.data.v1.VolumeQuality
+	(*VolumeQualityReason)(nil),       // 15: techindicators.data.v1.VolumeQualityReason
+	(*TimeframeAnalysis)(nil),         // 16: techindicators.data.v1.TimeframeAnalysis
+	(*MultiTimeframeAnalysis)(nil),    // 17: techindicators.data.v1.MultiTimeframeAnalysis
+	(*UltimateMemecoinAnalysis)(nil),  // 18: techindicators.data.v1.UltimateMemecoinAnalysis
+	(*YoungTokenMode)(nil),            // 19: techindicators.data.v1.YoungTokenMode
+	(*RugPullReason)(nil),             // 20: techindicators.data.v1.RugPullReason
+	(*TradabilityReason)(nil),         // 21: techindicators.data.v1.TradabilityReason
+	(*ManipulationReport)(nil),        // 22: techindicators.data.v1.ManipulationReport
+	(*LiquidationCascade)(nil),        // 23: techindicators.data.v1.LiquidationCascade
+	(*ManipulationEvent)(nil),         // 24: techindicators.data.v1.ManipulationEvent
+	nil,                               // 25: techindicators.data.v1.IndicatorPoint.ComponentsEntry
+	nil,                               // 26: techindicators.data.v1.IndicatorSeries.ParamsEntry
+	nil,                               // 27: techindicators.data.v1.CombinedTechnicalAnalysis.ExtraSignalsEntry
+	(*timestamppb.Timestamp)(nil),     // 28: google.protobuf.Timestamp
+}
+var file_techindicators_data_proto_depIdxs = []int32{
+	28, // 0: techindicators.data.v1.Candle.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 1: techindicators.data.v1.IndicatorPoint.timestamp:type_name -> google.protobuf.Timestamp
+	25, // 2: techindicators.data.v1.IndicatorPoint.components:type_name -> techindicators.data.v1.IndicatorPoint.ComponentsEntry
+	26, // 3: techindicators.data.v1.IndicatorSeries.params:type_name -> techindicators.data.v1.IndicatorSeries.ParamsEntry
+	2,  // 4: techindicators.data.v1.IndicatorSeries.points:type_name -> techindicators.data.v1.IndicatorPoint
+	28, // 5: techindicators.data.v1.RSIResult.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 6: techindicators.data.v1.BollingerBands.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 7: techindicators.data.v1.VolumeResult.timestamp:type_name -> google.protobuf.Timestamp
+	27, // 8: techindicators.data.v1.CombinedTechnicalAnalysis.extra_signals:type_name -> techindicators.data.v1.CombinedTechnicalAnalysis.ExtraSignalsEntry
+	7,  // 9: techindicators.data.v1.CombinedTechnicalAnalysis.breakdown:type_name -> techindicators.data.v1.Contribution
+	9,  // 10: techindicators.data.v1.CombinedTechnicalAnalysis.derivatives:type_name -> techindicators.data.v1.DerivativesAnalysis
+	28, // 11: techindicators.data.v1.DerivativesAnalysis.timestamp:type_name -> google.protobuf.Timestamp
+	6,  // 12: techindicators.data.v1.VolumeStrategy.current:type_name -> techindicators.data.v1.VolumeResult
+	10, // 13: techindicators.data.v1.VolumeStrategy.breakout_signal:type_name -> techindicators.data.v1.VolumeSignal
+	10, // 14: techindicators.data.v1.VolumeStrategy.accumulation_signal:type_name -> techindicators.data.v1.VolumeSignal
+	14, // 15: techindicators.data.v1.VolumeStrategy.quality:type_name -> techindicators.data.v1.VolumeQuality
+	12, // 16: techindicators.data.v1.VolumeStrategy.divergences:type_name -> techindicators.data.v1.VolumeDivergence
+	13, // 17: techindicators.data.v1.VolumeDivergence.price_start:type_name -> techindicators.data.v1.Pivot
+	13, // 18: techindicators.data.v1.VolumeDivergence.price_end:type_name -> techindicators.data.v1.Pivot
+	13, // 19: techindicators.data.v1.VolumeDivergence.oscillator_start:type_name -> techindicators.data.v1.Pivot
+	13, // 20: techindicators.data.v1.VolumeDivergence.oscillator_end:type_name -> techindicators.data.v1.Pivot
+	28, // 21: techindicators.data.v1.Pivot.timestamp:type_name -> google.protobuf.Timestamp
+	15, // 22: techindicators.data.v1.VolumeQuality.reasons:type_name -> techindicators.data.v1.VolumeQualityReason
+	8,  // 23: techindicators.data.v1.TimeframeAnalysis.analysis:type_name -> techindicators.data.v1.CombinedTechnicalAnalysis
+	16, // 24: techindicators.data.v1.MultiTimeframeAnalysis.timeframes:type_name -> techindicators.data.v1.TimeframeAnalysis
+	8,  // 25: techindicators.data.v1.UltimateMemecoinAnalysis.technical:type_name -> techindicators.data.v1.CombinedTechnicalAnalysis
+	11, // 26: techindicators.data.v1.UltimateMemecoinAnalysis.volume:type_name -> techindicators.data.v1.VolumeStrategy
+	17, // 27: techindicators.data.v1.UltimateMemecoinAnalysis.timeframes:type_name -> techindicators.data.v1.MultiTimeframeAnalysis
+	20, // 28: techindicators.data.v1.UltimateMemecoinAnalysis.rug_pull_reasons:type_name -> techindicators.data.v1.RugPullReason
+	21, // 29: techindicators.data.v1.UltimateMemecoinAnalysis.tradability_reasons:type_name -> techindicators.data.v1.TradabilityReason
+	22, // 30: techindicators.data.v1.UltimateMemecoinAnalysis.manipulation:type_name -> techindicators.data.v1.ManipulationReport
+	23, // 31: techindicators.data.v1.UltimateMemecoinAnalysis.liquidation:type_name -> techindicators.data.v1.LiquidationCascade
+	19, // 32: techindicators.data.v1.UltimateMemecoinAnalysis.young_token:type_name -> techindicators.data.v1.YoungTokenMode
+	24, // 33: techindicators.data.v1.ManipulationReport.events:type_name -> techindicators.data.v1.ManipulationEvent
+	28, // 34: techindicators.data.v1.LiquidationCascade.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 35: techindicators.data.v1.ManipulationEvent.timestamp:type_name -> google.protobuf.Timestamp
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_techindicators_data_proto_init() }
+func file_techindicators_data_proto_init() {
+	if File_techindicators_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_techindicators_data_proto_rawDesc), len(file_techindicators_data_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_techindicators_data_proto_goTypes,
+		DependencyIndexes: file_techindicators_data_proto_depIdxs,
+		MessageInfos:      file_techindicators_data_proto_msgTypes,
+	}.Build()
+	File_techindicators_data_proto = out.File
+	file_techindicators_data_proto_goTypes = nil
+	file_techindicators_data_proto_depIdxs = nil
+}
@@ -0,0 +1,280 @@
+package techindicators
+
+import (
+	"time"
+)
+
+// Pipeline composes dataset transforms and indicators fluently, e.g.
+//
+//	signals, err := NewPipeline(dataset).HeikinAshi().EMA(20).RSI(14).Signals()
+//
+// Each indicator runs on the dataset produced by the transforms before it. Series are memoized, so
+// Series, Table, and Signals share one calculation per indicator. The first error stops the pipeline
+// and is returned by every terminal method.
+type Pipeline struct {
+	cache     *analysisCache
+	priceType PriceType
+	steps     []pipelineStep
+	err       error
+}
+
+// pipelineStep is one indicator bound to the dataset it was added on
+type pipelineStep struct {
+	name   string
+	cache  *analysisCache
+	points func(cache *analysisCache) ([]Point, error)
+	signal func(cache *analysisCache) (Signal, error) // nil if the indicator has no signal
+}
+
+// PipelineSignal is the signal of one pipeline indicator
+type PipelineSignal struct {
+	Name   string `json:"name"`
+	Signal Signal `json:"signal"`
+}
+
+// NewPipeline starts a pipeline on the dataset using close prices
+func NewPipeline(dataset []OHLCV) *Pipeline {
+	p := &Pipeline{cache: newAnalysisCache(dataset), priceType: ClosePrice}
+	if len(dataset) == 0 {
+		p.err = ErrEmptyDataset
+	}
+	return p
+}
+
+// Price sets the price type used by the indicators added after it
+func (p *Pipeline) Price(priceType PriceType) *Pipeline {
+	p.priceType = priceType
+	return p
+}
+
+// Transform replaces the dataset for the steps that follow
+func (p *Pipeline) Transform(transform func([]OHLCV) ([]OHLCV, error)) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+
+	dataset, err := transform(p.cache.dataset)
+	if err != nil {
+		p.err = err
+		return p
+	}
+	if len(dataset) == 0 {
+		p.err = ErrEmptyDataset
+		return p
+	}
+
+	p.cache = newAnalysisCache(dataset)
+	return p
+}
+
+// HeikinAshi converts the dataset to Heikin-Ashi candles
+func (p *Pipeline) HeikinAshi() *Pipeline {
+	return p.Transform(HeikinAshi)
+}
+
+// Resample aggregates the dataset into candles of the given interval
+func (p *Pipeline) Resample(interval time.Duration) *Pipeline {
+	return p.Transform(func(dataset []OHLCV) ([]OHLCV, error) { return Resample(dataset, interval) })
+}
+
+// Normalize sorts the dataset and resolves duplicate timestamps
+func (p *Pipeline) Normalize(duplicates DuplicatePolicy) *Pipeline {
+	return p.Transform(func(dataset []OHLCV) ([]OHLCV, error) { return NormalizeDataset(dataset, duplicates) })
+}
+
+// SMA adds a simple moving average; its signal is the SMA trend used by the comprehensive analysis
+func (p *Pipeline) SMA(period int) *Pipeline {
+	priceType := p.priceType
+	return p.add(SMAIndicator{Period: period}.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			results, err := c.sma(period, priceType)
+			return smaPoints(results), err
+		},
+		func(c *analysisCache) (Signal, error) {
+			if _, err := c.sma(period, priceType); err != nil {
+				return "", err
+			}
+			return smaTrendSignal(c, period, priceType), nil
+		})
+}
+
+// EMA adds an exponential moving average; its signal applies the SMA trend rules to the EMA
+func (p *Pipeline) EMA(period int) *Pipeline {
+	priceType := p.priceType
+	return p.add(EMAIndicator{Period: period}.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			results, err := c.ema(period, priceType)
+			return smaPoints(results), err
+		},
+		func(c *analysisCache) (Signal, error) {
+			if _, err := c.ema(period, priceType); err != nil {
+				return "", err
+			}
+			return movingAverageTrendSignal(c.dataset, period, priceType, c.ema), nil
+		})
+}
+
+// RSI adds the RSI; its signal is the RSI strategy signal
+func (p *Pipeline) RSI(period int) *Pipeline {
+	priceType := p.priceType
+	return p.add(RSIIndicator{Period: period}.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			results, err := c.rsi(period, priceType)
+			return rsiPoints(results), err
+		},
+		func(c *analysisCache) (Signal, error) {
+			strategy, err := analyzeRSIStrategy(c, period, priceType)
+			return strategy.Signal, err
+		})
+}
+
+// Bollinger adds Bollinger Bands; its signal is the Bollinger strategy signal
+func (p *Pipeline) Bollinger(period int, multiplier float64) *Pipeline {
+	priceType := p.priceType
+	return p.add(BollingerIndicator{Period: period, Multiplier: multiplier}.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			results, err := c.bollinger(period, multiplier, priceType)
+			return bollingerPoints(results), err
+		},
+		func(c *analysisCache) (Signal, error) {
+			strategy, err := analyzeBollingerStrategy(c, period, multiplier, priceType)
+			return strategy.Signal, err
+		})
+}
+
+// Volume adds the volume analysis; its signal is the volume strategy signal
+func (p *Pipeline) Volume(vmaPeriod, vrocPeriod int) *Pipeline {
+	return p.add(VolumeIndicator{VMAPeriod: vmaPeriod, VROCPeriod: vrocPeriod}.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			results, err := c.volume(vmaPeriod, vrocPeriod)
+			return volumePoints(results), err
+		},
+		func(c *analysisCache) (Signal, error) {
+			strategy, err := analyzeVolumeStrategy(c, vmaPeriod, vrocPeriod)
+			return strategy.Signal, err
+		})
+}
+
+// Indicator adds any Indicator; it contributes a signal if it implements SignalIndicator
+func (p *Pipeline) Indicator(indicator Indicator) *Pipeline {
+	var signal func(*analysisCache) (Signal, error)
+	if voter, ok := indicator.(SignalIndicator); ok {
+		signal = func(c *analysisCache) (Signal, error) { return voter.Signal(c.dataset) }
+	}
+
+	return p.add(indicator.Name(),
+		func(c *analysisCache) ([]Point, error) {
+			value, err := c.memo("indicator:"+indicator.Name(), func() (any, error) {
+				return indicator.Compute(c.dataset)
+			})
+			if err != nil {
+				return nil, err
+			}
+			return value.([]Point), nil
+		},
+		signal)
+}
+
+// Registered adds an indicator from the registry by name
+func (p *Pipeline) Registered(name string, params IndicatorParams) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+
+	indicator, err := NewIndicator(name, params)
+	if err != nil {
+		p.err = err
+		return p
+	}
+	return p.Indicator(indicator)
+}
+
+// add appends a step bound to the current dataset; adding the same name again replaces the earlier step
+func (p *Pipeline) add(name string, points func(*analysisCache) ([]Point, error), signal func(*analysisCache) (Signal, error)) *Pipeline {
+	if p.err != nil {
+		return p
+	}
+
+	step := pipelineStep{name: name, cache: p.cache, points: points, signal: signal}
+	for i := range p.steps {
+		if p.steps[i].name == name {
+			p.steps[i] = step
+			return p
+		}
+	}
+	p.steps = append(p.steps, step)
+	return p
+}
+
+// Err returns the first error encountered while building the pipeline
+func (p *Pipeline) Err() error {
+	return p.err
+}
+
+// Dataset returns the dataset after all transforms
+func (p *Pipeline) Dataset() ([]OHLCV, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.cache.dataset, nil
+}
+
+// Names returns the indicator names in the order they were added
+func (p *Pipeline) Names() []string {
+	names := make([]string, len(p.steps))
+	for i, step := range p.steps {
+		names[i] = step.name
+	}
+	return names
+}
+
+// Series returns the points of the named indicator
+func (p *Pipeline) Series(name string) ([]Point, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	for _, step := range p.steps {
+		if step.name == name {
+			return step.points(step.cache)
+		}
+	}
+	return nil, invalidParameter("pipeline has no indicator %q", name)
+}
+
+// Table joins every indicator with the final dataset by timestamp
+func (p *Pipeline) Table() (SeriesTable, error) {
+	if p.err != nil {
+		return SeriesTable{}, p.err
+	}
+
+	series := make(map[string][]Point, len(p.steps))
+	for _, step := range p.steps {
+		points, err := step.points(step.cache)
+		if err != nil {
+			return SeriesTable{}, err
+		}
+		series[step.name] = points
+	}
+	return JoinSeries(p.cache.dataset, series), nil
+}
+
+// Signals returns the signal of every indicator that has one, in the order they were added
+func (p *Pipeline) Signals() ([]PipelineSignal, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var signals []PipelineSignal
+	for _, step := range p.steps {
+		if step.signal == nil {
+			continue
+		}
+		signal, err := step.signal(step.cache)
+		if err != nil {
+			return nil, err
+		}
+		signals = append(signals, PipelineSignal{Name: step.name, Signal: signal})
+	}
+	return signals, nil
+}
@@ -0,0 +1,184 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Portfolio represents a weighted basket of assets
+type Portfolio struct {
+	Weights map[string]float64 `json:"weights"` // Target weight per symbol, normalized to sum to 1
+	Assets  map[string][]OHLCV `json:"assets"`  // Candle data per symbol
+}
+
+// EquityPoint represents the portfolio value at a point in time
+type EquityPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"` // Growth of 1 unit invested at the first common candle
+}
+
+// AssetContribution represents how much a single asset drove portfolio return and risk
+type AssetContribution struct {
+	Symbol             string  `json:"symbol"`
+	Weight             float64 `json:"weight"`              // Normalized weight
+	TotalReturn        float64 `json:"total_return"`        // Asset's own return over the period
+	ReturnContribution float64 `json:"return_contribution"` // Sum of weighted per-candle returns
+	RiskContribution   float64 `json:"risk_contribution"`   // Share of portfolio variance (sums to 1)
+}
+
+// PortfolioAnalysis represents combined performance and risk of a portfolio
+type PortfolioAnalysis struct {
+	EquityCurve   []EquityPoint       `json:"equity_curve"`
+	TotalReturn   float64             `json:"total_return"`
+	Volatility    float64             `json:"volatility"`   // Annualized volatility of portfolio returns
+	SharpeRatio   float64             `json:"sharpe_ratio"` // Annualized, assuming a zero risk-free rate
+	MaxDrawdown   float64             `json:"max_drawdown"` // Deepest equity decline (0.3 = 30%)
+	Contributions []AssetContribution `json:"contributions"`
+}
+
+// normalizedWeights validates the portfolio and returns weights scaled to sum to 1
+func (p Portfolio) normalizedWeights() (map[string]float64, error) {
+	if len(p.Weights) == 0 {
+		return nil, invalidParameter("portfolio has no weights")
+	}
+
+	total := 0.0
+	for symbol, weight := range p.Weights {
+		if weight < 0 {
+			return nil, invalidParameter("negative weight for %s", symbol)
+		}
+		if len(p.Assets[symbol]) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrEmptyDataset, symbol)
+		}
+		total += weight
+	}
+
+	if total == 0 {
+		return nil, invalidParameter("portfolio weights sum to zero")
+	}
+
+	weights := make(map[string]float64, len(p.Weights))
+	for symbol, weight := range p.Weights {
+		weights[symbol] = weight / total
+	}
+
+	return weights, nil
+}
+
+// Analyze computes the equity curve, risk, and per-asset contributions of a portfolio rebalanced every candle.
+// annualization is the number of candles per year (365 for daily crypto candles).
+func (p Portfolio) Analyze(annualization float64) (PortfolioAnalysis, error) {
+	if annualization <= 0 {
+		return PortfolioAnalysis{}, invalidParameter("annualization factor must be greater than 0")
+	}
+
+	weights, err := p.normalizedWeights()
+	if err != nil {
+		return PortfolioAnalysis{}, err
+	}
+
+	datasets := make(map[string][]OHLCV, len(weights))
+	for symbol := range weights {
+		datasets[symbol] = p.Assets[symbol]
+	}
+
+	symbols, aligned := alignDatasets(datasets)
+	candles := aligned[symbols[0]]
+	if len(candles) < 3 {
+		return PortfolioAnalysis{}, ErrInsufficientData{Need: 3, Have: len(candles)}
+	}
+
+	// Per-asset and combined returns
+	assetReturns := make(map[string][]float64, len(symbols))
+	portfolioReturns := make([]float64, len(candles)-1)
+	for _, symbol := range symbols {
+		returns, err := ReturnsFromOHLCV(aligned[symbol], 1)
+		if err != nil {
+			return PortfolioAnalysis{}, fmt.Errorf("returns for %s: %w", symbol, err)
+		}
+		assetReturns[symbol] = returns
+
+		for i, r := range returns {
+			portfolioReturns[i] += weights[symbol] * r
+		}
+	}
+
+	// Equity curve and max drawdown
+	equity := 1.0
+	peak := 1.0
+	maxDrawdown := 0.0
+	curve := []EquityPoint{{Timestamp: candles[0].Timestamp, Value: equity}}
+
+	for i, r := range portfolioReturns {
+		equity *= 1 + r
+		curve = append(curve, EquityPoint{
+			Timestamp: candles[i+1].Timestamp,
+			Value:     equity,
+		})
+
+		if equity > peak {
+			peak = equity
+		}
+		if peak != 0 {
+			if drawdown := (peak - equity) / peak; drawdown > maxDrawdown {
+				maxDrawdown = drawdown
+			}
+		}
+	}
+
+	mean := average(portfolioReturns)
+	sd := stdDev(portfolioReturns, mean)
+	variance := sd * sd
+
+	sharpe := 0.0
+	if sd != 0 {
+		sharpe = mean / sd * math.Sqrt(annualization)
+	}
+
+	// Contributions
+	contributions := make([]AssetContribution, 0, len(symbols))
+	for _, symbol := range symbols {
+		returns := assetReturns[symbol]
+		first := aligned[symbol][0].Close
+		last := aligned[symbol][len(candles)-1].Close
+
+		returnContribution := 0.0
+		for _, r := range returns {
+			returnContribution += weights[symbol] * r
+		}
+
+		riskContribution := 0.0
+		if variance != 0 {
+			assetMean := average(returns)
+			covariance := 0.0
+			for i := range returns {
+				covariance += (returns[i] - assetMean) * (portfolioReturns[i] - mean)
+			}
+			covariance /= float64(len(returns) - 1)
+			riskContribution = weights[symbol] * covariance / variance
+		}
+
+		totalReturn := 0.0
+		if first != 0 {
+			totalReturn = (last - first) / first
+		}
+
+		contributions = append(contributions, AssetContribution{
+			Symbol:             symbol,
+			Weight:             weights[symbol],
+			TotalReturn:        totalReturn,
+			ReturnContribution: returnContribution,
+			RiskContribution:   riskContribution,
+		})
+	}
+
+	return PortfolioAnalysis{
+		EquityCurve:   curve,
+		TotalReturn:   equity - 1,
+		Volatility:    sd * math.Sqrt(annualization),
+		SharpeRatio:   sharpe,
+		MaxDrawdown:   maxDrawdown,
+		Contributions: contributions,
+	}, nil
+}
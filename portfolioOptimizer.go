@@ -0,0 +1,314 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+)
+
+// OptimizationMethod selects how portfolio weights are derived
+type OptimizationMethod string
+
+const (
+	InverseVolatilityWeights OptimizationMethod = "inverse_volatility" // Weight each coin by 1 / volatility
+	RiskParityWeights        OptimizationMethod = "risk_parity"        // Equalize each coin's contribution to portfolio variance
+	MeanVarianceWeights      OptimizationMethod = "mean_variance"      // Maximize return - riskAversion/2 * variance
+)
+
+// OptimizerConfig configures the weight optimizer
+type OptimizerConfig struct {
+	Method        OptimizationMethod `json:"method"`
+	MaxWeight     float64            `json:"max_weight"`    // Maximum weight per coin (0 = no limit)
+	RiskAversion  float64            `json:"risk_aversion"` // Mean-variance risk aversion (default 1)
+	Annualization float64            `json:"annualization"` // Candles per year (default 365)
+}
+
+// OptimizedWeights represents suggested portfolio weights and their expected characteristics
+type OptimizedWeights struct {
+	Weights           map[string]float64 `json:"weights"`
+	Method            OptimizationMethod `json:"method"`
+	ExpectedReturn    float64            `json:"expected_return"`    // Annualized mean return
+	Volatility        float64            `json:"volatility"`         // Annualized volatility
+	RiskContributions map[string]float64 `json:"risk_contributions"` // Share of portfolio variance per coin
+}
+
+// OptimizeWeights suggests long-only portfolio weights for a set of coins using their aligned returns
+func OptimizeWeights(datasets map[string][]OHLCV, config OptimizerConfig) (OptimizedWeights, error) {
+	if len(datasets) < 2 {
+		return OptimizedWeights{}, invalidParameter("at least 2 assets are required")
+	}
+
+	if config.RiskAversion <= 0 {
+		config.RiskAversion = 1
+	}
+	if config.Annualization <= 0 {
+		config.Annualization = 365
+	}
+
+	maxWeight := config.MaxWeight
+	if maxWeight <= 0 || maxWeight > 1 {
+		maxWeight = 1
+	}
+	if maxWeight*float64(len(datasets)) < 1 {
+		return OptimizedWeights{}, invalidParameter("max weight %.4f is infeasible for %d assets", maxWeight, len(datasets))
+	}
+
+	symbols, aligned := alignDatasets(datasets)
+	if len(aligned[symbols[0]]) < 3 {
+		return OptimizedWeights{}, ErrInsufficientData{Need: 3, Have: len(aligned[symbols[0]])}
+	}
+
+	returns := make([][]float64, len(symbols))
+	for i, symbol := range symbols {
+		series, err := ReturnsFromOHLCV(aligned[symbol], 1)
+		if err != nil {
+			return OptimizedWeights{}, fmt.Errorf("returns for %s: %w", symbol, err)
+		}
+		returns[i] = series
+	}
+
+	means, cov := covarianceMatrix(returns)
+
+	var weights []float64
+	switch config.Method {
+	case InverseVolatilityWeights:
+		weights = inverseVolatility(cov)
+		weights = capWeights(weights, maxWeight)
+	case RiskParityWeights:
+		weights = riskParity(cov, maxWeight)
+	case MeanVarianceWeights:
+		weights = meanVariance(means, cov, config.RiskAversion, maxWeight)
+	default:
+		return OptimizedWeights{}, invalidParameter("unknown optimization method: %s", config.Method)
+	}
+
+	// Summarize the resulting portfolio
+	expected := 0.0
+	for i := range weights {
+		expected += weights[i] * means[i]
+	}
+
+	marginal := matVec(cov, weights)
+	variance := 0.0
+	for i := range weights {
+		variance += weights[i] * marginal[i]
+	}
+
+	result := OptimizedWeights{
+		Weights:           make(map[string]float64, len(symbols)),
+		Method:            config.Method,
+		ExpectedReturn:    expected * config.Annualization,
+		Volatility:        math.Sqrt(variance * config.Annualization),
+		RiskContributions: make(map[string]float64, len(symbols)),
+	}
+
+	for i, symbol := range symbols {
+		result.Weights[symbol] = weights[i]
+		if variance != 0 {
+			result.RiskContributions[symbol] = weights[i] * marginal[i] / variance
+		}
+	}
+
+	return result, nil
+}
+
+// covarianceMatrix computes mean returns and the sample covariance matrix of equally sized series
+func covarianceMatrix(returns [][]float64) ([]float64, [][]float64) {
+	n := len(returns)
+	means := make([]float64, n)
+	for i, series := range returns {
+		means[i] = average(series)
+	}
+
+	cov := make([][]float64, n)
+	for i := range cov {
+		cov[i] = make([]float64, n)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sum := 0.0
+			for k := range returns[i] {
+				sum += (returns[i][k] - means[i]) * (returns[j][k] - means[j])
+			}
+			cov[i][j] = sum / float64(len(returns[i])-1)
+			cov[j][i] = cov[i][j]
+		}
+	}
+
+	return means, cov
+}
+
+// matVec multiplies a square matrix by a vector
+func matVec(m [][]float64, v []float64) []float64 {
+	out := make([]float64, len(v))
+	for i := range m {
+		for j := range v {
+			out[i] += m[i][j] * v[j]
+		}
+	}
+	return out
+}
+
+// inverseVolatility weights each asset by the inverse of its standard deviation
+func inverseVolatility(cov [][]float64) []float64 {
+	weights := make([]float64, len(cov))
+	total := 0.0
+	for i := range cov {
+		if cov[i][i] > 0 {
+			weights[i] = 1 / math.Sqrt(cov[i][i])
+		}
+		total += weights[i]
+	}
+
+	// Fall back to equal weights when no asset has variance
+	for i := range weights {
+		if total == 0 {
+			weights[i] = 1 / float64(len(weights))
+		} else {
+			weights[i] /= total
+		}
+	}
+
+	return weights
+}
+
+// riskParity iteratively rescales weights until each asset contributes equally to portfolio variance
+func riskParity(cov [][]float64, maxWeight float64) []float64 {
+	weights := inverseVolatility(cov)
+
+	for iter := 0; iter < 500; iter++ {
+		marginal := matVec(cov, weights)
+		variance := 0.0
+		for i := range weights {
+			variance += weights[i] * marginal[i]
+		}
+		if variance == 0 {
+			break
+		}
+
+		target := variance / float64(len(weights))
+		maxChange := 0.0
+		total := 0.0
+		for i := range weights {
+			contribution := weights[i] * marginal[i]
+			if contribution <= 0 {
+				continue
+			}
+			updated := weights[i] * math.Sqrt(target/contribution) // Damped update for stable convergence
+			maxChange = math.Max(maxChange, math.Abs(updated-weights[i]))
+			weights[i] = updated
+			total += updated
+		}
+
+		for i := range weights {
+			weights[i] /= total
+		}
+		weights = capWeights(weights, maxWeight)
+
+		if maxChange < 1e-10 {
+			break
+		}
+	}
+
+	return weights
+}
+
+// meanVariance maximizes w·μ - riskAversion/2 * w'Σw over capped long-only weights using projected gradient ascent
+func meanVariance(means []float64, cov [][]float64, riskAversion, maxWeight float64) []float64 {
+	n := len(means)
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 / float64(n)
+	}
+
+	// Step size from the largest diagonal keeps the ascent stable
+	scale := 0.0
+	for i := range cov {
+		scale = math.Max(scale, riskAversion*cov[i][i]*float64(n))
+	}
+	if scale == 0 {
+		scale = 1
+	}
+	step := 1 / scale
+
+	for iter := 0; iter < 1000; iter++ {
+		marginal := matVec(cov, weights)
+		candidate := make([]float64, n)
+		for i := range weights {
+			candidate[i] = weights[i] + step*(means[i]-riskAversion*marginal[i])
+		}
+		candidate = projectCappedSimplex(candidate, maxWeight)
+
+		maxChange := 0.0
+		for i := range weights {
+			maxChange = math.Max(maxChange, math.Abs(candidate[i]-weights[i]))
+		}
+		weights = candidate
+
+		if maxChange < 1e-12 {
+			break
+		}
+	}
+
+	return weights
+}
+
+// capWeights limits each weight to maxWeight, redistributing the excess proportionally among uncapped weights
+func capWeights(weights []float64, maxWeight float64) []float64 {
+	for iter := 0; iter < len(weights); iter++ {
+		excess := 0.0
+		uncapped := 0.0
+		for i := range weights {
+			if weights[i] > maxWeight {
+				excess += weights[i] - maxWeight
+				weights[i] = maxWeight
+			} else if weights[i] < maxWeight {
+				uncapped += weights[i]
+			}
+		}
+
+		if excess == 0 || uncapped == 0 {
+			break
+		}
+
+		for i := range weights {
+			if weights[i] < maxWeight {
+				weights[i] += excess * weights[i] / uncapped
+			}
+		}
+	}
+
+	return weights
+}
+
+// projectCappedSimplex projects values onto {w : 0 <= w <= maxWeight, sum(w) = 1}
+func projectCappedSimplex(values []float64, maxWeight float64) []float64 {
+	clipped := func(tau float64) ([]float64, float64) {
+		out := make([]float64, len(values))
+		sum := 0.0
+		for i, v := range values {
+			out[i] = math.Min(math.Max(v-tau, 0), maxWeight)
+			sum += out[i]
+		}
+		return out, sum
+	}
+
+	// Bisection on the shift tau so the clipped weights sum to 1
+	lo, hi := -1.0, 1.0
+	for _, v := range values {
+		lo = math.Min(lo, v-maxWeight)
+		hi = math.Max(hi, v)
+	}
+
+	for iter := 0; iter < 100; iter++ {
+		mid := (lo + hi) / 2
+		if _, sum := clipped(mid); sum > 1 {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	out, _ := clipped((lo + hi) / 2)
+	return out
+}
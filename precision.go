@@ -0,0 +1,132 @@
+package techindicators
+
+import "math/big"
+
+// Arithmetic selects the numeric backend used by the core indicators (SMA and Bollinger Bands)
+type Arithmetic string
+
+const (
+	Float64Arithmetic  Arithmetic = "float64"   // Native float64 math (default, fastest)
+	BigFloatArithmetic Arithmetic = "big_float" // 128-bit big.Float sums, for micro-priced memecoins
+)
+
+// bigFloatPrecision is the mantissa size in bits used by BigFloatArithmetic
+const bigFloatPrecision = 128
+
+// newBigFloat returns a zero big.Float with the high-precision mantissa
+func newBigFloat() *big.Float {
+	return new(big.Float).SetPrec(bigFloatPrecision)
+}
+
+// CalculateSMAPrecise calculates the Simple Moving Average with 128-bit window sums.
+// Results match CalculateSMA but avoid float64 rounding on long series of very small prices.
+func CalculateSMAPrecise(dataset []OHLCV, period int, priceType PriceType) ([]SMAResult, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	if period > len(dataset) {
+		return nil, ErrInsufficientData{Need: period, Have: len(dataset)}
+	}
+
+	results := make([]SMAResult, 0, len(dataset)-period+1)
+	sum := newBigFloat()
+	price := newBigFloat()
+	avg := newBigFloat()
+	divisor := newBigFloat().SetInt64(int64(period))
+
+	for i := 0; i < len(dataset); i++ {
+		sum.Add(sum, price.SetFloat64(dataset[i].ExtractPrice(priceType)))
+		if i >= period {
+			sum.Sub(sum, price.SetFloat64(dataset[i-period].ExtractPrice(priceType)))
+		}
+		if i < period-1 {
+			continue
+		}
+
+		value, _ := avg.Quo(sum, divisor).Float64()
+		results = append(results, SMAResult{
+			Timestamp: dataset[i].Timestamp,
+			Value:     value,
+		})
+	}
+
+	return results, nil
+}
+
+// CalculateBollingerBandsPrecise calculates Bollinger Bands with 128-bit running sums of prices and squared prices.
+// The extra precision keeps the variance exact enough that band widths of tiny, nearly flat prices stay meaningful.
+func CalculateBollingerBandsPrecise(dataset []OHLCV, period int, multiplier float64, priceType PriceType) ([]BollingerBands, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	if period > len(dataset) {
+		return nil, ErrInsufficientData{Need: period, Have: len(dataset)}
+	}
+
+	if multiplier <= 0 {
+		return nil, invalidParameter("multiplier must be greater than 0")
+	}
+
+	results := make([]BollingerBands, 0, len(dataset)-period+1)
+	sum, sumSquares := newBigFloat(), newBigFloat()
+	price, square := newBigFloat(), newBigFloat()
+	mean, variance := newBigFloat(), newBigFloat()
+	width, band := newBigFloat(), newBigFloat()
+	n := newBigFloat().SetInt64(int64(period))
+	k := newBigFloat().SetFloat64(multiplier)
+
+	for i := 0; i < len(dataset); i++ {
+		price.SetFloat64(dataset[i].ExtractPrice(priceType))
+		sum.Add(sum, price)
+		sumSquares.Add(sumSquares, square.Mul(price, price))
+
+		if i >= period {
+			price.SetFloat64(dataset[i-period].ExtractPrice(priceType))
+			sum.Sub(sum, price)
+			sumSquares.Sub(sumSquares, square.Mul(price, price))
+		}
+		if i < period-1 {
+			continue
+		}
+
+		// Population variance: E[x²] - E[x]²
+		mean.Quo(sum, n)
+		variance.Quo(sumSquares, n)
+		variance.Sub(variance, square.Mul(mean, mean))
+		if variance.Sign() < 0 {
+			variance.SetInt64(0)
+		}
+
+		// Band half-width: multiplier * standard deviation
+		width.Mul(k, band.Sqrt(variance))
+
+		middle, _ := mean.Float64()
+		upper, _ := band.Add(mean, width).Float64()
+		lower, _ := band.Sub(mean, width).Float64()
+
+		bandWidth := 0.0
+		if mean.Sign() != 0 {
+			bandWidth, _ = band.Quo(band.Add(width, width), mean).Float64()
+		}
+
+		results = append(results, BollingerBands{
+			Timestamp:  dataset[i].Timestamp,
+			UpperBand:  upper,
+			MiddleBand: middle,
+			LowerBand:  lower,
+			BandWidth:  bandWidth,
+		})
+	}
+
+	return results, nil
+}
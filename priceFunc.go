@@ -0,0 +1,38 @@
+package techindicators
+
+import "sync"
+
+// PriceFunc extracts a user-defined price from a candle (e.g. a mid price or a volume-weighted price)
+type PriceFunc func(OHLCV) float64
+
+// firstCustomPriceType leaves room for future built-in price types
+const firstCustomPriceType PriceType = 1000
+
+var (
+	customPricesMu sync.RWMutex
+	customPrices   = make(map[PriceType]PriceFunc)
+)
+
+// CustomPrice registers a PriceFunc and returns a PriceType for it, usable anywhere a PriceType is accepted:
+//
+//	midBody := CustomPrice(func(c OHLCV) float64 { return (c.Open + c.Close) / 2 })
+//	sma, err := CalculateSMA(dataset, 20, midBody)
+//
+// Register each function once (e.g. in a package-level var) and reuse the returned PriceType.
+func CustomPrice(fn PriceFunc) PriceType {
+	customPricesMu.Lock()
+	defer customPricesMu.Unlock()
+
+	priceType := firstCustomPriceType + PriceType(len(customPrices))
+	customPrices[priceType] = fn
+	return priceType
+}
+
+// customPrice returns the registered PriceFunc for a custom PriceType
+func customPrice(priceType PriceType) (PriceFunc, bool) {
+	customPricesMu.RLock()
+	defer customPricesMu.RUnlock()
+
+	fn, ok := customPrices[priceType]
+	return fn, ok
+}
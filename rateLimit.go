@@ -0,0 +1,177 @@
+package techindicators
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket allowing a burst of its full request budget, refilled evenly over the period.
+// It is safe for concurrent use.
+type RateLimiter struct {
+	interval time.Duration // Time to refill one token
+	burst    float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter allows requests calls per period, e.g. NewRateLimiter(30, time.Minute)
+func NewRateLimiter(requests int, per time.Duration) (*RateLimiter, error) {
+	if requests <= 0 || per <= 0 {
+		return nil, invalidParameter("rate limit needs positive requests and period, got %d per %s", requests, per)
+	}
+	return &RateLimiter{
+		interval: per / time.Duration(requests),
+		burst:    float64(requests),
+		tokens:   float64(requests),
+		last:     time.Now(),
+	}, nil
+}
+
+// Wait blocks until a call is allowed or the context ends
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+	l.last = now
+	l.tokens-- // Reserve a token now, so waiting callers are served in order
+	delay := time.Duration(-l.tokens * float64(l.interval))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++ // Give the reservation back
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// RetryTransport is an http.RoundTripper that throttles requests through a RateLimiter and retries
+// 429 and 5xx responses and network errors with exponential backoff and jitter, honouring Retry-After.
+// Requests with a body are only retried when it can be replayed (GetBody is set).
+type RetryTransport struct {
+	Base       http.RoundTripper // Defaults to http.DefaultTransport
+	Limiter    *RateLimiter      // Optional; every attempt, including retries, takes a token
+	MaxRetries int               // Retries after the first attempt (default 3, negative disables)
+	BaseDelay  time.Duration     // First backoff (default 1 second), doubled on each retry
+	MaxDelay   time.Duration     // Backoff and Retry-After cap (default 30 seconds)
+}
+
+// RoundTrip sends the request, retrying transient failures
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	retries := t.MaxRetries
+	if retries == 0 {
+		retries = 3
+	}
+	baseDelay, maxDelay := t.BaseDelay, t.MaxDelay
+	if baseDelay <= 0 {
+		baseDelay = time.Second
+	}
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		if t.Limiter != nil {
+			if err := t.Limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(ctx)
+			req.Body = body
+		}
+
+		resp, err := base.RoundTrip(req)
+		if attempt >= retries || !replayable || ctx.Err() != nil || (err == nil && !retryableStatus(resp.StatusCode)) {
+			return resp, err
+		}
+
+		delay := backoffDelay(baseDelay, maxDelay, attempt)
+		delay = delay/2 + rand.N(delay/2+1) // Jitter so throttled clients do not retry in lockstep
+		if err == nil {
+			if after, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = min(after, maxDelay)
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// backoffDelay doubles the base delay per attempt up to maxDelay, stopping once it is reached so many
+// retries cannot overflow the shift
+func backoffDelay(baseDelay, maxDelay time.Duration, attempt int) time.Duration {
+	delay := min(baseDelay, maxDelay)
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		if delay > maxDelay/2 {
+			return maxDelay
+		}
+		delay *= 2
+	}
+	return delay
+}
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500 && code != http.StatusNotImplemented
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// NewRetryingHTTPClient returns an HTTP client that throttles to requests per period and retries transient
+// failures. Each attempt waits at most 30 seconds for the response headers; there is no overall timeout, so
+// bound the total time with the request context.
+func NewRetryingHTTPClient(requests int, per time.Duration) (*http.Client, error) {
+	limiter, err := NewRateLimiter(requests, per)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = 30 * time.Second
+	return &http.Client{Transport: &RetryTransport{Base: transport, Limiter: limiter}}, nil
+}
@@ -0,0 +1,26 @@
+package techindicators
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayDoesNotOverflow(t *testing.T) {
+	for _, tc := range []struct {
+		base, max time.Duration
+		attempt   int
+		want      time.Duration
+	}{
+		{time.Second, 30 * time.Second, 0, time.Second},
+		{time.Second, 30 * time.Second, 3, 8 * time.Second},
+		{time.Second, 30 * time.Second, 5, 30 * time.Second},
+		{time.Second, 30 * time.Second, 40, 30 * time.Second},
+		{time.Second, math.MaxInt64, 100, math.MaxInt64},
+		{time.Minute, time.Second, 2, time.Second},
+	} {
+		if got := backoffDelay(tc.base, tc.max, tc.attempt); got != tc.want {
+			t.Errorf("backoffDelay(%v, %v, %d) = %v; want %v", tc.base, tc.max, tc.attempt, got, tc.want)
+		}
+	}
+}
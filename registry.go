@@ -0,0 +1,197 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// IndicatorParams holds the numeric parameters used to construct a registered indicator, e.g. {"period": 20}
+type IndicatorParams map[string]float64
+
+// Int returns the parameter as an int, or def when it is not set
+func (p IndicatorParams) Int(key string, def int) int {
+	if v, ok := p[key]; ok {
+		return int(math.Round(v))
+	}
+	return def
+}
+
+// Float returns the parameter, or def when it is not set
+func (p IndicatorParams) Float(key string, def float64) float64 {
+	if v, ok := p[key]; ok {
+		return v
+	}
+	return def
+}
+
+// IndicatorFactory builds an Indicator from parameters; missing parameters take the indicator's defaults
+type IndicatorFactory func(params IndicatorParams) (Indicator, error)
+
+// SignalIndicator is an Indicator that can also vote in the comprehensive analysis
+type SignalIndicator interface {
+	Indicator
+	// Signal returns the indicator's current signal for the dataset
+	Signal(dataset []OHLCV) (Signal, error)
+}
+
+// IndicatorRegistration describes a registered indicator
+type IndicatorRegistration struct {
+	Name        string
+	Description string
+	Params      IndicatorParams // Default parameters, also used to list the accepted keys
+	Factory     IndicatorFactory
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]IndicatorRegistration)
+)
+
+// RegisterIndicator adds an indicator to the registry so it can be created by name with NewIndicator
+// and listed by tooling. Registering an existing name replaces it.
+func RegisterIndicator(registration IndicatorRegistration) error {
+	if registration.Name == "" {
+		return invalidParameter("indicator name is required")
+	}
+	if registration.Factory == nil {
+		return invalidParameter("indicator %q has no factory", registration.Name)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[registration.Name] = registration
+	return nil
+}
+
+// NewIndicator creates a registered indicator by name; params override the registered defaults
+func NewIndicator(name string, params IndicatorParams) (Indicator, error) {
+	registration, ok := LookupIndicator(name)
+	if !ok {
+		return nil, invalidParameter("unknown indicator: %q", name)
+	}
+
+	merged := make(IndicatorParams, len(registration.Params)+len(params))
+	for k, v := range registration.Params {
+		merged[k] = v
+	}
+	for k, v := range params {
+		merged[k] = v
+	}
+	return registration.Factory(merged)
+}
+
+// LookupIndicator returns the registration for name
+func LookupIndicator(name string) (IndicatorRegistration, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	registration, ok := registry[name]
+	return registration, ok
+}
+
+// RegisteredIndicators returns every registration sorted by name
+func RegisteredIndicators() []IndicatorRegistration {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	registrations := make([]IndicatorRegistration, 0, len(registry))
+	for _, registration := range registry {
+		registrations = append(registrations, registration)
+	}
+	sort.Slice(registrations, func(a, b int) bool { return registrations[a].Name < registrations[b].Name })
+	return registrations
+}
+
+// init registers the built-in indicator adapters
+func init() {
+	builtins := []IndicatorRegistration{
+		{
+			Name:        "sma",
+			Description: "Simple moving average",
+			Params:      IndicatorParams{"period": 20, "price_type": float64(ClosePrice)},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return SMAIndicator{Period: p.Int("period", 20), PriceType: PriceType(p.Int("price_type", 0))}, nil
+			},
+		},
+		{
+			Name:        "ema",
+			Description: "Exponential moving average",
+			Params:      IndicatorParams{"period": 20, "price_type": float64(ClosePrice)},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return EMAIndicator{Period: p.Int("period", 20), PriceType: PriceType(p.Int("price_type", 0))}, nil
+			},
+		},
+		{
+			Name:        "rsi",
+			Description: "Relative strength index",
+			Params:      IndicatorParams{"period": 14, "price_type": float64(ClosePrice)},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return RSIIndicator{Period: p.Int("period", 14), PriceType: PriceType(p.Int("price_type", 0))}, nil
+			},
+		},
+		{
+			Name:        "bollinger",
+			Description: "Bollinger Bands (middle band with upper, lower, and band width components)",
+			Params:      IndicatorParams{"period": 20, "multiplier": 2, "price_type": float64(ClosePrice)},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return BollingerIndicator{
+					Period:     p.Int("period", 20),
+					Multiplier: p.Float("multiplier", 2),
+					PriceType:  PriceType(p.Int("price_type", 0)),
+				}, nil
+			},
+		},
+		{
+			Name:        "volume",
+			Description: "Volume moving average with OBV, VPT, VROC, and ADL components",
+			Params:      IndicatorParams{"vma_period": 20, "vroc_period": 5},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return VolumeIndicator{VMAPeriod: p.Int("vma_period", 20), VROCPeriod: p.Int("vroc_period", 5)}, nil
+			},
+		},
+		{
+			Name:        "adx",
+			Description: "Average directional index with +DI and -DI components",
+			Params:      IndicatorParams{"period": 14},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return ADXIndicator{Period: p.Int("period", 14)}, nil
+			},
+		},
+		{
+			Name:        "ulcer",
+			Description: "Ulcer Index",
+			Params:      IndicatorParams{"period": 14, "price_type": float64(ClosePrice)},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return UlcerIndexIndicator{Period: p.Int("period", 14), PriceType: PriceType(p.Int("price_type", 0))}, nil
+			},
+		},
+		{
+			Name:        "sharpe",
+			Description: "Rolling Sharpe ratio",
+			Params:      IndicatorParams{"window": 30, "risk_free": 0},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return SharpeIndicator{Window: p.Int("window", 30), RiskFree: p.Float("risk_free", 0)}, nil
+			},
+		},
+	}
+
+	for _, estimator := range []VolatilityEstimator{CloseToCloseEstimator, ParkinsonEstimator, GarmanKlassEstimator} {
+		builtins = append(builtins, IndicatorRegistration{
+			Name:        "volatility_" + string(estimator),
+			Description: "Annualized rolling volatility (" + string(estimator) + " estimator)",
+			Params:      IndicatorParams{"window": 20, "annualization": 365},
+			Factory: func(p IndicatorParams) (Indicator, error) {
+				return VolatilityIndicator{
+					Window:        p.Int("window", 20),
+					Annualization: p.Float("annualization", 365),
+					Estimator:     estimator,
+				}, nil
+			},
+		})
+	}
+
+	for _, registration := range builtins {
+		registry[registration.Name] = registration
+	}
+}
@@ -0,0 +1,92 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resample aggregates candles into a coarser timeframe (e.g. 1m into 5m, 15m, 1h, or 1d).
+// Each output candle covers [start, start+interval) with start aligned to the interval in UTC,
+// taking the first open, max high, min low, last close, and summed volume of its candles.
+// Timestamps must be strictly increasing; run NormalizeDataset first for unordered feeds.
+func Resample(dataset []OHLCV, interval time.Duration) ([]OHLCV, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if interval <= 0 {
+		return nil, invalidParameter("interval must be greater than 0")
+	}
+
+	var resampled []OHLCV
+	for i, candle := range dataset {
+		if i > 0 && !candle.Timestamp.After(dataset[i-1].Timestamp) {
+			return nil, fmt.Errorf("%w: timestamp at index %d is not after the previous candle", ErrInvalidDataset, i)
+		}
+
+		start := candle.Timestamp.UTC().Truncate(interval)
+		last := len(resampled) - 1
+		if last < 0 || !resampled[last].Timestamp.Equal(start) {
+			candle.Timestamp = start
+			resampled = append(resampled, candle)
+			continue
+		}
+
+		bucket := &resampled[last]
+		bucket.High = math.Max(bucket.High, candle.High)
+		bucket.Low = math.Min(bucket.Low, candle.Low)
+		bucket.Close = candle.Close
+		bucket.Volume += candle.Volume
+	}
+
+	return resampled, nil
+}
+
+// ParseInterval parses a candle interval such as "15m", "4h", "1d", or "1w". Besides Go durations it
+// accepts day (d) and week (w) suffixes.
+func ParseInterval(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit > 0 {
+		count, err := strconv.Atoi(strings.TrimSpace(s[:len(s)-1]))
+		if err != nil || count <= 0 {
+			return 0, invalidParameter("invalid interval %q", s)
+		}
+		return time.Duration(count) * unit, nil
+	}
+
+	interval, err := time.ParseDuration(s)
+	if err != nil || interval <= 0 {
+		return 0, invalidParameter("invalid interval %q", s)
+	}
+	return interval, nil
+}
+
+// FormatInterval formats an interval in the short form ParseInterval reads, e.g. "15m", "4h", "1d", or "1w"
+func FormatInterval(interval time.Duration) string {
+	units := []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{7 * 24 * time.Hour, "w"},
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+		{time.Second, "s"},
+	}
+	for _, unit := range units {
+		if interval > 0 && interval%unit.size == 0 {
+			return strconv.FormatInt(int64(interval/unit.size), 10) + unit.suffix
+		}
+	}
+	return interval.String()
+}
@@ -0,0 +1,147 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+)
+
+// ReturnsFromOHLCV calculates simple close-to-close returns spaced period candles apart.
+// Use period 1 for consecutive candles; the result has len(dataset)-period values.
+func ReturnsFromOHLCV(dataset []OHLCV, period int) ([]float64, error) {
+	if err := validateReturnsParams(dataset, period); err != nil {
+		return nil, err
+	}
+
+	returns := make([]float64, 0, len(dataset)-period)
+	for i := period; i < len(dataset); i++ {
+		prev := dataset[i-period].Close
+		if prev == 0 {
+			return nil, invalidPrice("zero close price at index %d", i-period)
+		}
+		returns = append(returns, (dataset[i].Close-prev)/prev)
+	}
+
+	return returns, nil
+}
+
+// LogReturnsFromOHLCV calculates close-to-close log returns spaced period candles apart.
+// Use period 1 for consecutive candles; the result has len(dataset)-period values.
+func LogReturnsFromOHLCV(dataset []OHLCV, period int) ([]float64, error) {
+	if err := validateReturnsParams(dataset, period); err != nil {
+		return nil, err
+	}
+
+	returns := make([]float64, 0, len(dataset)-period)
+	for i := period; i < len(dataset); i++ {
+		prev := dataset[i-period].Close
+		curr := dataset[i].Close
+		if prev <= 0 || curr <= 0 {
+			return nil, invalidPrice("non-positive close price at index %d", i)
+		}
+		returns = append(returns, math.Log(curr/prev))
+	}
+
+	return returns, nil
+}
+
+// validateReturnsParams checks the common inputs of the returns helpers
+func validateReturnsParams(dataset []OHLCV, period int) error {
+	if len(dataset) == 0 {
+		return ErrEmptyDataset
+	}
+
+	if period <= 0 {
+		return invalidPeriod("period must be greater than 0")
+	}
+
+	if len(dataset) <= period {
+		return ErrInsufficientData{Need: period + 1, Have: len(dataset)}
+	}
+
+	return nil
+}
+
+// HistogramBin represents a single bucket of a returns histogram
+type HistogramBin struct {
+	Lower     float64 `json:"lower"`
+	Upper     float64 `json:"upper"`
+	Count     int     `json:"count"`
+	Frequency float64 `json:"frequency"` // Count / total observations
+}
+
+// ReturnsDistribution represents a histogram and percentile report of historical returns
+type ReturnsDistribution struct {
+	Bins         []HistogramBin `json:"bins"`
+	P1           float64        `json:"p1"`
+	P5           float64        `json:"p5"`
+	P50          float64        `json:"p50"`
+	P95          float64        `json:"p95"`
+	P99          float64        `json:"p99"`
+	Mean         float64        `json:"mean"`
+	StdDev       float64        `json:"std_dev"`
+	Min          float64        `json:"min"`
+	Max          float64        `json:"max"`
+	Observations int            `json:"observations"`
+}
+
+// AnalyzeReturnsDistribution bins the simple returns of the dataset and reports tail percentiles,
+// showing what "normal" moves look like for a token before trusting breakout signals
+func AnalyzeReturnsDistribution(dataset []OHLCV, period, bins int) (ReturnsDistribution, error) {
+	if bins <= 0 {
+		return ReturnsDistribution{}, invalidParameter("bins must be greater than 0")
+	}
+
+	returns, err := ReturnsFromOHLCV(dataset, period)
+	if err != nil {
+		return ReturnsDistribution{}, err
+	}
+
+	if len(returns) < 2 {
+		return ReturnsDistribution{}, ErrInsufficientData{Need: period + 2, Have: len(dataset)}
+	}
+
+	sorted := make([]float64, len(returns))
+	copy(sorted, returns)
+	sort.Float64s(sorted)
+
+	minReturn := sorted[0]
+	maxReturn := sorted[len(sorted)-1]
+	width := (maxReturn - minReturn) / float64(bins)
+
+	histogram := make([]HistogramBin, bins)
+	for i := range histogram {
+		histogram[i].Lower = minReturn + float64(i)*width
+		histogram[i].Upper = minReturn + float64(i+1)*width
+	}
+
+	for _, r := range returns {
+		index := bins - 1 // All returns fall into the last bin when every return is equal
+		if width > 0 {
+			index = int((r - minReturn) / width)
+			if index >= bins {
+				index = bins - 1 // Max return belongs to the last bin
+			}
+		}
+		histogram[index].Count++
+	}
+
+	for i := range histogram {
+		histogram[i].Frequency = float64(histogram[i].Count) / float64(len(returns))
+	}
+
+	mean := average(returns)
+
+	return ReturnsDistribution{
+		Bins:         histogram,
+		P1:           percentile(sorted, 1),
+		P5:           percentile(sorted, 5),
+		P50:          percentile(sorted, 50),
+		P95:          percentile(sorted, 95),
+		P99:          percentile(sorted, 99),
+		Mean:         mean,
+		StdDev:       stdDev(returns, mean),
+		Min:          minReturn,
+		Max:          maxReturn,
+		Observations: len(returns),
+	}, nil
+}
@@ -0,0 +1,365 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// UlcerIndexResult represents Ulcer Index calculation result
+type UlcerIndexResult struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"` // RMS of percentage drawdowns over the period
+}
+
+// CalculateUlcerIndex calculates the rolling Ulcer Index for the given dataset
+func CalculateUlcerIndex(dataset []OHLCV, period int, priceType PriceType) ([]UlcerIndexResult, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	if period > len(dataset) {
+		return nil, ErrInsufficientData{Need: period, Have: len(dataset)}
+	}
+
+	results := make([]UlcerIndexResult, 0, len(dataset)-period+1)
+
+	for i := period - 1; i < len(dataset); i++ {
+		results = append(results, UlcerIndexResult{
+			Timestamp: dataset[i].Timestamp,
+			Value:     ulcerIndex(dataset[i-period+1:i+1], priceType),
+		})
+	}
+
+	return results, nil
+}
+
+// ulcerIndex computes the Ulcer Index of a window, measuring drawdowns from the window's running high
+func ulcerIndex(window []OHLCV, priceType PriceType) float64 {
+	peak := 0.0
+	squaredSum := 0.0
+
+	for _, candle := range window {
+		price := candle.ExtractPrice(priceType)
+		if price > peak {
+			peak = price
+		}
+
+		if peak != 0 {
+			drawdown := 100 * (price - peak) / peak
+			squaredSum += drawdown * drawdown
+		}
+	}
+
+	return math.Sqrt(squaredSum / float64(len(window)))
+}
+
+// GetLatestUlcerIndex returns the most recent Ulcer Index value
+func GetLatestUlcerIndex(dataset []OHLCV, period int, priceType PriceType) (float64, error) {
+	results, err := CalculateUlcerIndex(dataset, period, priceType)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(results) == 0 {
+		return 0, noResults("Ulcer Index")
+	}
+
+	return results[len(results)-1].Value, nil
+}
+
+// MartinRatio represents the Ulcer Performance Index for a dataset
+type MartinRatio struct {
+	TotalReturn float64 `json:"total_return"` // Percentage return from first to last candle
+	UlcerIndex  float64 `json:"ulcer_index"`  // Ulcer Index over the whole dataset
+	Ratio       float64 `json:"ratio"`        // (TotalReturn - riskFree) / UlcerIndex
+}
+
+// CalculateMartinRatio calculates the Martin (Ulcer Performance) ratio over the whole dataset.
+// riskFree is expressed as a percentage return over the same span as the dataset.
+func CalculateMartinRatio(dataset []OHLCV, riskFree float64, priceType PriceType) (MartinRatio, error) {
+	if len(dataset) < 2 {
+		return MartinRatio{}, ErrInsufficientData{Need: 2, Have: len(dataset)}
+	}
+
+	firstPrice := dataset[0].ExtractPrice(priceType)
+	if firstPrice == 0 {
+		return MartinRatio{}, invalidPrice("first price must be non-zero")
+	}
+
+	lastPrice := dataset[len(dataset)-1].ExtractPrice(priceType)
+	totalReturn := 100 * (lastPrice - firstPrice) / firstPrice
+	ui := ulcerIndex(dataset, priceType)
+
+	// Ratio is undefined when there were no drawdowns
+	ratio := 0.0
+	if ui != 0 {
+		ratio = (totalReturn - riskFree) / ui
+	}
+
+	return MartinRatio{
+		TotalReturn: totalReturn,
+		UlcerIndex:  ui,
+		Ratio:       ratio,
+	}, nil
+}
+
+// BetaAlpha represents an asset's sensitivity to a benchmark
+type BetaAlpha struct {
+	Beta         float64 `json:"beta"`         // Covariance(asset, benchmark) / Variance(benchmark)
+	Alpha        float64 `json:"alpha"`        // Average per-candle excess return not explained by beta
+	Correlation  float64 `json:"correlation"`  // Pearson correlation of returns
+	RSquared     float64 `json:"r_squared"`    // Share of asset variance explained by the benchmark
+	Observations int     `json:"observations"` // Number of aligned return pairs used
+}
+
+// CalculateBetaAlpha calculates beta and alpha of an asset versus a benchmark (e.g. BTC, ETH, SOL).
+// Candles are aligned by timestamp, so only periods present in both series are used.
+func CalculateBetaAlpha(asset, benchmark []OHLCV) (BetaAlpha, error) {
+	assetReturns, benchmarkReturns, err := alignedReturns(asset, benchmark)
+	if err != nil {
+		return BetaAlpha{}, err
+	}
+
+	assetMean := average(assetReturns)
+	benchmarkMean := average(benchmarkReturns)
+
+	var covariance, assetVariance, benchmarkVariance float64
+	for i := range assetReturns {
+		assetDiff := assetReturns[i] - assetMean
+		benchmarkDiff := benchmarkReturns[i] - benchmarkMean
+		covariance += assetDiff * benchmarkDiff
+		assetVariance += assetDiff * assetDiff
+		benchmarkVariance += benchmarkDiff * benchmarkDiff
+	}
+
+	if benchmarkVariance == 0 {
+		return BetaAlpha{}, invalidParameter("benchmark returns have zero variance")
+	}
+
+	beta := covariance / benchmarkVariance
+	alpha := assetMean - beta*benchmarkMean
+
+	correlation := 0.0
+	if assetVariance != 0 {
+		correlation = covariance / math.Sqrt(assetVariance*benchmarkVariance)
+	}
+
+	return BetaAlpha{
+		Beta:         beta,
+		Alpha:        alpha,
+		Correlation:  correlation,
+		RSquared:     correlation * correlation,
+		Observations: len(assetReturns),
+	}, nil
+}
+
+// alignedReturns computes close-to-close returns of an asset and benchmark over their common timestamps
+func alignedReturns(asset, benchmark []OHLCV) ([]float64, []float64, error) {
+	if len(asset) == 0 || len(benchmark) == 0 {
+		return nil, nil, ErrEmptyDataset
+	}
+
+	alignedAsset, alignedBenchmark := alignByTimestamp(asset, benchmark)
+	if len(alignedAsset) < 3 {
+		return nil, nil, ErrInsufficientData{Need: 3, Have: len(alignedAsset)}
+	}
+
+	assetReturns, err := ReturnsFromOHLCV(alignedAsset, 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("asset returns: %w", err)
+	}
+
+	benchmarkReturns, err := ReturnsFromOHLCV(alignedBenchmark, 1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("benchmark returns: %w", err)
+	}
+
+	return assetReturns, benchmarkReturns, nil
+}
+
+// alignByTimestamp returns the candles of both series whose timestamps match, in the order of a
+func alignByTimestamp(a, b []OHLCV) ([]OHLCV, []OHLCV) {
+	index := make(map[int64]int, len(b))
+	for i, candle := range b {
+		index[candle.Timestamp.UnixNano()] = i
+	}
+
+	var alignedA, alignedB []OHLCV
+	for _, candle := range a {
+		if j, ok := index[candle.Timestamp.UnixNano()]; ok {
+			alignedA = append(alignedA, candle)
+			alignedB = append(alignedB, b[j])
+		}
+	}
+
+	return alignedA, alignedB
+}
+
+// InformationRatio represents benchmark-relative performance of an asset
+type InformationRatio struct {
+	ActiveReturn  float64 `json:"active_return"`  // Annualized mean of asset minus benchmark returns
+	TrackingError float64 `json:"tracking_error"` // Annualized standard deviation of active returns
+	Ratio         float64 `json:"ratio"`          // ActiveReturn / TrackingError
+	Observations  int     `json:"observations"`   // Number of aligned return pairs used
+}
+
+// CalculateInformationRatio calculates the information ratio of an asset versus a benchmark.
+// annualization is the number of candles per year (365 for daily crypto candles).
+func CalculateInformationRatio(asset, benchmark []OHLCV, annualization float64) (InformationRatio, error) {
+	if annualization <= 0 {
+		return InformationRatio{}, invalidParameter("annualization factor must be greater than 0")
+	}
+
+	assetReturns, benchmarkReturns, err := alignedReturns(asset, benchmark)
+	if err != nil {
+		return InformationRatio{}, err
+	}
+
+	active := make([]float64, len(assetReturns))
+	for i := range assetReturns {
+		active[i] = assetReturns[i] - benchmarkReturns[i]
+	}
+
+	mean := average(active)
+	trackingError := stdDev(active, mean) * math.Sqrt(annualization)
+	activeReturn := mean * annualization
+
+	ratio := 0.0
+	if trackingError != 0 {
+		ratio = activeReturn / trackingError
+	}
+
+	return InformationRatio{
+		ActiveReturn:  activeReturn,
+		TrackingError: trackingError,
+		Ratio:         ratio,
+		Observations:  len(active),
+	}, nil
+}
+
+// TreynorRatio represents excess return per unit of systematic (benchmark) risk
+type TreynorRatio struct {
+	ExcessReturn float64 `json:"excess_return"` // Annualized mean return minus risk-free rate
+	Beta         float64 `json:"beta"`          // Beta versus the benchmark
+	Ratio        float64 `json:"ratio"`         // ExcessReturn / Beta
+}
+
+// CalculateTreynorRatio calculates the Treynor ratio of an asset versus a benchmark.
+// riskFree is the annual risk-free rate (0.05 = 5%) and annualization the number of candles per year.
+func CalculateTreynorRatio(asset, benchmark []OHLCV, riskFree, annualization float64) (TreynorRatio, error) {
+	if annualization <= 0 {
+		return TreynorRatio{}, invalidParameter("annualization factor must be greater than 0")
+	}
+
+	betaAlpha, err := CalculateBetaAlpha(asset, benchmark)
+	if err != nil {
+		return TreynorRatio{}, err
+	}
+
+	assetReturns, _, err := alignedReturns(asset, benchmark)
+	if err != nil {
+		return TreynorRatio{}, err
+	}
+
+	excessReturn := average(assetReturns)*annualization - riskFree
+
+	ratio := 0.0
+	if betaAlpha.Beta != 0 {
+		ratio = excessReturn / betaAlpha.Beta
+	}
+
+	return TreynorRatio{
+		ExcessReturn: excessReturn,
+		Beta:         betaAlpha.Beta,
+		Ratio:        ratio,
+	}, nil
+}
+
+// DrawdownAnalysis represents drawdown depth and time-under-water statistics
+type DrawdownAnalysis struct {
+	MaxDrawdown         float64 `json:"max_drawdown"`          // Deepest peak-to-trough decline (0.3 = 30%)
+	CurrentDrawdown     float64 `json:"current_drawdown"`      // Decline of the latest price from its peak
+	CurrentDrawdownAge  int     `json:"current_drawdown_age"`  // Candles since the peak of the ongoing drawdown
+	LongestDuration     int     `json:"longest_duration"`      // Most candles spent under water in one drawdown
+	AverageDuration     float64 `json:"average_duration"`      // Average candles from peak to recovery
+	AverageRecoveryTime float64 `json:"average_recovery_time"` // Average candles from trough back to the prior peak
+	Drawdowns           int     `json:"drawdowns"`             // Number of drawdowns, including an ongoing one
+	Recovered           int     `json:"recovered"`             // Number of drawdowns that fully recovered
+}
+
+// AnalyzeDrawdowns calculates drawdown depth, duration, and recovery statistics for the dataset
+func AnalyzeDrawdowns(dataset []OHLCV, priceType PriceType) (DrawdownAnalysis, error) {
+	if len(dataset) < 2 {
+		return DrawdownAnalysis{}, ErrInsufficientData{Need: 2, Have: len(dataset)}
+	}
+
+	var analysis DrawdownAnalysis
+	var totalDuration, totalRecovery int
+
+	peak := dataset[0].ExtractPrice(priceType)
+	peakIndex := 0
+	trough := peak
+	troughIndex := 0
+	underWater := false
+
+	for i := 1; i < len(dataset); i++ {
+		price := dataset[i].ExtractPrice(priceType)
+
+		if price >= peak {
+			// Recovered (or made a new high)
+			if underWater {
+				duration := i - peakIndex
+				totalDuration += duration
+				totalRecovery += i - troughIndex
+				analysis.Recovered++
+				if duration > analysis.LongestDuration {
+					analysis.LongestDuration = duration
+				}
+				underWater = false
+			}
+			peak = price
+			peakIndex = i
+			continue
+		}
+
+		if !underWater {
+			underWater = true
+			analysis.Drawdowns++
+			trough = price
+			troughIndex = i
+		} else if price < trough {
+			trough = price
+			troughIndex = i
+		}
+
+		if peak != 0 {
+			if drawdown := (peak - price) / peak; drawdown > analysis.MaxDrawdown {
+				analysis.MaxDrawdown = drawdown
+			}
+		}
+	}
+
+	// Ongoing drawdown at the end of the dataset
+	if underWater {
+		last := len(dataset) - 1
+		analysis.CurrentDrawdownAge = last - peakIndex
+		if peak != 0 {
+			analysis.CurrentDrawdown = (peak - dataset[last].ExtractPrice(priceType)) / peak
+		}
+		if analysis.CurrentDrawdownAge > analysis.LongestDuration {
+			analysis.LongestDuration = analysis.CurrentDrawdownAge
+		}
+	}
+
+	if analysis.Recovered > 0 {
+		analysis.AverageDuration = float64(totalDuration) / float64(analysis.Recovered)
+		analysis.AverageRecoveryTime = float64(totalRecovery) / float64(analysis.Recovered)
+	}
+
+	return analysis, nil
+}
@@ -1,16 +1,16 @@
 package techindicators
 
 import (
-	"errors"
-	"fmt"
 	"math"
+	"slices"
+	"time"
 )
 
 // RSIResult represents RSI calculation result
 type RSIResult struct {
-	Timestamp string  `json:"timestamp"`
-	Value     float64 `json:"value"`
-	Signal    string  `json:"signal"` // overbought, oversold, neutral
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+	Signal    string    `json:"signal"` // overbought, oversold, neutral
 }
 
 // RSICondition represents RSI market conditions
@@ -26,45 +26,44 @@ const (
 
 // CalculateRSI calculates Relative Strength Index for the given dataset
 func CalculateRSI(dataset []OHLCV, period int, priceType PriceType) ([]RSIResult, error) {
+	return AppendRSI(nil, dataset, period, priceType)
+}
+
+// AppendRSI appends the RSI series for the dataset to dst and returns the extended slice
+func AppendRSI(dst []RSIResult, dataset []OHLCV, period int, priceType PriceType) ([]RSIResult, error) {
 	if len(dataset) == 0 {
-		return nil, errors.New("dataset is empty")
+		return dst, ErrEmptyDataset
 	}
 
 	if period <= 0 {
-		return nil, errors.New("period must be greater than 0")
+		return dst, invalidPeriod("period must be greater than 0")
 	}
 
 	if period >= len(dataset) {
-		return nil, fmt.Errorf("period (%d) must be less than dataset length (%d)", period, len(dataset))
-	}
-
-	// Extract prices
-	var prices []float64
-	for _, candle := range dataset {
-		price := candle.ExtractPrice(priceType)
-		prices = append(prices, price)
+		return dst, ErrInsufficientData{Need: period + 1, Have: len(dataset)}
 	}
 
-	var results []RSIResult
+	results := slices.Grow(dst, len(dataset)-period)
 
-	// Calculate price changes
-	var gains []float64
-	var losses []float64
+	// Calculate price changes into pooled scratch buffers
+	gainsBuf := getFloats(len(dataset) - 1)
+	defer putFloats(gainsBuf)
+	lossesBuf := getFloats(len(dataset) - 1)
+	defer putFloats(lossesBuf)
+	gains, losses := *gainsBuf, *lossesBuf
 
-	for i := 1; i < len(prices); i++ {
-		change := prices[i] - prices[i-1]
+	prev := dataset[0].ExtractPrice(priceType)
+	for i := 1; i < len(dataset); i++ {
+		price := dataset[i].ExtractPrice(priceType)
+		change := price - prev
 		if change > 0 {
-			gains = append(gains, change)
-			losses = append(losses, 0)
+			gains[i-1] = change
+			losses[i-1] = 0
 		} else {
-			gains = append(gains, 0)
-			losses = append(losses, -change)
+			gains[i-1] = 0
+			losses[i-1] = -change
 		}
-	}
-
-	// Need enough data for initial calculation
-	if len(gains) < period {
-		return nil, fmt.Errorf("insufficient data: need at least %d price changes", period)
+		prev = price
 	}
 
 	// Calculate initial average gain and loss (SMA for first calculation)
@@ -86,7 +85,7 @@ func CalculateRSI(dataset []OHLCV, period int, priceType PriceType) ([]RSIResult
 	// Add first RSI result
 	signal := getRSISignal(rsi)
 	results = append(results, RSIResult{
-		Timestamp: dataset[period].Timestamp.Format("2006-01-02T15:04:05Z"), // period+1 index in original dataset
+		Timestamp: dataset[period].Timestamp, // period+1 index in original dataset
 		Value:     rsi,
 		Signal:    signal,
 	})
@@ -106,7 +105,7 @@ func CalculateRSI(dataset []OHLCV, period int, priceType PriceType) ([]RSIResult
 
 		signal = getRSISignal(rsi)
 		results = append(results, RSIResult{
-			Timestamp: dataset[i+1].Timestamp.Format("2006-01-02T15:04:05Z"), // i+1 because gains array is offset by 1
+			Timestamp: dataset[i+1].Timestamp, // i+1 because gains array is offset by 1
 			Value:     rsi,
 			Signal:    signal,
 		})
@@ -139,7 +138,7 @@ func GetLatestRSI(dataset []OHLCV, period int, priceType PriceType) (RSIResult,
 	}
 
 	if len(rsiResults) == 0 {
-		return RSIResult{}, errors.New("no RSI results calculated")
+		return RSIResult{}, noResults("RSI")
 	}
 
 	return rsiResults[len(rsiResults)-1], nil
@@ -163,8 +162,13 @@ func DetectRSIDivergence(dataset []OHLCV, period int, priceType PriceType, lookb
 		return RSIDivergence{}, err
 	}
 
+	return rsiDivergenceFromResults(dataset, rsiResults, lookback), nil
+}
+
+// rsiDivergenceFromResults detects divergences over the last lookback candles of an already computed RSI series
+func rsiDivergenceFromResults(dataset []OHLCV, rsiResults []RSIResult, lookback int) RSIDivergence {
 	if len(rsiResults) < lookback || len(dataset) < lookback {
-		return RSIDivergence{Type: "none", Strength: "insufficient_data", Confidence: 0}, nil
+		return RSIDivergence{Type: "none", Strength: "insufficient_data", Confidence: 0}
 	}
 
 	// Get recent data
@@ -214,7 +218,7 @@ func DetectRSIDivergence(dataset []OHLCV, period int, priceType PriceType, lookb
 				Type:       "bearish",
 				Strength:   "regular",
 				Confidence: confidence,
-			}, nil
+			}
 		}
 	}
 
@@ -234,11 +238,11 @@ func DetectRSIDivergence(dataset []OHLCV, period int, priceType PriceType, lookb
 				Type:       "bullish",
 				Strength:   "regular",
 				Confidence: confidence,
-			}, nil
+			}
 		}
 	}
 
-	return RSIDivergence{Type: "none", Strength: "none", Confidence: 0}, nil
+	return RSIDivergence{Type: "none", Strength: "none", Confidence: 0}
 }
 
 // RSIStrategy provides comprehensive RSI analysis
@@ -246,18 +250,29 @@ type RSIStrategy struct {
 	Current    RSIResult     `json:"current"`
 	Condition  RSICondition  `json:"condition"`
 	Divergence RSIDivergence `json:"divergence"`
-	Signal     string        `json:"signal"`
+	Signal     Signal        `json:"signal"`
 	Momentum   string        `json:"momentum"` // strengthening, weakening, neutral
 }
 
 // AnalyzeRSIStrategy provides complete RSI analysis for trading decisions
 func AnalyzeRSIStrategy(dataset []OHLCV, period int, priceType PriceType) (RSIStrategy, error) {
-	// Get current RSI
-	currentRSI, err := GetLatestRSI(dataset, period, priceType)
+	return analyzeRSIStrategy(newAnalysisCache(dataset), period, priceType)
+}
+
+// analyzeRSIStrategy runs the RSI strategy on the cached RSI series so every sub-check shares one calculation
+func analyzeRSIStrategy(cache *analysisCache, period int, priceType PriceType) (RSIStrategy, error) {
+	rsiResults, err := cache.rsi(period, priceType)
 	if err != nil {
 		return RSIStrategy{}, err
 	}
 
+	if len(rsiResults) == 0 {
+		return RSIStrategy{}, noResults("RSI")
+	}
+
+	// Get current RSI
+	currentRSI := rsiResults[len(rsiResults)-1]
+
 	// Determine condition
 	var condition RSICondition
 	switch {
@@ -274,17 +289,9 @@ func AnalyzeRSIStrategy(dataset []OHLCV, period int, priceType PriceType) (RSISt
 	}
 
 	// Detect divergence
-	divergence, err := DetectRSIDivergence(dataset, period, priceType, 10)
-	if err != nil {
-		return RSIStrategy{}, err
-	}
+	divergence := rsiDivergenceFromResults(cache.dataset, rsiResults, 10)
 
 	// Analyze momentum trend
-	rsiResults, err := CalculateRSI(dataset, period, priceType)
-	if err != nil {
-		return RSIStrategy{}, err
-	}
-
 	momentum := "neutral"
 	if len(rsiResults) >= 3 {
 		recent := rsiResults[len(rsiResults)-3:]
@@ -296,20 +303,20 @@ func AnalyzeRSIStrategy(dataset []OHLCV, period int, priceType PriceType) (RSISt
 	}
 
 	// Generate trading signal
-	signal := "hold"
+	signal := SignalHold
 	switch {
 	case condition == RSIExtremeLow && divergence.Type == "bullish":
-		signal = "strong_buy"
+		signal = SignalStrongBuy
 	case condition == RSIExtremeHigh && divergence.Type == "bearish":
-		signal = "strong_sell"
+		signal = SignalStrongSell
 	case condition == RSIOversold && momentum == "strengthening":
-		signal = "buy"
+		signal = SignalBuy
 	case condition == RSIOverbought && momentum == "weakening":
-		signal = "sell"
+		signal = SignalSell
 	case currentRSI.Value > 50 && momentum == "strengthening":
-		signal = "bullish"
+		signal = SignalBullish
 	case currentRSI.Value < 50 && momentum == "weakening":
-		signal = "bearish"
+		signal = SignalBearish
 	}
 
 	return RSIStrategy{
@@ -0,0 +1,373 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// RugPullCode identifies one reason behind a rug pull risk assessment
+type RugPullCode string
+
+const (
+	// Candle patterns (the rules UltimateAnalysis has always used)
+	RugPullDumpPattern         RugPullCode = "dump_pattern"         // Strong sell volume, distribution, and RSI strong sell on a volume spike
+	RugPullDistributionSelloff RugPullCode = "distribution_selloff" // Distribution while the technical analysis is a strong sell
+	RugPullSellPressure        RugPullCode = "sell_pressure"        // Distribution volume signal, or elevated volume on a sell
+
+	// Market and on-chain inputs
+	RugPullCriticalLiquidity   RugPullCode = "critical_liquidity"   // Pooled liquidity small enough to drain in one trade
+	RugPullThinLiquidity       RugPullCode = "thin_liquidity"       // Pooled liquidity a single wallet can move
+	RugPullWashTrading         RugPullCode = "wash_trading"         // 24h volume far above the pooled liquidity
+	RugPullHoneypot            RugPullCode = "honeypot"             // Buys without any sell
+	RugPullShallowDepth        RugPullCode = "shallow_depth"        // Little liquidity within 2% of the price
+	RugPullLiquidityRemoved    RugPullCode = "liquidity_removed"    // A large share of the liquidity was pulled recently
+	RugPullHolderConcentration RugPullCode = "holder_concentration" // The top holders own most of the supply
+	RugPullFewHolders          RugPullCode = "few_holders"
+	RugPullDeployerHoldings    RugPullCode = "deployer_holdings" // The deployer still holds a large share of the supply
+	RugPullWhaleTransfers      RugPullCode = "whale_transfers"   // Large transfers moved a notable share of the supply recently
+	RugPullDeployerSelling     RugPullCode = "deployer_selling"  // The deployer moved tokens recently
+)
+
+// defaultRugPullSeverities are the 0-1 severities of each reason; the candle patterns match rugPullRiskScore
+var defaultRugPullSeverities = map[RugPullCode]float64{
+	RugPullDumpPattern:         1.0,
+	RugPullDistributionSelloff: 0.75,
+	RugPullSellPressure:        0.5,
+	RugPullCriticalLiquidity:   0.75,
+	RugPullThinLiquidity:       0.5,
+	RugPullWashTrading:         0.5,
+	RugPullHoneypot:            1.0,
+	RugPullShallowDepth:        0.5,
+	RugPullLiquidityRemoved:    0.75,
+	RugPullHolderConcentration: 0.5,
+	RugPullFewHolders:          0.35,
+	RugPullDeployerHoldings:    0.5,
+	RugPullWhaleTransfers:      0.35,
+	RugPullDeployerSelling:     0.75,
+}
+
+// RugPullReason is one triggered rule of a rug pull assessment
+type RugPullReason struct {
+	Code     RugPullCode `json:"code"`
+	Severity float64     `json:"severity"` // 0-1
+	Detail   string      `json:"detail"`
+}
+
+// RugPullAssessment is the result of AssessRugPullRisk
+type RugPullAssessment struct {
+	Score   float64         `json:"score"` // 0-1, higher is riskier
+	Level   string          `json:"level"` // low, medium, high, extreme
+	Reasons []RugPullReason `json:"reasons,omitempty"`
+}
+
+// LiquidityEvent is a change of pooled liquidity, e.g. an LP mint or burn; removals have a negative DeltaUSD
+type LiquidityEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	DeltaUSD  float64   `json:"delta_usd"`
+}
+
+// RugPullInputs is the optional context AssessRugPullRisk uses besides the candle analysis.
+// Zero fields are treated as unknown and skip their rules.
+type RugPullInputs struct {
+	Market          *TokenMarketData   `json:"market,omitempty"`
+	DepthUSD        float64            `json:"depth_usd"`              // Liquidity within 2% of the price
+	LiquidityEvents []LiquidityEvent   `json:"liquidity_events"`       // Recent pool changes, any order
+	Liquidity       []LiquiditySample  `json:"liquidity,omitempty"`    // Pool TVL or depth series in time order, e.g. from AnalysisConfig.Liquidity
+	TopHoldersShare float64            `json:"top_holders_share"`      // Share of supply held by the top 10 holders, 0-1
+	Fundamentals    *TokenFundamentals `json:"fundamentals,omitempty"` // Holder data; fills TopHoldersShare when that is 0
+	At              time.Time          `json:"at"`                     // Reference time for the removal window [now]
+}
+
+// RugPullRiskConfig configures AssessRugPullRisk. Zero fields take the defaults in brackets.
+type RugPullRiskConfig struct {
+	DumpVolumeRatio float64 `json:"dump_volume_ratio"` // Volume ratio of the dump pattern [3]
+	SellVolumeRatio float64 `json:"sell_volume_ratio"` // Volume ratio that makes a sell signal sell pressure [2]
+
+	CriticalLiquidityUSD   float64       `json:"critical_liquidity_usd"`   // [10,000]
+	ThinLiquidityUSD       float64       `json:"thin_liquidity_usd"`       // [50,000]
+	WashTradingRatio       float64       `json:"wash_trading_ratio"`       // 24h volume to liquidity [20]
+	HoneypotMinBuys        int           `json:"honeypot_min_buys"`        // Buys without a sell before flagging a honeypot [20]
+	ShallowDepthUSD        float64       `json:"shallow_depth_usd"`        // [5,000]
+	LiquidityRemovalShare  float64       `json:"liquidity_removal_share"`  // Share of liquidity removed within the window [0.2]
+	LiquidityRemovalWindow time.Duration `json:"liquidity_removal_window"` // [24 hours]
+	HolderConcentration    float64       `json:"holder_concentration"`     // Top 10 holders share [0.5]
+	MinHolders             int           `json:"min_holders"`              // [100]
+	DeployerShare          float64       `json:"deployer_share"`           // Supply share the deployer may keep [0.05]
+	WhaleTransferShare     float64       `json:"whale_transfer_share"`     // Supply share moved by large transfers within the window [0.05]
+	WhaleTransferWindow    time.Duration `json:"whale_transfer_window"`    // [24 hours]
+
+	// Per-reason severity overrides; reasons not listed use the defaults
+	Severities map[RugPullCode]float64 `json:"severities,omitempty"`
+
+	// Score at which the level becomes medium [0.5], high [0.75], and extreme [0.95]
+	MediumScore  float64 `json:"medium_score"`
+	HighScore    float64 `json:"high_score"`
+	ExtremeScore float64 `json:"extreme_score"`
+}
+
+// DefaultRugPullRiskConfig returns the thresholds UltimateAnalysis and ApplyMarketContext use by default
+func DefaultRugPullRiskConfig() RugPullRiskConfig {
+	return RugPullRiskConfig{
+		DumpVolumeRatio:        3,
+		SellVolumeRatio:        2,
+		CriticalLiquidityUSD:   criticalLiquidityUSD,
+		ThinLiquidityUSD:       thinLiquidityUSD,
+		WashTradingRatio:       volumeLiquidityRatio,
+		HoneypotMinBuys:        honeypotMinBuys,
+		ShallowDepthUSD:        5_000,
+		LiquidityRemovalShare:  0.2,
+		LiquidityRemovalWindow: 24 * time.Hour,
+		HolderConcentration:    0.5,
+		MinHolders:             100,
+		DeployerShare:          0.05,
+		WhaleTransferShare:     0.05,
+		WhaleTransferWindow:    24 * time.Hour,
+		MediumScore:            0.5,
+		HighScore:              0.75,
+		ExtremeScore:           0.95,
+	}
+}
+
+// withDefaults fills zero fields
+func (c RugPullRiskConfig) withDefaults() RugPullRiskConfig {
+	defaults := DefaultRugPullRiskConfig()
+	fill := func(value *float64, fallback float64) {
+		if *value == 0 {
+			*value = fallback
+		}
+	}
+	fill(&c.DumpVolumeRatio, defaults.DumpVolumeRatio)
+	fill(&c.SellVolumeRatio, defaults.SellVolumeRatio)
+	fill(&c.CriticalLiquidityUSD, defaults.CriticalLiquidityUSD)
+	fill(&c.ThinLiquidityUSD, defaults.ThinLiquidityUSD)
+	fill(&c.WashTradingRatio, defaults.WashTradingRatio)
+	fill(&c.ShallowDepthUSD, defaults.ShallowDepthUSD)
+	fill(&c.LiquidityRemovalShare, defaults.LiquidityRemovalShare)
+	fill(&c.HolderConcentration, defaults.HolderConcentration)
+	fill(&c.DeployerShare, defaults.DeployerShare)
+	fill(&c.WhaleTransferShare, defaults.WhaleTransferShare)
+	fill(&c.MediumScore, defaults.MediumScore)
+	fill(&c.HighScore, defaults.HighScore)
+	fill(&c.ExtremeScore, defaults.ExtremeScore)
+	if c.HoneypotMinBuys == 0 {
+		c.HoneypotMinBuys = defaults.HoneypotMinBuys
+	}
+	if c.LiquidityRemovalWindow == 0 {
+		c.LiquidityRemovalWindow = defaults.LiquidityRemovalWindow
+	}
+	if c.MinHolders == 0 {
+		c.MinHolders = defaults.MinHolders
+	}
+	if c.WhaleTransferWindow == 0 {
+		c.WhaleTransferWindow = defaults.WhaleTransferWindow
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c RugPullRiskConfig) validate() error {
+	for _, value := range []float64{c.DumpVolumeRatio, c.SellVolumeRatio, c.CriticalLiquidityUSD, c.ThinLiquidityUSD,
+		c.WashTradingRatio, c.ShallowDepthUSD, c.LiquidityRemovalShare, c.HolderConcentration, c.DeployerShare, c.WhaleTransferShare} {
+		if value < 0 || math.IsNaN(value) {
+			return invalidParameter("rug pull thresholds must not be negative")
+		}
+	}
+	if c.HoneypotMinBuys < 0 || c.MinHolders < 0 || c.LiquidityRemovalWindow < 0 || c.WhaleTransferWindow < 0 {
+		return invalidParameter("rug pull thresholds must not be negative")
+	}
+	if !(0 < c.MediumScore && c.MediumScore <= c.HighScore && c.HighScore <= c.ExtremeScore && c.ExtremeScore <= 1) {
+		return invalidParameter("rug pull level scores must be ascending within (0, 1]")
+	}
+	for code, severity := range c.Severities {
+		if severity < 0 || severity > 1 {
+			return invalidParameter("severity of %s must be between 0 and 1, got %v", code, severity)
+		}
+	}
+	return nil
+}
+
+// severity returns the configured severity of a reason
+func (c RugPullRiskConfig) severity(code RugPullCode) float64 {
+	if severity, ok := c.Severities[code]; ok {
+		return severity
+	}
+	return defaultRugPullSeverities[code]
+}
+
+// level maps a score to a rug pull risk label
+func (c RugPullRiskConfig) level(score float64) string {
+	switch {
+	case score >= c.ExtremeScore:
+		return "extreme"
+	case score >= c.HighScore:
+		return "high"
+	case score >= c.MediumScore:
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+// AssessRugPullRisk scores the rug pull risk of an ultimate analysis from its candle patterns plus whatever
+// liquidity, depth, holder, and whale inputs are available. Each triggered rule adds a reason with its severity; the
+// score combines them as independent risks (1 - ∏(1 - severity)), so one rule alone gives its own severity
+// and the defaults reproduce the labels of the candle-only analysis.
+func AssessRugPullRisk(analysis UltimateMemecoinAnalysis, inputs RugPullInputs, config RugPullRiskConfig) (RugPullAssessment, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return RugPullAssessment{}, err
+	}
+	if inputs.Fundamentals != nil {
+		if err := inputs.Fundamentals.validate(); err != nil {
+			return RugPullAssessment{}, err
+		}
+	}
+	if err := validateLiquidity(inputs.Liquidity); err != nil {
+		return RugPullAssessment{}, err
+	}
+
+	var reasons []RugPullReason
+	add := func(code RugPullCode, format string, args ...any) {
+		if severity := config.severity(code); severity > 0 {
+			reasons = append(reasons, RugPullReason{Code: code, Severity: severity, Detail: fmt.Sprintf(format, args...)})
+		}
+	}
+
+	// Candle patterns: only the most severe one applies
+	volume, technical := analysis.Volume, analysis.Technical
+	distribution := volume.AccumulationSignal.Type == "distribution"
+	switch {
+	case volume.Signal == SignalStrongSell && distribution && technical.RSISignal == SignalStrongSell && volume.VolumeRatio > config.DumpVolumeRatio:
+		add(RugPullDumpPattern, "strong sell on %.1fx volume with distribution and RSI strong sell", volume.VolumeRatio)
+	case distribution && technical.FinalSignal == SignalStrongSell:
+		add(RugPullDistributionSelloff, "distribution during a strong sell")
+	case volume.Signal == SignalDistribute:
+		add(RugPullSellPressure, "volume signals distribution")
+	case volume.VolumeRatio > config.SellVolumeRatio && technical.FinalSignal == SignalSell:
+		add(RugPullSellPressure, "sell on %.1fx volume", volume.VolumeRatio)
+	}
+
+	if market := inputs.Market; market != nil {
+		switch {
+		case market.LiquidityUSD > 0 && market.LiquidityUSD < config.CriticalLiquidityUSD:
+			add(RugPullCriticalLiquidity, "liquidity $%.0f below $%.0f", market.LiquidityUSD, config.CriticalLiquidityUSD)
+		case market.LiquidityUSD > 0 && market.LiquidityUSD < config.ThinLiquidityUSD:
+			add(RugPullThinLiquidity, "liquidity $%.0f below $%.0f", market.LiquidityUSD, config.ThinLiquidityUSD)
+		}
+		if market.LiquidityUSD > 0 && market.Volume24hUSD > config.WashTradingRatio*market.LiquidityUSD {
+			add(RugPullWashTrading, "24h volume %.0fx liquidity", market.Volume24hUSD/market.LiquidityUSD)
+		}
+		if market.Buys24h >= config.HoneypotMinBuys && market.Sells24h == 0 {
+			add(RugPullHoneypot, "%d buys and no sells in 24h", market.Buys24h)
+		}
+	}
+
+	if inputs.DepthUSD > 0 && inputs.DepthUSD < config.ShallowDepthUSD {
+		add(RugPullShallowDepth, "$%.0f within 2%% of the price", inputs.DepthUSD)
+	}
+
+	if share := removedLiquidityShare(inputs, config.LiquidityRemovalWindow); share >= config.LiquidityRemovalShare {
+		add(RugPullLiquidityRemoved, "%.0f%% of liquidity removed within %s", share*100, config.LiquidityRemovalWindow)
+	}
+
+	topHolders, holders := inputs.TopHoldersShare, 0
+	if inputs.Market != nil {
+		holders = inputs.Market.Holders
+	}
+	if f := inputs.Fundamentals; f != nil {
+		if topHolders == 0 {
+			topHolders = f.TopHoldersShare
+		}
+		if f.Holders > 0 {
+			holders = f.Holders
+		}
+	}
+	if topHolders >= config.HolderConcentration {
+		add(RugPullHolderConcentration, "top 10 holders own %.0f%%", topHolders*100)
+	}
+	if holders > 0 && holders < config.MinHolders {
+		add(RugPullFewHolders, "%d holders", holders)
+	}
+
+	if f := inputs.Fundamentals; f != nil {
+		if f.DeployerShare >= config.DeployerShare {
+			add(RugPullDeployerHoldings, "deployer holds %.1f%%", f.DeployerShare*100)
+		}
+		share, deployer := f.recentTransfers(config.WhaleTransferWindow)
+		if deployer {
+			add(RugPullDeployerSelling, "deployer transferred tokens within %s", config.WhaleTransferWindow)
+		}
+		if share >= config.WhaleTransferShare {
+			add(RugPullWhaleTransfers, "large transfers moved %.1f%% of supply within %s", share*100, config.WhaleTransferWindow)
+		}
+	}
+
+	sort.SliceStable(reasons, func(i, j int) bool { return reasons[i].Severity > reasons[j].Severity })
+
+	safe := 1.0
+	for _, reason := range reasons {
+		safe *= 1 - reason.Severity
+	}
+	score := clampScore(1 - safe)
+	return RugPullAssessment{Score: score, Level: config.level(score), Reasons: reasons}, nil
+}
+
+// removedLiquidityShare returns the liquidity removed within the window as a share of the liquidity before
+// the removal: the net removal of the events or the fall of the liquidity series, whichever is larger
+func removedLiquidityShare(inputs RugPullInputs, window time.Duration) float64 {
+	at := inputs.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	series := removedLiquiditySeriesShare(inputs.Liquidity, at, window)
+
+	removed := 0.0
+	for _, event := range inputs.LiquidityEvents {
+		if !event.Timestamp.After(at) && at.Sub(event.Timestamp) <= window {
+			removed -= event.DeltaUSD
+		}
+	}
+	if removed <= 0 {
+		return series
+	}
+
+	current := 0.0
+	if inputs.Market != nil {
+		current = inputs.Market.LiquidityUSD
+	}
+	return math.Max(series, removed/(current+removed))
+}
+
+// ApplyRugPullAssessment raises the rug pull risk of an analysis to the assessment. Like ApplyMarketContext
+// the risk is only ever raised: the reasons are merged, RiskScore and RugPullScore take the maximum, and
+// RiskLevel becomes HIGH at a high or extreme risk. A honeypot reason sets the Honeypot flag and critical
+// liquidity the Illiquid flag.
+func ApplyRugPullAssessment(analysis *UltimateMemecoinAnalysis, assessment RugPullAssessment) {
+	analysis.RugPullRisk = higherRugPullRisk(analysis.RugPullRisk, assessment.Level)
+	analysis.RugPullScore = math.Max(analysis.RugPullScore, assessment.Score)
+	analysis.RiskScore = math.Max(analysis.RiskScore, assessment.Score)
+
+	for _, reason := range assessment.Reasons {
+		if !hasRugPullReason(analysis.RugPullReasons, reason.Code) {
+			analysis.RugPullReasons = append(analysis.RugPullReasons, reason)
+		}
+	}
+
+	if analysis.RugPullRisk == "high" || analysis.RugPullRisk == "extreme" {
+		analysis.RiskLevel = "HIGH"
+	}
+	analysis.Honeypot = analysis.Honeypot || hasRugPullReason(assessment.Reasons, RugPullHoneypot)
+	analysis.Illiquid = analysis.Illiquid || hasRugPullReason(assessment.Reasons, RugPullCriticalLiquidity)
+}
+
+// hasRugPullReason reports whether the reasons include the code
+func hasRugPullReason(reasons []RugPullReason, code RugPullCode) bool {
+	for _, reason := range reasons {
+		if reason.Code == code {
+			return true
+		}
+	}
+	return false
+}
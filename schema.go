@@ -0,0 +1,118 @@
+package techindicators
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaVersion is the version written by the Marshal helpers. Bump it when a stored result struct
+// changes incompatibly and register a migration from the previous version in schemaMigrations.
+const SchemaVersion = 1
+
+// Document kinds
+const (
+	KindDataset               = "dataset"
+	KindUltimateAnalysis      = "ultimate_analysis"
+	KindComprehensiveAnalysis = "comprehensive_analysis"
+)
+
+// VersionedDocument is the envelope stored around datasets and analysis results
+type VersionedDocument struct {
+	SchemaVersion int             `json:"schema_version"`
+	Kind          string          `json:"kind"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// schemaMigration rewrites a document's data from one version to the next
+type schemaMigration func(data json.RawMessage) (json.RawMessage, error)
+
+// schemaMigrations maps a kind to its migrations, where index v upgrades version v to v+1.
+// Version 0 is plain JSON written before the envelope existed; its shape matches version 1.
+var schemaMigrations = map[string][]schemaMigration{}
+
+// MarshalVersioned wraps a value in a VersionedDocument of the given kind
+func MarshalVersioned(kind string, value any) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(VersionedDocument{SchemaVersion: SchemaVersion, Kind: kind, Data: data})
+}
+
+// UnmarshalVersioned decodes a document of the given kind into value, migrating older versions.
+// Plain JSON without an envelope is read as version 0.
+func UnmarshalVersioned(raw []byte, kind string, value any) error {
+	var document VersionedDocument
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &document); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+		}
+	}
+	if document.SchemaVersion == 0 {
+		document = VersionedDocument{Kind: kind, Data: raw}
+	}
+
+	if document.Kind != kind {
+		return invalidParameter("document kind %q, expected %q", document.Kind, kind)
+	}
+	if document.SchemaVersion > SchemaVersion {
+		return invalidParameter("schema version %d is newer than the supported version %d", document.SchemaVersion, SchemaVersion)
+	}
+
+	data := document.Data
+	migrations := schemaMigrations[kind]
+	for version := document.SchemaVersion; version < SchemaVersion; version++ {
+		if version >= len(migrations) || migrations[version] == nil {
+			continue // No shape change between these versions
+		}
+		migrated, err := migrations[version](data)
+		if err != nil {
+			return fmt.Errorf("migrating %s from schema version %d: %w", kind, version, err)
+		}
+		data = migrated
+	}
+
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDataset, err)
+	}
+	return nil
+}
+
+// MarshalDataset encodes candles as a versioned document
+func MarshalDataset(dataset []OHLCV) ([]byte, error) {
+	return MarshalVersioned(KindDataset, dataset)
+}
+
+// UnmarshalDataset decodes candles from a versioned document or a plain JSON array
+func UnmarshalDataset(raw []byte) ([]OHLCV, error) {
+	var dataset []OHLCV
+	if err := UnmarshalVersioned(raw, KindDataset, &dataset); err != nil {
+		return nil, err
+	}
+	return dataset, nil
+}
+
+// MarshalUltimateAnalysis encodes an ultimate analysis as a versioned document
+func MarshalUltimateAnalysis(analysis UltimateMemecoinAnalysis) ([]byte, error) {
+	return MarshalVersioned(KindUltimateAnalysis, analysis)
+}
+
+// UnmarshalUltimateAnalysis decodes an ultimate analysis from a versioned document or plain JSON
+func UnmarshalUltimateAnalysis(raw []byte) (UltimateMemecoinAnalysis, error) {
+	var analysis UltimateMemecoinAnalysis
+	err := UnmarshalVersioned(raw, KindUltimateAnalysis, &analysis)
+	return analysis, err
+}
+
+// MarshalComprehensiveAnalysis encodes a comprehensive analysis as a versioned document
+func MarshalComprehensiveAnalysis(analysis CombinedTechnicalAnalysis) ([]byte, error) {
+	return MarshalVersioned(KindComprehensiveAnalysis, analysis)
+}
+
+// UnmarshalComprehensiveAnalysis decodes a comprehensive analysis from a versioned document or plain JSON
+func UnmarshalComprehensiveAnalysis(raw []byte) (CombinedTechnicalAnalysis, error) {
+	var analysis CombinedTechnicalAnalysis
+	err := UnmarshalVersioned(raw, KindComprehensiveAnalysis, &analysis)
+	return analysis, err
+}
@@ -0,0 +1,131 @@
+package techindicators
+
+import "time"
+
+// Sample is one timestamped value of a Series
+type Sample[T any] struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     T         `json:"value"`
+}
+
+// Series is a timestamped sequence of values in ascending time order
+type Series[T any] []Sample[T]
+
+// ToSeries converts any result slice to a Series using the given extractor
+func ToSeries[R, T any](results []R, sample func(R) (time.Time, T)) Series[T] {
+	series := make(Series[T], len(results))
+	for i, result := range results {
+		series[i].Timestamp, series[i].Value = sample(result)
+	}
+	return series
+}
+
+// SMASeries converts CalculateSMA results to a Series
+func SMASeries(results []SMAResult) Series[float64] {
+	return ToSeries(results, func(r SMAResult) (time.Time, float64) { return r.Timestamp, r.Value })
+}
+
+// RSISeries converts CalculateRSI results to a Series
+func RSISeries(results []RSIResult) Series[float64] {
+	return ToSeries(results, func(r RSIResult) (time.Time, float64) { return r.Timestamp, r.Value })
+}
+
+// PointSeries converts Indicator.Compute output to a Series of primary values
+func PointSeries(points []Point) Series[float64] {
+	return ToSeries(points, func(p Point) (time.Time, float64) { return p.Timestamp, p.Value })
+}
+
+// ComputeSeries runs an indicator and returns its primary values as a Series
+func ComputeSeries(indicator Indicator, dataset []OHLCV) (Series[float64], error) {
+	points, err := indicator.Compute(dataset)
+	if err != nil {
+		return nil, err
+	}
+	return PointSeries(points), nil
+}
+
+// PriceSeries extracts the given price from every candle as a Series
+func PriceSeries(dataset []OHLCV, priceType PriceType) Series[float64] {
+	return ToSeries(dataset, func(c OHLCV) (time.Time, float64) { return c.Timestamp, c.ExtractPrice(priceType) })
+}
+
+// Values returns the series values without timestamps
+func (s Series[T]) Values() []T {
+	values := make([]T, len(s))
+	for i, sample := range s {
+		values[i] = sample.Value
+	}
+	return values
+}
+
+// Last returns the most recent sample, or false for an empty series
+func (s Series[T]) Last() (Sample[T], bool) {
+	if len(s) == 0 {
+		return Sample[T]{}, false
+	}
+	return s[len(s)-1], true
+}
+
+// Filter returns the samples for which keep returns true
+func (s Series[T]) Filter(keep func(Sample[T]) bool) Series[T] {
+	var filtered Series[T]
+	for _, sample := range s {
+		if keep(sample) {
+			filtered = append(filtered, sample)
+		}
+	}
+	return filtered
+}
+
+// Since returns the samples at or after the given time
+func (s Series[T]) Since(t time.Time) Series[T] {
+	return s.Filter(func(sample Sample[T]) bool { return !sample.Timestamp.Before(t) })
+}
+
+// MapSeries transforms every value, keeping timestamps
+func MapSeries[T, U any](s Series[T], f func(T) U) Series[U] {
+	mapped := make(Series[U], len(s))
+	for i, sample := range s {
+		mapped[i] = Sample[U]{Timestamp: sample.Timestamp, Value: f(sample.Value)}
+	}
+	return mapped
+}
+
+// WindowSeries applies f to each sliding window of size values; each output takes the timestamp
+// of the window's last sample, so the result has len(s)-size+1 samples (none if s is shorter than size)
+func WindowSeries[T, U any](s Series[T], size int, f func(window []T) U) Series[U] {
+	if size <= 0 || len(s) < size {
+		return nil
+	}
+
+	values := s.Values()
+	windowed := make(Series[U], 0, len(s)-size+1)
+	for i := size - 1; i < len(s); i++ {
+		windowed = append(windowed, Sample[U]{Timestamp: s[i].Timestamp, Value: f(values[i-size+1 : i+1])})
+	}
+	return windowed
+}
+
+// ZipSeries combines two series at their common timestamps
+func ZipSeries[A, B, C any](a Series[A], b Series[B], f func(A, B) C) Series[C] {
+	index := make(map[int64]int, len(b))
+	for i, sample := range b {
+		index[sample.Timestamp.UnixNano()] = i
+	}
+
+	var zipped Series[C]
+	for _, sample := range a {
+		if j, ok := index[sample.Timestamp.UnixNano()]; ok {
+			zipped = append(zipped, Sample[C]{Timestamp: sample.Timestamp, Value: f(sample.Value, b[j].Value)})
+		}
+	}
+	return zipped
+}
+
+// Mean returns the average of the values, for use with WindowSeries (e.g. smoothing an RSI)
+func Mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return average(values)
+}
@@ -0,0 +1,155 @@
+package techindicators
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// SeriesTable holds candles and indicator series joined by timestamp, one row per timestamp.
+// Cells without a value (e.g. during an indicator's warm-up) are NaN.
+type SeriesTable struct {
+	Timestamps []time.Time
+	Columns    []string
+	Rows       [][]float64 // Rows[i][j] is column j at Timestamps[i]
+}
+
+// candleColumns are the raw candle columns added first when candles are joined
+var candleColumns = []string{"open", "high", "low", "close", "volume"}
+
+// JoinSeries joins raw candles and named point series into one table keyed by timestamp.
+// Candle columns come first, then the series in name order; components become "name.component" columns.
+// Rows cover every timestamp present in the candles or any series, in ascending order.
+func JoinSeries(candles []OHLCV, series map[string][]Point) SeriesTable {
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Column layout: candle fields, then each series followed by its sorted components
+	var columns []string
+	if len(candles) > 0 {
+		columns = append(columns, candleColumns...)
+	}
+	componentColumns := make(map[string][]string, len(names))
+	for _, name := range names {
+		columns = append(columns, name)
+		seen := make(map[string]bool)
+		for _, point := range series[name] {
+			for component := range point.Components {
+				seen[component] = true
+			}
+		}
+		for component := range seen {
+			componentColumns[name] = append(componentColumns[name], component)
+		}
+		sort.Strings(componentColumns[name])
+		for _, component := range componentColumns[name] {
+			columns = append(columns, name+"."+component)
+		}
+	}
+
+	// Row layout: union of all timestamps
+	rowIndex := make(map[int64]int)
+	var timestamps []time.Time
+	addTimestamp := func(ts time.Time) {
+		if _, ok := rowIndex[ts.UnixNano()]; !ok {
+			rowIndex[ts.UnixNano()] = len(timestamps)
+			timestamps = append(timestamps, ts)
+		}
+	}
+	for _, candle := range candles {
+		addTimestamp(candle.Timestamp)
+	}
+	for _, name := range names {
+		for _, point := range series[name] {
+			addTimestamp(point.Timestamp)
+		}
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+	for i, ts := range timestamps {
+		rowIndex[ts.UnixNano()] = i
+	}
+
+	rows := make([][]float64, len(timestamps))
+	for i := range rows {
+		rows[i] = make([]float64, len(columns))
+		for j := range rows[i] {
+			rows[i][j] = math.NaN()
+		}
+	}
+
+	// Fill cells
+	col := 0
+	if len(candles) > 0 {
+		for _, candle := range candles {
+			row := rows[rowIndex[candle.Timestamp.UnixNano()]]
+			row[0], row[1], row[2], row[3], row[4] = candle.Open, candle.High, candle.Low, candle.Close, candle.Volume
+		}
+		col = len(candleColumns)
+	}
+	for _, name := range names {
+		components := componentColumns[name]
+		for _, point := range series[name] {
+			row := rows[rowIndex[point.Timestamp.UnixNano()]]
+			row[col] = point.Value
+			for k, component := range components {
+				if value, ok := point.Components[component]; ok {
+					row[col+1+k] = value
+				}
+			}
+		}
+		col += 1 + len(components)
+	}
+
+	return SeriesTable{Timestamps: timestamps, Columns: columns, Rows: rows}
+}
+
+// JoinIndicators computes each indicator on the dataset and joins them with the candles, named by Indicator.Name
+func JoinIndicators(dataset []OHLCV, indicators ...Indicator) (SeriesTable, error) {
+	series := make(map[string][]Point, len(indicators))
+	for _, indicator := range indicators {
+		points, err := indicator.Compute(dataset)
+		if err != nil {
+			return SeriesTable{}, err
+		}
+		series[indicator.Name()] = points
+	}
+
+	return JoinSeries(dataset, series), nil
+}
+
+// Column returns the values of the named column, or false if the table has no such column
+func (t SeriesTable) Column(name string) ([]float64, bool) {
+	for j, column := range t.Columns {
+		if column == name {
+			values := make([]float64, len(t.Rows))
+			for i, row := range t.Rows {
+				values[i] = row[j]
+			}
+			return values, true
+		}
+	}
+	return nil, false
+}
+
+// DropIncomplete returns a copy of the table without rows that have any NaN cell,
+// leaving only the timestamps where every series has warmed up
+func (t SeriesTable) DropIncomplete() SeriesTable {
+	trimmed := SeriesTable{Columns: t.Columns}
+	for i, row := range t.Rows {
+		complete := true
+		for _, value := range row {
+			if math.IsNaN(value) {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			trimmed.Timestamps = append(trimmed.Timestamps, t.Timestamps[i])
+			trimmed.Rows = append(trimmed.Rows, row)
+		}
+	}
+	return trimmed
+}
@@ -0,0 +1,32 @@
+package techindicators
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SeriesWriter persists candles and indicator series to a time-series database, tagged by symbol,
+// interval, indicator, and parameters so dashboards such as Grafana can query them
+type SeriesWriter interface {
+	WriteCandles(ctx context.Context, symbol string, interval time.Duration, candles []OHLCV) error
+	// WriteIndicator stores an indicator's points; indicator is its registry name (e.g. "rsi")
+	WriteIndicator(ctx context.Context, symbol string, interval time.Duration, indicator string, params IndicatorParams, points []Point) error
+}
+
+// formatIndicatorParams renders params as a stable "key=value;key=value" string sorted by key
+func formatIndicatorParams(params IndicatorParams) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + strconv.FormatFloat(params[key], 'g', -1, 64)
+	}
+	return strings.Join(pairs, ";")
+}
@@ -4,10 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"math"
+	"time"
 
-	"github.com/JulianToledano/goingecko/v3/api"
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
@@ -39,7 +38,7 @@ func stdDev(data []float64, mean float64) float64 {
 }
 
 func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string) ([]byte, error) {
-	client := api.NewDefaultClient()
+	client := defaultCoinGeckoClient()
 
 	// coinID := "solana" // Replace with your chosen meme coin ID
 	// vsCurrency := "usd"
@@ -52,12 +51,12 @@ func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string)
 		days,
 	)
 	if err != nil {
-		log.Fatalf("Error fetching market chart: %v", err)
+		return nil, fmt.Errorf("fetching market chart: %w", coinGeckoError(err))
 	}
 
 	prices := resp.Prices
 	if len(prices) < 2 {
-		log.Fatalf("Not enough data points for coin %s", coinID)
+		return nil, ErrInsufficientData{Need: 2, Have: len(prices)}
 	}
 
 	// Compute daily returns
@@ -68,25 +67,8 @@ func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string)
 		returns = append(returns, (curr-prev)/prev)
 	}
 
-	// Average return
-	mean := average(returns)
-
-	// Standard deviation
-	sd := stdDev(returns, mean)
-
 	// Risk-free rate — assuming 0 for crypto
-	rf := 0.0
-
-	dailySharpe := (mean - rf) / sd
-
-	// Annualize assuming 365 trading days
-	annualSharpe := dailySharpe * math.Sqrt(365)
-
-	fmt.Printf("Meme Coin: %s\n", coinID)
-	fmt.Printf("Avg Daily Return: %.5f\n", mean)
-	fmt.Printf("Daily Volatility: %.5f\n", sd)
-	fmt.Printf("Daily Sharpe Ratio: %.5f\n", dailySharpe)
-	fmt.Printf("Annualized Sharpe Ratio: %.5f\n", annualSharpe)
+	mean, sd, dailySharpe, annualSharpe := sharpeStats(returns, 0)
 
 	sharpeobj := Sharpe{
 		Coin:              coinID,
@@ -98,7 +80,6 @@ func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string)
 
 	jsonSharpe, err := json.Marshal(sharpeobj)
 	if err != nil {
-		fmt.Println(err)
 		return nil, err
 	}
 
@@ -106,6 +87,67 @@ func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string)
 
 }
 
+// sharpeStats computes mean return, volatility, and daily/annualized Sharpe ratios for a return series
+func sharpeStats(returns []float64, riskFree float64) (mean, sd, dailySharpe, annualSharpe float64) {
+	mean = average(returns)
+	sd = stdDev(returns, mean)
+
+	if sd != 0 {
+		dailySharpe = (mean - riskFree) / sd
+	}
+
+	// Annualize assuming 365 trading days
+	annualSharpe = dailySharpe * math.Sqrt(365)
+
+	return mean, sd, dailySharpe, annualSharpe
+}
+
+// SharpeResult represents a rolling Sharpe ratio calculation result
+type SharpeResult struct {
+	Timestamp         time.Time `json:"timestamp"`
+	AvgReturn         float64   `json:"avg_return"`
+	Volatility        float64   `json:"volatility"`
+	SharpeRatio       float64   `json:"sharpe_ratio"`
+	AnnualSharpeRatio float64   `json:"annual_sharpe_ratio"` // Assumes daily candles (365 per year)
+}
+
+// CalculateSharpeFromOHLCV calculates a rolling Sharpe ratio from close-to-close returns.
+// riskFree is the per-candle risk-free return and window the number of returns per calculation.
+func CalculateSharpeFromOHLCV(dataset []OHLCV, riskFree float64, window int) ([]SharpeResult, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	if window <= 1 {
+		return nil, invalidPeriod("window must be greater than 1")
+	}
+
+	if len(dataset) <= window {
+		return nil, ErrInsufficientData{Need: window + 1, Have: len(dataset)}
+	}
+
+	returns, err := ReturnsFromOHLCV(dataset, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SharpeResult, 0, len(returns)-window+1)
+
+	for i := window - 1; i < len(returns); i++ {
+		mean, sd, sharpe, annualSharpe := sharpeStats(returns[i-window+1:i+1], riskFree)
+
+		results = append(results, SharpeResult{
+			Timestamp:         dataset[i+1].Timestamp, // i+1 because returns are offset by 1
+			AvgReturn:         mean,
+			Volatility:        sd,
+			SharpeRatio:       sharpe,
+			AnnualSharpeRatio: annualSharpe,
+		})
+	}
+
+	return results, nil
+}
+
 // func calculateSharpeRatio(ctx context.Context, coinID, vsCurrency, days string) ([]byte, error) {
 
 func SharpeRatioHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -128,7 +170,7 @@ func SharpeRatioHandler(ctx context.Context, request mcp.CallToolRequest) (*mcp.
 	sharpeRatio, err := calculateSharpeRatio(ctx, coinID, vsCurrency, days)
 
 	if err != nil {
-		log.Print("error calculating sharpe ratio")
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	return mcp.NewToolResultText(string(sharpeRatio)), nil
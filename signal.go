@@ -0,0 +1,127 @@
+package techindicators
+
+import (
+	"strings"
+)
+
+// Signal represents a trading signal produced by an indicator or strategy
+type Signal string
+
+const (
+	// Trading actions
+	SignalStrongBuy  Signal = "strong_buy"
+	SignalBuy        Signal = "buy"
+	SignalHold       Signal = "hold"
+	SignalSell       Signal = "sell"
+	SignalStrongSell Signal = "strong_sell"
+	SignalWait       Signal = "wait"       // Low volatility, wait for a breakout
+	SignalSuspicious Signal = "suspicious" // Price moves without volume, likely manipulation
+
+	// Forced moves, neither bullish nor bearish votes
+	SignalLongLiquidation  Signal = "long_liquidation"  // Cascade of long liquidations flushing the price down
+	SignalShortLiquidation Signal = "short_liquidation" // Cascade of short liquidations squeezing the price up
+
+	// Trend bias
+	SignalStrongBullish Signal = "strong_bullish"
+	SignalBullish       Signal = "bullish"
+	SignalNeutral       Signal = "neutral"
+	SignalBearish       Signal = "bearish"
+	SignalStrongBearish Signal = "strong_bearish"
+
+	// Bollinger Bands setups
+	SignalWaitForBreakout Signal = "wait_for_breakout" // Squeeze with price between bands
+	SignalBuySetup        Signal = "buy_signal"        // Price touching the lower band
+	SignalSellSetup       Signal = "sell_signal"       // Price touching the upper band
+
+	// Volume
+	SignalAccumulate     Signal = "accumulate"
+	SignalDistribute     Signal = "distribute"
+	SignalLowVolumeAlert Signal = "low_volume_alert" // Potentially fake moves
+
+	// Events
+	SignalBullishCrossover Signal = "bullish_crossover"
+	SignalBearishCrossover Signal = "bearish_crossover"
+	SignalGoldenCross      Signal = "golden_cross" // Long-term bullish crossover, e.g. SMA-50 over SMA-200
+	SignalDeathCross       Signal = "death_cross"  // Long-term bearish crossover
+	SignalBullishBreakout  Signal = "bullish_breakout"
+	SignalBearishBreakout  Signal = "bearish_breakout"
+	SignalNoSignal         Signal = "no_signal"
+	SignalNoBreakout       Signal = "no_breakout"
+	SignalInsufficientData Signal = "insufficient_data"
+)
+
+// allSignals lists every known signal for parsing
+var allSignals = []Signal{
+	SignalStrongBuy, SignalBuy, SignalHold, SignalSell, SignalStrongSell, SignalWait, SignalSuspicious,
+	SignalLongLiquidation, SignalShortLiquidation,
+	SignalStrongBullish, SignalBullish, SignalNeutral, SignalBearish, SignalStrongBearish,
+	SignalWaitForBreakout, SignalBuySetup, SignalSellSetup,
+	SignalAccumulate, SignalDistribute, SignalLowVolumeAlert,
+	SignalBullishCrossover, SignalBearishCrossover, SignalGoldenCross, SignalDeathCross,
+	SignalBullishBreakout, SignalBearishBreakout, SignalNoSignal, SignalNoBreakout, SignalInsufficientData,
+}
+
+// ParseSignal converts a signal string in any casing ("STRONG BUY", "strong_buy", "Strong-Buy") to a Signal
+func ParseSignal(s string) (Signal, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	normalized = strings.NewReplacer(" ", "_", "-", "_").Replace(normalized)
+
+	for _, signal := range allSignals {
+		if string(signal) == normalized {
+			return signal, nil
+		}
+	}
+
+	return "", invalidParameter("unknown signal: %q", s)
+}
+
+// String returns the signal's canonical form
+func (s Signal) String() string {
+	return string(s)
+}
+
+// Label returns the upper-case display form used in reports, e.g. "STRONG BUY"
+func (s Signal) Label() string {
+	return strings.ToUpper(strings.ReplaceAll(string(s), "_", " "))
+}
+
+// IsBullish reports whether the signal counts as a bullish vote
+func (s Signal) IsBullish() bool {
+	switch s {
+	case SignalStrongBuy, SignalBuy, SignalBullish, SignalStrongBullish,
+		SignalBullishCrossover, SignalGoldenCross, SignalBullishBreakout, SignalAccumulate:
+		return true
+	}
+	return false
+}
+
+// IsBearish reports whether the signal counts as a bearish vote
+func (s Signal) IsBearish() bool {
+	switch s {
+	case SignalStrongSell, SignalSell, SignalBearish, SignalStrongBearish,
+		SignalBearishCrossover, SignalDeathCross, SignalBearishBreakout, SignalDistribute:
+		return true
+	}
+	return false
+}
+
+// Direction returns 1 for bullish, -1 for bearish, and 0 for neutral signals
+func (s Signal) Direction() int {
+	switch {
+	case s.IsBullish():
+		return 1
+	case s.IsBearish():
+		return -1
+	default:
+		return 0
+	}
+}
+
+// IsStrong reports whether the signal is a strong action or trend
+func (s Signal) IsStrong() bool {
+	switch s {
+	case SignalStrongBuy, SignalStrongSell, SignalStrongBullish, SignalStrongBearish:
+		return true
+	}
+	return false
+}
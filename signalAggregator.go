@@ -0,0 +1,147 @@
+package techindicators
+
+import (
+	"math"
+)
+
+// Contribution is one indicator's vote in a SignalAggregator
+type Contribution struct {
+	Name       string  `json:"name"`
+	Signal     Signal  `json:"signal"`
+	Weight     float64 `json:"weight"`
+	Confidence float64 `json:"confidence"` // 0-1 scale scaling the weight; 0 counts as fully confident
+
+	// Filled in by Aggregate
+	Direction int     `json:"direction"` // 1 bullish, -1 bearish, 0 neutral
+	Share     float64 `json:"share"`     // Effective weight / total effective weight
+}
+
+// effectiveWeight is the weight scaled by the confidence
+func (c Contribution) effectiveWeight() float64 {
+	if c.Confidence == 0 {
+		return c.Weight
+	}
+	return c.Weight * c.Confidence
+}
+
+// AggregatedSignal is the composite result of a SignalAggregator
+type AggregatedSignal struct {
+	Signal          Signal         `json:"signal"`
+	Confidence      string         `json:"confidence"`
+	RiskLevel       string         `json:"risk_level"`
+	Score           float64        `json:"score"`            // Bullish minus bearish weight share, -1 to 1
+	ConfidenceScore float64        `json:"confidence_score"` // 0-1 scale
+	RiskScore       float64        `json:"risk_score"`       // 0-1 scale, higher is riskier
+	Breakdown       []Contribution `json:"breakdown"`
+}
+
+// SignalAggregator combines indicator signals into a composite signal by weighted vote.
+// BuyVotes and StrongVotes are thresholds in votes out of the number of contributions, applied to the
+// weighted bullish and bearish shares; zero means a simple majority and unanimity respectively.
+type SignalAggregator struct {
+	BuyVotes    int
+	StrongVotes int
+
+	contributions []Contribution
+}
+
+// NewSignalAggregator creates an aggregator with the given vote thresholds (0 for the defaults)
+func NewSignalAggregator(buyVotes, strongVotes int) *SignalAggregator {
+	return &SignalAggregator{BuyVotes: buyVotes, StrongVotes: strongVotes}
+}
+
+// Add records a fully confident vote
+func (a *SignalAggregator) Add(name string, signal Signal, weight float64) {
+	a.AddContribution(Contribution{Name: name, Signal: signal, Weight: weight})
+}
+
+// AddContribution records a vote with its own weight and confidence
+func (a *SignalAggregator) AddContribution(contribution Contribution) {
+	a.contributions = append(a.contributions, contribution)
+}
+
+// AddIndicator computes a SignalIndicator on the dataset and records its vote under Indicator.Name
+func (a *SignalAggregator) AddIndicator(indicator SignalIndicator, dataset []OHLCV, weight float64) error {
+	signal, err := indicator.Signal(dataset)
+	if err != nil {
+		return err
+	}
+	a.Add(indicator.Name(), signal, weight)
+	return nil
+}
+
+// Aggregate combines the recorded votes. Bullish and bearish signals count toward their side; every
+// other signal counts toward the total only, diluting both shares.
+func (a *SignalAggregator) Aggregate() (AggregatedSignal, error) {
+	if len(a.contributions) == 0 {
+		return AggregatedSignal{}, noResults("signal aggregation")
+	}
+
+	breakdown := make([]Contribution, len(a.contributions))
+	var bullishWeight, bearishWeight, totalWeight float64
+
+	for i, contribution := range a.contributions {
+		weight := contribution.effectiveWeight()
+		if weight < 0 {
+			return AggregatedSignal{}, invalidParameter("vote %q has a negative weight", contribution.Name)
+		}
+
+		contribution.Direction = contribution.Signal.Direction()
+		breakdown[i] = contribution
+
+		totalWeight += weight
+		switch contribution.Direction {
+		case 1:
+			bullishWeight += weight
+		case -1:
+			bearishWeight += weight
+		}
+	}
+
+	if totalWeight == 0 {
+		return AggregatedSignal{}, invalidParameter("indicator weights sum to zero")
+	}
+
+	for i := range breakdown {
+		breakdown[i].Share = breakdown[i].effectiveWeight() / totalWeight
+	}
+
+	voters := len(a.contributions)
+	strongVotes, buyVotes := a.StrongVotes, a.BuyVotes
+	if strongVotes == 0 {
+		strongVotes = voters
+	}
+	if buyVotes == 0 {
+		buyVotes = voters/2 + 1
+	}
+
+	bullishShare := bullishWeight / totalWeight
+	bearishShare := bearishWeight / totalWeight
+	strongShare := float64(strongVotes)/float64(voters) - 1e-9
+	buyShare := float64(buyVotes)/float64(voters) - 1e-9
+
+	// Numeric scores: confidence is the weighted share of agreeing indicators, risk leans with the bearish majority
+	score := bullishShare - bearishShare
+	result := AggregatedSignal{
+		Signal:          SignalHold,
+		Confidence:      "LOW",
+		RiskLevel:       "MEDIUM",
+		Score:           score,
+		ConfidenceScore: clampScore(math.Max(bullishShare, bearishShare)),
+		RiskScore:       clampScore(0.5 - 0.5*score),
+		Breakdown:       breakdown,
+	}
+
+	switch {
+	case bullishShare >= strongShare:
+		result.Signal, result.Confidence, result.RiskLevel = SignalStrongBuy, "HIGH", "LOW"
+	case bullishShare >= buyShare:
+		result.Signal, result.Confidence, result.RiskLevel = SignalBuy, "MEDIUM", "LOW"
+	case bearishShare >= strongShare:
+		result.Signal, result.Confidence, result.RiskLevel = SignalStrongSell, "HIGH", "HIGH"
+	case bearishShare >= buyShare:
+		result.Signal, result.Confidence, result.RiskLevel = SignalSell, "MEDIUM", "MEDIUM"
+	}
+
+	return result, nil
+}
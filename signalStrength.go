@@ -0,0 +1,57 @@
+package techindicators
+
+import "math"
+
+// Weights of the signal strength components; components that do not apply are left out and the
+// others rescaled
+const (
+	strengthSignalWeight = 0.3  // Final signal
+	strengthVotesWeight  = 0.35 // Weighted indicator votes
+	strengthVolumeWeight = 0.2  // Volume signal and confirmation
+	strengthRegimeWeight = 0.15 // Market regime, with AnalysisConfig.RegimeSwitching
+)
+
+// signalStrength summarizes the bullish and bearish evidence of an ultimate analysis on a 0-100 scale,
+// 0 the strongest sell, 50 neutral, and 100 the strongest buy. It averages the final signal, the
+// confidence-weighted indicator votes (WeightedScore), the volume signal, and the market regime, each from
+// -1 to 1, and scales the result by the confidence so uncertain signals stay near 50. Confirming volume
+// counts at least half in the signal's direction; wash-traded volume is left out.
+func signalStrength(analysis UltimateMemecoinAnalysis) float64 {
+	var evidence, weights float64
+	add := func(value, weight float64) {
+		evidence += value * weight
+		weights += weight
+	}
+
+	add(signalEvidence(analysis.FinalSignal), strengthSignalWeight)
+	add(analysis.Technical.WeightedScore, strengthVotesWeight)
+
+	if volume := analysis.Volume; volume.Signal != "" && !volume.Quality.WashTrading {
+		value := signalEvidence(volume.Signal)
+		if direction := float64(analysis.FinalSignal.Direction()); analysis.VolumeConfirm && direction != 0 {
+			value = direction * math.Max(math.Abs(value), 0.5)
+		}
+		add(value, strengthVolumeWeight)
+	}
+
+	switch analysis.Technical.Regime {
+	case RegimeTrendingUp:
+		add(1, strengthRegimeWeight)
+	case RegimeTrendingDown:
+		add(-1, strengthRegimeWeight)
+	case RegimeRanging, RegimeVolatile:
+		add(0, strengthRegimeWeight)
+	}
+
+	evidence = evidence / weights * (0.5 + 0.5*analysis.ConfidenceScore)
+	return 100 * clampScore(0.5+0.5*evidence)
+}
+
+// signalEvidence maps a signal to -1 to 1: strong signals count fully, other directional ones half
+func signalEvidence(signal Signal) float64 {
+	value := float64(signal.Direction())
+	if !signal.IsStrong() {
+		value /= 2
+	}
+	return value
+}
@@ -0,0 +1,178 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// SocialVolume is the social activity (mentions, posts) counted from Timestamp, e.g. one sample per hour
+type SocialVolume struct {
+	Timestamp time.Time `json:"timestamp"`
+	Mentions  float64   `json:"mentions"`
+}
+
+// SocialVolumeResult represents social volume analysis result
+type SocialVolumeResult struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Mentions     float64   `json:"mentions"`      // Mentions aligned to the candle
+	MA           float64   `json:"ma"`            // Moving average of the mentions
+	Ratio        float64   `json:"ratio"`         // Mentions relative to the moving average, 0 when it is 0
+	SocialChange float64   `json:"social_change"` // Relative change of the moving average over the period
+	PriceChange  float64   `json:"price_change"`  // Relative price change over the period
+}
+
+// SocialDivergence compares social chatter with the price over the latest period
+type SocialDivergence struct {
+	Type         string  `json:"type"` // bullish (chatter rising, price lagging), bearish (chatter fading, price holding), none
+	SocialChange float64 `json:"social_change"`
+	PriceChange  float64 `json:"price_change"`
+	Confidence   float64 `json:"confidence"` // 0-1 scale
+}
+
+// AlignSocialVolume sums the social samples falling within each candle, from its open up to the next
+// candle's open; the last candle spans the interval before it, and a lone candle everything after its
+// open. Samples outside the candles are dropped and candles without samples get 0. The samples do not
+// need to be sorted.
+func AlignSocialVolume(dataset []OHLCV, social []SocialVolume) ([]float64, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+
+	end, bounded := datasetEnd(dataset)
+	mentions := make([]float64, len(dataset))
+	for _, sample := range social {
+		if math.IsNaN(sample.Mentions) || sample.Mentions < 0 {
+			return nil, invalidParameter("social volume at %s must not be negative, got %v", sample.Timestamp, sample.Mentions)
+		}
+		if bounded && !sample.Timestamp.Before(end) {
+			continue
+		}
+		// Last candle opening at or before the sample
+		i := sort.Search(len(dataset), func(i int) bool { return dataset[i].Timestamp.After(sample.Timestamp) }) - 1
+		if i < 0 {
+			continue
+		}
+		mentions[i] += sample.Mentions
+	}
+	return mentions, nil
+}
+
+// datasetEnd estimates the close of the latest candle from the interval before it; ok is false for fewer
+// than two candles
+func datasetEnd(dataset []OHLCV) (end time.Time, ok bool) {
+	n := len(dataset)
+	if n < 2 {
+		return time.Time{}, false
+	}
+	return dataset[n-1].Timestamp.Add(dataset[n-1].Timestamp.Sub(dataset[n-2].Timestamp)), true
+}
+
+// CalculateSocialVolume aligns the social samples to the candles and computes their moving average and
+// its change against the price change over the same period. Counts are smoothed by one mention in
+// SocialChange so chatter starting from zero stays finite. The first result needs 2*period candles.
+func CalculateSocialVolume(dataset []OHLCV, social []SocialVolume, period int, priceType PriceType) ([]SocialVolumeResult, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+
+	mentions, err := AlignSocialVolume(dataset, social)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dataset) < 2*period {
+		return nil, ErrInsufficientData{Need: 2 * period, Have: len(dataset)}
+	}
+
+	// Moving average of the mentions, valid from index period-1
+	ma := make([]float64, len(dataset))
+	sum := 0.0
+	for i, m := range mentions {
+		sum += m
+		if i >= period {
+			sum -= mentions[i-period]
+		}
+		ma[i] = sum / float64(period)
+	}
+
+	results := make([]SocialVolumeResult, 0, len(dataset)-2*period+1)
+	for i := 2*period - 1; i < len(dataset); i++ {
+		result := SocialVolumeResult{
+			Timestamp:    dataset[i].Timestamp,
+			Mentions:     mentions[i],
+			MA:           ma[i],
+			SocialChange: (ma[i]+1)/(ma[i-period]+1) - 1,
+		}
+		if ma[i] > 0 {
+			result.Ratio = mentions[i] / ma[i]
+		}
+		if base := dataset[i-period].ExtractPrice(priceType); base > 0 {
+			result.PriceChange = dataset[i].ExtractPrice(priceType)/base - 1
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// DetectSocialDivergence compares the latest social and price changes over the period. Chatter rising by
+// at least minChange while the price has not risen is a bullish divergence, as memecoin moves often follow
+// the chatter; chatter falling by minChange while the price holds or rises is bearish, a move the crowd
+// has stopped talking about. A minChange of 0 or less defaults to 0.5.
+func DetectSocialDivergence(dataset []OHLCV, social []SocialVolume, period int, priceType PriceType, minChange float64) (SocialDivergence, error) {
+	if minChange <= 0 {
+		minChange = 0.5
+	}
+
+	results, err := CalculateSocialVolume(dataset, social, period, priceType)
+	if err != nil {
+		return SocialDivergence{}, err
+	}
+
+	latest := results[len(results)-1]
+	divergence := SocialDivergence{Type: "none", SocialChange: latest.SocialChange, PriceChange: latest.PriceChange}
+	switch {
+	case latest.SocialChange >= minChange && latest.PriceChange <= 0:
+		divergence.Type = "bullish"
+	case latest.SocialChange <= -minChange && latest.PriceChange >= 0:
+		divergence.Type = "bearish"
+	default:
+		return divergence, nil
+	}
+	divergence.Confidence = clampScore(math.Abs(latest.SocialChange) / (2 * minChange))
+	return divergence, nil
+}
+
+// SocialVolumeIndicator adapts CalculateSocialVolume to the Indicator interface for a fixed set of samples.
+// The primary value is the moving average; mentions, ratio, and the social and price changes are components.
+type SocialVolumeIndicator struct {
+	Social    []SocialVolume
+	Period    int
+	PriceType PriceType
+}
+
+func (i SocialVolumeIndicator) Name() string    { return fmt.Sprintf("SOCIAL(%d)", i.Period) }
+func (i SocialVolumeIndicator) MinPeriods() int { return 2 * i.Period }
+
+func (i SocialVolumeIndicator) Compute(dataset []OHLCV) ([]Point, error) {
+	results, err := CalculateSocialVolume(dataset, i.Social, i.Period, i.PriceType)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]Point, len(results))
+	for k, r := range results {
+		points[k] = Point{
+			Timestamp: r.Timestamp,
+			Value:     r.MA,
+			Components: map[string]float64{
+				"mentions":      r.Mentions,
+				"ratio":         r.Ratio,
+				"social_change": r.SocialChange,
+				"price_change":  r.PriceChange,
+			},
+		}
+	}
+	return points, nil
+}
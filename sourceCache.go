@@ -0,0 +1,325 @@
+package techindicators
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStore keeps encoded fetch results for CachedSource. Get reports false for missing or expired keys.
+type CacheStore interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// CacheOptions controls how long CachedSource keeps results
+type CacheOptions struct {
+	TTLs          map[time.Duration]time.Duration // TTL per candle interval, overriding the default
+	HistoricalTTL time.Duration                   // TTL for windows that ended before the latest candle opened (default 24 hours)
+	KeyPrefix     string                          // Prepended to every key, e.g. to share a Redis database (default "techindicators:")
+}
+
+// CachedSource wraps a DataSource so that repeated fetches of the same window within the TTL are served
+// from a store instead of the API. By default a window reaching the present is kept for a quarter of the
+// candle interval, clamped to 10 seconds..15 minutes, so the forming candle is never stale for long.
+// Store failures fall back to the wrapped source.
+type CachedSource struct {
+	Source  DataSource
+	Store   CacheStore
+	Options CacheOptions
+}
+
+// NewCachedSource wraps source with an in-memory cache
+func NewCachedSource(source DataSource, opts CacheOptions) *CachedSource {
+	return &CachedSource{Source: source, Store: NewMemoryCacheStore(0), Options: opts}
+}
+
+// Name returns the wrapped source's name
+func (c *CachedSource) Name() string {
+	return c.Source.Name()
+}
+
+// FetchOHLCV returns the cached candles for the request or fetches and stores them
+func (c *CachedSource) FetchOHLCV(ctx context.Context, req FetchRequest) ([]OHLCV, error) {
+	key := c.key(req)
+	if raw, ok, err := c.Store.Get(ctx, key); err == nil && ok {
+		var candles []OHLCV
+		if json.Unmarshal(raw, &candles) == nil {
+			return candles, nil
+		}
+	}
+
+	candles, err := c.Source.FetchOHLCV(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if ttl := c.ttl(req); ttl > 0 {
+		if raw, err := json.Marshal(candles); err == nil {
+			c.Store.Set(ctx, key, raw, ttl)
+		}
+	}
+	return candles, nil
+}
+
+// key identifies a request by source, symbol, interval, window, and limit
+func (c *CachedSource) key(req FetchRequest) string {
+	prefix := c.Options.KeyPrefix
+	if prefix == "" {
+		prefix = "techindicators:"
+	}
+	window := func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+	return fmt.Sprintf("%sohlcv:%s:%s:%s:%s:%s:%d", prefix, c.Source.Name(), req.Symbol,
+		FormatInterval(req.Interval), window(req.Start), window(req.End), req.Limit)
+}
+
+// ttl returns how long the request's result stays fresh
+func (c *CachedSource) ttl(req FetchRequest) time.Duration {
+	if !req.End.IsZero() && req.End.Add(req.Interval).Before(time.Now()) {
+		if c.Options.HistoricalTTL > 0 {
+			return c.Options.HistoricalTTL
+		}
+		return 24 * time.Hour
+	}
+	if ttl, ok := c.Options.TTLs[req.Interval]; ok {
+		return ttl
+	}
+	return min(max(req.Interval/4, 10*time.Second), 15*time.Minute)
+}
+
+// MemoryCacheStore is an in-process CacheStore. It is safe for concurrent use.
+type MemoryCacheStore struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+// memoryCacheEntry is a stored value and its expiry
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCacheStore returns a store holding at most maxEntries values (0 means 1024);
+// when full, expired entries are evicted first, then the one closest to expiring
+func NewMemoryCacheStore(maxEntries int) *MemoryCacheStore {
+	if maxEntries <= 0 {
+		maxEntries = 1024
+	}
+	return &MemoryCacheStore{maxEntries: maxEntries, entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get returns the value if it has not expired
+func (s *MemoryCacheStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if time.Now().After(entry.expires) {
+		delete(s.entries, key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+// Set stores the value until the TTL elapses
+func (s *MemoryCacheStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= s.maxEntries {
+		var oldest string
+		for k, entry := range s.entries {
+			if now.After(entry.expires) {
+				delete(s.entries, k)
+				continue
+			}
+			if oldest == "" || entry.expires.Before(s.entries[oldest].expires) {
+				oldest = k
+			}
+		}
+		if len(s.entries) >= s.maxEntries {
+			delete(s.entries, oldest)
+		}
+	}
+	s.entries[key] = memoryCacheEntry{value: value, expires: now.Add(ttl)}
+	return nil
+}
+
+// RedisCacheStore is a CacheStore on a Redis server, so several processes share one cache.
+// It speaks the RESP protocol directly over a single connection, reconnecting after errors.
+type RedisCacheStore struct {
+	Addr     string // host:port, default localhost:6379
+	Password string // Sent with AUTH when set
+	DB       int    // Selected with SELECT when non-zero
+	Timeout  time.Duration
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// NewRedisCacheStore returns a store on the Redis server at addr
+func NewRedisCacheStore(addr string) *RedisCacheStore {
+	return &RedisCacheStore{Addr: addr}
+}
+
+// Get returns the value with GET
+func (s *RedisCacheStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := s.do(ctx, "GET", key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+	return reply, true, nil
+}
+
+// Set stores the value with SET ... PX ttl
+func (s *RedisCacheStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := s.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(max(ttl.Milliseconds(), 1), 10))
+	return err
+}
+
+// Close closes the connection
+func (s *RedisCacheStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn, s.reader = nil, nil
+	return err
+}
+
+// do sends a command and returns its bulk or simple string reply; a nil bulk reply returns nil
+func (s *RedisCacheStore) do(ctx context.Context, args ...string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return nil, fmt.Errorf("redis: %w", err)
+		}
+	}
+	reply, err := s.roundTrip(ctx, args)
+	if err != nil {
+		if _, isReply := err.(redisError); !isReply {
+			s.conn.Close()
+			s.conn, s.reader = nil, nil
+		}
+		return nil, fmt.Errorf("redis: %w", err)
+	}
+	return reply, nil
+}
+
+// connect dials the server and authenticates
+func (s *RedisCacheStore) connect(ctx context.Context) error {
+	addr := s.Addr
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	dialer := net.Dialer{Timeout: s.timeout()}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.conn, s.reader = conn, bufio.NewReader(conn)
+
+	var setup [][]string
+	if s.Password != "" {
+		setup = append(setup, []string{"AUTH", s.Password})
+	}
+	if s.DB != 0 {
+		setup = append(setup, []string{"SELECT", strconv.Itoa(s.DB)})
+	}
+	for _, args := range setup {
+		if _, err := s.roundTrip(ctx, args); err != nil {
+			conn.Close()
+			s.conn, s.reader = nil, nil
+			return err
+		}
+	}
+	return nil
+}
+
+// timeout returns the per-command deadline (default 5 seconds)
+func (s *RedisCacheStore) timeout() time.Duration {
+	if s.Timeout > 0 {
+		return s.Timeout
+	}
+	return 5 * time.Second
+}
+
+// roundTrip writes one command and reads its reply
+func (s *RedisCacheStore) roundTrip(ctx context.Context, args []string) ([]byte, error) {
+	deadline := time.Now().Add(s.timeout())
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	s.conn.SetDeadline(deadline)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(s.conn, b.String()); err != nil {
+		return nil, err
+	}
+	return readRedisReply(s.reader)
+}
+
+// redisError is an error reply from the server; the connection stays usable
+type redisError string
+
+func (e redisError) Error() string { return string(e) }
+
+// readRedisReply reads a simple string, error, integer, or bulk string reply
+func readRedisReply(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, fmt.Errorf("empty reply")
+	}
+
+	switch line[0] {
+	case '+', ':':
+		return []byte(line[1:]), nil
+	case '-':
+		return nil, redisError(line[1:])
+	case '$':
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("bad bulk length %q", line)
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		return data[:size], nil
+	default:
+		return nil, fmt.Errorf("unexpected reply %q", line)
+	}
+}
@@ -0,0 +1,260 @@
+package techindicators
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SQLiteStore persists candles and signal history keyed by symbol and interval in an embedded SQLite
+// database, so a bot can restart and continue its analysis without downloading everything again.
+// It goes through database/sql, so open the *sql.DB with any SQLite driver (modernc.org/sqlite,
+// mattn/go-sqlite3). Timestamps are stored as Unix milliseconds.
+type SQLiteStore struct {
+	DB *sql.DB
+}
+
+// NewSQLiteStore returns a store on the database; call EnsureSchema once before using it
+func NewSQLiteStore(db *sql.DB) *SQLiteStore {
+	return &SQLiteStore{DB: db}
+}
+
+// EnsureSchema creates the candles and signals tables when they do not exist
+func (s *SQLiteStore) EnsureSchema(ctx context.Context) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS candles (
+			symbol   TEXT NOT NULL,
+			interval TEXT NOT NULL,
+			time     INTEGER NOT NULL,
+			open     REAL,
+			high     REAL,
+			low      REAL,
+			close    REAL,
+			volume   REAL,
+			PRIMARY KEY (symbol, interval, time)
+		) WITHOUT ROWID`,
+		`CREATE TABLE IF NOT EXISTS signals (
+			symbol     TEXT NOT NULL,
+			interval   TEXT NOT NULL,
+			time       INTEGER NOT NULL,
+			signal     TEXT NOT NULL,
+			price      REAL,
+			confidence REAL,
+			PRIMARY KEY (symbol, interval, time)
+		) WITHOUT ROWID`,
+	}
+	for _, statement := range statements {
+		if _, err := s.DB.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("sqlite: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteCandles upserts candles in one transaction, replacing stored candles with the same open time
+func (s *SQLiteStore) WriteCandles(ctx context.Context, symbol string, interval time.Duration, candles []OHLCV) error {
+	query := `INSERT INTO candles (symbol, interval, time, open, high, low, close, volume)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (symbol, interval, time) DO UPDATE SET
+			open = excluded.open, high = excluded.high, low = excluded.low,
+			close = excluded.close, volume = excluded.volume`
+
+	name := FormatInterval(interval)
+	return s.exec(ctx, query, len(candles), func(i int) []any {
+		c := candles[i]
+		return []any{symbol, name, c.Timestamp.UnixMilli(), sqlFloat(c.Open), sqlFloat(c.High), sqlFloat(c.Low), sqlFloat(c.Close), sqlFloat(c.Volume)}
+	})
+}
+
+// WriteSignals upserts journal entries, e.g. from TradeLog.Entries, in one transaction
+func (s *SQLiteStore) WriteSignals(ctx context.Context, symbol string, interval time.Duration, entries []JournalEntry) error {
+	query := `INSERT INTO signals (symbol, interval, time, signal, price, confidence)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (symbol, interval, time) DO UPDATE SET
+			signal = excluded.signal, price = excluded.price, confidence = excluded.confidence`
+
+	name := FormatInterval(interval)
+	return s.exec(ctx, query, len(entries), func(i int) []any {
+		e := entries[i]
+		return []any{symbol, name, e.Timestamp.UnixMilli(), string(e.Signal), sqlFloat(e.Price), sqlFloat(e.ConfidenceScore)}
+	})
+}
+
+// exec runs the statement once per row in a transaction, rolling back on the first error
+func (s *SQLiteStore) exec(ctx context.Context, query string, rows int, args func(i int) []any) error {
+	if s.DB == nil {
+		return invalidParameter("sqlite store has no database")
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("sqlite: %w", err)
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("sqlite: %w", err)
+	}
+	defer statement.Close()
+
+	for i := 0; i < rows; i++ {
+		if _, err := statement.ExecContext(ctx, args(i)...); err != nil {
+			return fmt.Errorf("sqlite: row %d: %w", i, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite: %w", err)
+	}
+	return nil
+}
+
+// StoreQuery selects stored rows of one symbol and interval
+type StoreQuery struct {
+	Symbol   string
+	Interval time.Duration
+	Start    time.Time // Inclusive; zero means no lower bound
+	End      time.Time // Inclusive; zero means no upper bound
+	Limit    int       // Keep only the latest Limit rows; 0 means all
+}
+
+// where returns the filter clause and its arguments
+func (q StoreQuery) where() (string, []any) {
+	clause := "symbol = ? AND interval = ?"
+	args := []any{q.Symbol, FormatInterval(q.Interval)}
+	if !q.Start.IsZero() {
+		clause += " AND time >= ?"
+		args = append(args, q.Start.UnixMilli())
+	}
+	if !q.End.IsZero() {
+		clause += " AND time <= ?"
+		args = append(args, q.End.UnixMilli())
+	}
+	return clause, args
+}
+
+// selectLatest wraps a query so it returns the latest Limit rows in ascending time order
+func (q StoreQuery) selectLatest(columns, table string) (string, []any) {
+	where, args := q.where()
+	query := "SELECT " + columns + " FROM " + table + " WHERE " + where + " ORDER BY time DESC"
+	if q.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, q.Limit)
+	}
+	return "SELECT * FROM (" + query + ") ORDER BY time", args
+}
+
+// Candles returns the stored candles matching the query, oldest first. NULL prices read back as NaN.
+func (s *SQLiteStore) Candles(ctx context.Context, q StoreQuery) ([]OHLCV, error) {
+	query, args := q.selectLatest("time, open, high, low, close, volume", "candles")
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: %w", err)
+	}
+	defer rows.Close()
+
+	var candles []OHLCV
+	for rows.Next() {
+		var millis int64
+		var values [5]sql.NullFloat64
+		if err := rows.Scan(&millis, &values[0], &values[1], &values[2], &values[3], &values[4]); err != nil {
+			return nil, fmt.Errorf("sqlite: %w", err)
+		}
+		candles = append(candles, OHLCV{
+			Timestamp: time.UnixMilli(millis).UTC(),
+			Open:      nullFloat(values[0]),
+			High:      nullFloat(values[1]),
+			Low:       nullFloat(values[2]),
+			Close:     nullFloat(values[3]),
+			Volume:    nullFloat(values[4]),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: %w", err)
+	}
+	return candles, nil
+}
+
+// Signals returns the stored journal entries matching the query, oldest first
+func (s *SQLiteStore) Signals(ctx context.Context, q StoreQuery) ([]JournalEntry, error) {
+	query, args := q.selectLatest("time, signal, price, confidence", "signals")
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []JournalEntry
+	for rows.Next() {
+		var millis int64
+		var signal string
+		var price, confidence sql.NullFloat64
+		if err := rows.Scan(&millis, &signal, &price, &confidence); err != nil {
+			return nil, fmt.Errorf("sqlite: %w", err)
+		}
+		entries = append(entries, JournalEntry{
+			Timestamp:       time.UnixMilli(millis).UTC(),
+			Signal:          Signal(signal),
+			Price:           nullFloat(price),
+			ConfidenceScore: confidence.Float64,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("sqlite: %w", err)
+	}
+	return entries, nil
+}
+
+// LastCandleTime returns the open time of the newest stored candle; ok is false when none is stored
+func (s *SQLiteStore) LastCandleTime(ctx context.Context, symbol string, interval time.Duration) (last time.Time, ok bool, err error) {
+	var millis sql.NullInt64
+	err = s.DB.QueryRowContext(ctx, "SELECT MAX(time) FROM candles WHERE symbol = ? AND interval = ?",
+		symbol, FormatInterval(interval)).Scan(&millis)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("sqlite: %w", err)
+	}
+	if !millis.Valid {
+		return time.Time{}, false, nil
+	}
+	return time.UnixMilli(millis.Int64).UTC(), true, nil
+}
+
+// SyncCandles brings the stored candles up to date from the source and returns the latest req.Limit of them
+// (all when Limit is 0). When candles are already stored only the ones from the newest stored candle onward
+// are fetched, refreshing that candle in case it was still forming; otherwise req is fetched as given.
+func (s *SQLiteStore) SyncCandles(ctx context.Context, source DataSource, req FetchRequest) ([]OHLCV, error) {
+	last, ok, err := s.LastCandleTime(ctx, req.Symbol, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	fetch := req
+	if ok {
+		fetch.Start, fetch.End = last, time.Time{}
+		if req.Interval > 0 {
+			fetch.Limit = int(time.Since(last)/req.Interval) + 2
+		}
+	}
+	candles, err := source.FetchOHLCV(ctx, fetch)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.WriteCandles(ctx, req.Symbol, req.Interval, candles); err != nil {
+		return nil, err
+	}
+
+	return s.Candles(ctx, StoreQuery{Symbol: req.Symbol, Interval: req.Interval, Start: req.Start, End: req.End, Limit: req.Limit})
+}
+
+// nullFloat reads a nullable column, mapping NULL to NaN
+func nullFloat(value sql.NullFloat64) float64 {
+	if !value.Valid {
+		return math.NaN()
+	}
+	return value.Float64
+}
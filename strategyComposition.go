@@ -0,0 +1,286 @@
+package techindicators
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StrategyResult is a strategy's decision on the latest candle of a dataset
+type StrategyResult struct {
+	Name       string           `json:"name"`
+	Signal     Signal           `json:"signal"`
+	Score      float64          `json:"score"`                // Bullish minus bearish evidence, -1 to 1
+	Confidence float64          `json:"confidence"`           // 0-1 scale; 0 when the strategy does not estimate one
+	Components []StrategyResult `json:"components,omitempty"` // Results of the strategies a combinator composed
+}
+
+// StrategyEvaluator is a named strategy that can be composed with AllOf, AnyOf, and Weighted. Run one in
+// Backtest or PaperTrader with AsStrategy, or as a comprehensive analysis vote with WithStrategyVote.
+type StrategyEvaluator interface {
+	Name() string
+	Evaluate(dataset []OHLCV) (StrategyResult, error)
+}
+
+// minStrategyConfidence floors an estimated confidence, since a confidence of 0 means none was estimated
+const minStrategyConfidence = 1e-3
+
+// BollingerEvaluator adapts AnalyzeBollingerStrategy to StrategyEvaluator
+type BollingerEvaluator struct {
+	Period     int
+	Multiplier float64
+	PriceType  PriceType
+}
+
+func (e BollingerEvaluator) Name() string {
+	return BollingerIndicator{Period: e.Period, Multiplier: e.Multiplier}.Name()
+}
+
+func (e BollingerEvaluator) Evaluate(dataset []OHLCV) (StrategyResult, error) {
+	strategy, err := AnalyzeBollingerStrategy(dataset, e.Period, e.Multiplier, e.PriceType)
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	return signalResult(e.Name(), strategy.Signal), nil
+}
+
+// RSIEvaluator adapts AnalyzeRSIStrategy to StrategyEvaluator
+type RSIEvaluator struct {
+	Period    int
+	PriceType PriceType
+}
+
+func (e RSIEvaluator) Name() string { return RSIIndicator{Period: e.Period}.Name() }
+
+func (e RSIEvaluator) Evaluate(dataset []OHLCV) (StrategyResult, error) {
+	strategy, err := AnalyzeRSIStrategy(dataset, e.Period, e.PriceType)
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	result := signalResult(e.Name(), strategy.Signal)
+	if strategy.Divergence.Type != "none" {
+		result.Confidence = max(strategy.Divergence.Confidence, minStrategyConfidence)
+	}
+	return result, nil
+}
+
+// VolumeEvaluator adapts AnalyzeVolumeStrategy to StrategyEvaluator. The confidence is the volume quality
+// score, floored at minStrategyConfidence, so wash-traded volume counts for little in Weighted and AllOf.
+type VolumeEvaluator struct {
+	VMAPeriod  int
+	VROCPeriod int
+}
+
+func (e VolumeEvaluator) Name() string {
+	return VolumeIndicator{VMAPeriod: e.VMAPeriod, VROCPeriod: e.VROCPeriod}.Name()
+}
+
+func (e VolumeEvaluator) Evaluate(dataset []OHLCV) (StrategyResult, error) {
+	strategy, err := AnalyzeVolumeStrategy(dataset, e.VMAPeriod, e.VROCPeriod)
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	result := signalResult(e.Name(), strategy.Signal)
+	if quality := strategy.Quality; quality.Score > 0 || quality.WashTrading {
+		result.Confidence = max(quality.Score, minStrategyConfidence)
+	}
+	return result, nil
+}
+
+// NamedStrategy adapts a Strategy function, e.g. ComprehensiveStrategy, to StrategyEvaluator
+func NamedStrategy(name string, strategy Strategy) StrategyEvaluator {
+	return namedStrategy{name: name, strategy: strategy}
+}
+
+type namedStrategy struct {
+	name     string
+	strategy Strategy
+}
+
+func (s namedStrategy) Name() string { return s.name }
+
+func (s namedStrategy) Evaluate(dataset []OHLCV) (StrategyResult, error) {
+	signal, err := s.strategy(dataset)
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	return signalResult(s.name, signal), nil
+}
+
+// AsStrategy returns the evaluator's signal as a Strategy for Backtest, PaperTrader, and the optimizers
+func AsStrategy(evaluator StrategyEvaluator) Strategy {
+	return func(history []OHLCV) (Signal, error) {
+		result, err := evaluator.Evaluate(history)
+		if err != nil {
+			return "", err
+		}
+		return result.Signal, nil
+	}
+}
+
+// WithStrategyVote adds a strategy, e.g. a composed one, as a weighted vote in the comprehensive analysis
+func WithStrategyVote(evaluator StrategyEvaluator, weight float64) AnalysisOption {
+	return WithVote(evaluator.Name(), weight, AsStrategy(evaluator))
+}
+
+// signalResult is the result of a strategy that only reports a signal
+func signalResult(name string, signal Signal) StrategyResult {
+	return StrategyResult{Name: name, Signal: signal, Score: signalEvidence(signal)}
+}
+
+// AllOf returns a strategy that is bullish only when every strategy is bullish and bearish only when every
+// one is bearish, strong when all of them are strong; otherwise it holds. Its confidence is the lowest one.
+func AllOf(strategies ...StrategyEvaluator) StrategyEvaluator {
+	return combinedStrategy{kind: "ALL", strategies: strategies, combine: combineAll}
+}
+
+// AnyOf returns a strategy that follows any directional strategy as long as none points the other way,
+// strong when any of the agreeing ones is strong; conflicting or neutral strategies hold. Its confidence
+// is the highest of the agreeing ones.
+func AnyOf(strategies ...StrategyEvaluator) StrategyEvaluator {
+	return combinedStrategy{kind: "ANY", strategies: strategies, combine: combineAny}
+}
+
+// WeightedStrategy is one strategy of Weighted with its vote weight
+type WeightedStrategy struct {
+	Strategy StrategyEvaluator
+	Weight   float64
+}
+
+// Weighted returns a strategy that combines the signals by weighted vote, like the comprehensive analysis:
+// a bullish or bearish weight share above half gives a regular signal and a unanimous one a strong signal.
+// Each weight is scaled by the strategy's confidence when it reports one.
+func Weighted(strategies ...WeightedStrategy) StrategyEvaluator {
+	evaluators := make([]StrategyEvaluator, len(strategies))
+	weights := make([]float64, len(strategies))
+	for i, s := range strategies {
+		evaluators[i], weights[i] = s.Strategy, s.Weight
+	}
+	return combinedStrategy{kind: "WEIGHTED", strategies: evaluators, combine: func(results []StrategyResult) (StrategyResult, error) {
+		return combineWeighted(results, weights)
+	}}
+}
+
+type combinedStrategy struct {
+	kind       string
+	strategies []StrategyEvaluator
+	combine    func(results []StrategyResult) (StrategyResult, error)
+}
+
+func (s combinedStrategy) Name() string {
+	names := make([]string, len(s.strategies))
+	for i, strategy := range s.strategies {
+		if strategy != nil {
+			names[i] = strategy.Name()
+		}
+	}
+	return s.kind + "(" + strings.Join(names, ", ") + ")"
+}
+
+// Evaluate runs every strategy on the dataset and combines their results; the first error stops it
+func (s combinedStrategy) Evaluate(dataset []OHLCV) (StrategyResult, error) {
+	if len(s.strategies) == 0 {
+		return StrategyResult{}, invalidParameter("%s needs at least one strategy", s.kind)
+	}
+
+	results := make([]StrategyResult, len(s.strategies))
+	for i, strategy := range s.strategies {
+		if strategy == nil {
+			return StrategyResult{}, invalidParameter("%s strategy %d is nil", s.kind, i)
+		}
+		result, err := strategy.Evaluate(dataset)
+		if err != nil {
+			return StrategyResult{}, fmt.Errorf("%s: %w", strategy.Name(), err)
+		}
+		results[i] = result
+	}
+
+	combined, err := s.combine(results)
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	combined.Name = s.Name()
+	combined.Components = results
+	return combined, nil
+}
+
+// combineAll requires every result to agree
+func combineAll(results []StrategyResult) (StrategyResult, error) {
+	direction, strong := results[0].Signal.Direction(), true
+	confidence := 1.0
+	for _, r := range results {
+		if r.Signal.Direction() != direction {
+			direction = 0
+		}
+		strong = strong && r.Signal.IsStrong()
+		confidence = min(confidence, resultConfidence(r))
+	}
+	return directionalResult(direction, strong, meanScore(results), confidence), nil
+}
+
+// combineAny follows the directional results unless they conflict
+func combineAny(results []StrategyResult) (StrategyResult, error) {
+	direction, strong := 0, false
+	confidence := 0.0
+	for _, r := range results {
+		d := r.Signal.Direction()
+		switch {
+		case d == 0:
+			continue
+		case direction != 0 && d != direction:
+			return directionalResult(0, false, meanScore(results), 0), nil
+		}
+		direction = d
+		strong = strong || r.Signal.IsStrong()
+		confidence = max(confidence, resultConfidence(r))
+	}
+	return directionalResult(direction, strong, meanScore(results), confidence), nil
+}
+
+// combineWeighted votes with a SignalAggregator
+func combineWeighted(results []StrategyResult, weights []float64) (StrategyResult, error) {
+	aggregator := NewSignalAggregator(0, 0)
+	for i, r := range results {
+		aggregator.AddContribution(Contribution{Name: r.Name, Signal: r.Signal, Weight: weights[i], Confidence: r.Confidence})
+	}
+	aggregated, err := aggregator.Aggregate()
+	if err != nil {
+		return StrategyResult{}, err
+	}
+	return StrategyResult{Signal: aggregated.Signal, Score: aggregated.Score, Confidence: aggregated.ConfidenceScore}, nil
+}
+
+// directionalResult builds the signal of a combined result; a zero direction holds
+func directionalResult(direction int, strong bool, score, confidence float64) StrategyResult {
+	result := StrategyResult{Signal: SignalHold, Score: score}
+	switch {
+	case direction > 0 && strong:
+		result.Signal = SignalStrongBuy
+	case direction > 0:
+		result.Signal = SignalBuy
+	case direction < 0 && strong:
+		result.Signal = SignalStrongSell
+	case direction < 0:
+		result.Signal = SignalSell
+	}
+	if direction != 0 {
+		result.Confidence = confidence
+	}
+	return result
+}
+
+// resultConfidence reads a result's confidence, counting an unestimated one as full
+func resultConfidence(result StrategyResult) float64 {
+	if result.Confidence == 0 {
+		return 1
+	}
+	return result.Confidence
+}
+
+// meanScore averages the scores of the results
+func meanScore(results []StrategyResult) float64 {
+	var sum float64
+	for _, r := range results {
+		sum += r.Score
+	}
+	return sum / float64(len(results))
+}
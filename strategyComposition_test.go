@@ -0,0 +1,25 @@
+package techindicators
+
+import "testing"
+
+func TestVolumeEvaluatorWashTradedConfidence(t *testing.T) {
+	dataset, err := GenerateOHLCV(SyntheticConfig{Candles: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range dataset {
+		dataset[i].Volume = 1000 // Every candle repeats the previous size
+	}
+
+	volume := VolumeEvaluator{VMAPeriod: 20, VROCPeriod: 5}
+	result, err := volume.Evaluate(dataset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Confidence <= 0 || result.Confidence > minStrategyConfidence {
+		t.Fatalf("Confidence = %v; want the floor %v", result.Confidence, minStrategyConfidence)
+	}
+	if got := resultConfidence(result); got != minStrategyConfidence {
+		t.Fatalf("resultConfidence = %v; want %v", got, minStrategyConfidence)
+	}
+}
@@ -0,0 +1,336 @@
+package techindicators
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// StreamingIndicator updates an indicator one closed candle at a time without replaying history.
+// Its state can be persisted with Snapshot and loaded into a fresh instance with Restore, so a live
+// bot can resume after a restart. Candles not after the last processed timestamp are ignored, which
+// makes replaying an overlapping window after Restore safe.
+type StreamingIndicator interface {
+	Name() string
+	// Update consumes a candle and returns the latest value; ready is false during warm-up
+	Update(candle OHLCV) (value float64, ready bool)
+	// Value returns the latest value without consuming a candle
+	Value() (value float64, ready bool)
+	Snapshot() ([]byte, error)
+	Restore(snapshot []byte) error
+}
+
+// streamSnapshot is the serialized form shared by all streaming indicators
+type streamSnapshot struct {
+	Type  string          `json:"type"`
+	State json.RawMessage `json:"state"`
+}
+
+// marshalStream wraps an indicator state with its type
+func marshalStream(kind string, state any) ([]byte, error) {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(streamSnapshot{Type: kind, State: raw})
+}
+
+// unmarshalStream decodes a snapshot into state after checking its type
+func unmarshalStream(snapshot []byte, kind string, state any) error {
+	var wrapper streamSnapshot
+	if err := json.Unmarshal(snapshot, &wrapper); err != nil {
+		return fmt.Errorf("%w: invalid snapshot: %w", ErrInvalidParameter, err)
+	}
+	if wrapper.Type != kind {
+		return invalidParameter("snapshot of %q cannot restore %q", wrapper.Type, kind)
+	}
+	if err := json.Unmarshal(wrapper.State, state); err != nil {
+		return fmt.Errorf("%w: invalid %s snapshot: %w", ErrInvalidParameter, kind, err)
+	}
+	return nil
+}
+
+// isNewCandle reports whether the candle comes after the last processed one
+func isNewCandle(last time.Time, candle OHLCV) bool {
+	return last.IsZero() || candle.Timestamp.After(last)
+}
+
+// StreamingSMAState is the persisted state of a StreamingSMA
+type StreamingSMAState struct {
+	Period        int       `json:"period"`
+	PriceType     PriceType `json:"price_type"`
+	Window        []float64 `json:"window"` // Ring buffer of the last Period prices
+	Head          int       `json:"head"`   // Next slot to overwrite
+	Count         int       `json:"count"`
+	Sum           float64   `json:"sum"`
+	LastTimestamp time.Time `json:"last_timestamp"`
+}
+
+// StreamingSMA is an incremental CalculateSMA
+type StreamingSMA struct {
+	state StreamingSMAState
+}
+
+// NewStreamingSMA creates a streaming SMA
+func NewStreamingSMA(period int, priceType PriceType) (*StreamingSMA, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+	return &StreamingSMA{state: StreamingSMAState{Period: period, PriceType: priceType, Window: make([]float64, period)}}, nil
+}
+
+func (s *StreamingSMA) Name() string { return fmt.Sprintf("SMA(%d)", s.state.Period) }
+
+func (s *StreamingSMA) Update(candle OHLCV) (float64, bool) {
+	st := &s.state
+	if !isNewCandle(st.LastTimestamp, candle) {
+		return s.Value()
+	}
+	st.LastTimestamp = candle.Timestamp
+
+	price, leaving := candle.ExtractPrice(st.PriceType), st.Window[st.Head]
+	st.Sum += price - leaving
+	st.Window[st.Head] = price
+	st.Head = (st.Head + 1) % st.Period
+	st.Count = min(st.Count+1, st.Period)
+
+	if rebuildWindow(st.Head, st.Period) || nonFinite(leaving) {
+		st.Sum = 0
+		for _, v := range st.Window {
+			st.Sum += v
+		}
+	}
+	return s.Value()
+}
+
+func (s *StreamingSMA) Value() (float64, bool) {
+	if s.state.Count < s.state.Period {
+		return 0, false
+	}
+	return s.state.Sum / float64(s.state.Period), true
+}
+
+func (s *StreamingSMA) Snapshot() ([]byte, error) { return marshalStream("sma", s.state) }
+
+func (s *StreamingSMA) Restore(snapshot []byte) error {
+	var state StreamingSMAState
+	if err := unmarshalStream(snapshot, "sma", &state); err != nil {
+		return err
+	}
+	if state.Period <= 0 || len(state.Window) != state.Period || state.Head < 0 || state.Head >= state.Period ||
+		state.Count < 0 || state.Count > state.Period {
+		return invalidParameter("inconsistent SMA snapshot")
+	}
+	s.state = state
+	return nil
+}
+
+// StreamingEMAState is the persisted state of a StreamingEMA
+type StreamingEMAState struct {
+	Period        int       `json:"period"`
+	PriceType     PriceType `json:"price_type"`
+	Count         int       `json:"count"`
+	SeedSum       float64   `json:"seed_sum"` // Sum of the first Period prices, used for the SMA seed
+	EMA           float64   `json:"ema"`
+	LastTimestamp time.Time `json:"last_timestamp"`
+}
+
+// StreamingEMA is an incremental CalculateEMA
+type StreamingEMA struct {
+	state StreamingEMAState
+}
+
+// NewStreamingEMA creates a streaming EMA
+func NewStreamingEMA(period int, priceType PriceType) (*StreamingEMA, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+	return &StreamingEMA{state: StreamingEMAState{Period: period, PriceType: priceType}}, nil
+}
+
+func (s *StreamingEMA) Name() string { return fmt.Sprintf("EMA(%d)", s.state.Period) }
+
+func (s *StreamingEMA) Update(candle OHLCV) (float64, bool) {
+	st := &s.state
+	if !isNewCandle(st.LastTimestamp, candle) {
+		return s.Value()
+	}
+	st.LastTimestamp = candle.Timestamp
+
+	price := candle.ExtractPrice(st.PriceType)
+	st.Count++
+	switch {
+	case st.Count < st.Period:
+		st.SeedSum += price
+	case st.Count == st.Period:
+		st.SeedSum += price
+		st.EMA = st.SeedSum / float64(st.Period)
+	default:
+		st.EMA += 2 / float64(st.Period+1) * (price - st.EMA)
+	}
+	return s.Value()
+}
+
+func (s *StreamingEMA) Value() (float64, bool) {
+	if s.state.Count < s.state.Period {
+		return 0, false
+	}
+	return s.state.EMA, true
+}
+
+func (s *StreamingEMA) Snapshot() ([]byte, error) { return marshalStream("ema", s.state) }
+
+func (s *StreamingEMA) Restore(snapshot []byte) error {
+	var state StreamingEMAState
+	if err := unmarshalStream(snapshot, "ema", &state); err != nil {
+		return err
+	}
+	if state.Period <= 0 || state.Count < 0 {
+		return invalidParameter("inconsistent EMA snapshot")
+	}
+	s.state = state
+	return nil
+}
+
+// StreamingRSIState is the persisted state of a StreamingRSI
+type StreamingRSIState struct {
+	Period        int       `json:"period"`
+	PriceType     PriceType `json:"price_type"`
+	PrevPrice     float64   `json:"prev_price"`
+	Changes       int       `json:"changes"`  // Price changes seen so far
+	AvgGain       float64   `json:"avg_gain"` // Sum of gains until Period changes, then Wilder average
+	AvgLoss       float64   `json:"avg_loss"`
+	LastTimestamp time.Time `json:"last_timestamp"`
+}
+
+// StreamingRSI is an incremental CalculateRSI using Wilder's smoothing
+type StreamingRSI struct {
+	state StreamingRSIState
+}
+
+// NewStreamingRSI creates a streaming RSI
+func NewStreamingRSI(period int, priceType PriceType) (*StreamingRSI, error) {
+	if period <= 0 {
+		return nil, invalidPeriod("period must be greater than 0")
+	}
+	return &StreamingRSI{state: StreamingRSIState{Period: period, PriceType: priceType}}, nil
+}
+
+func (s *StreamingRSI) Name() string { return fmt.Sprintf("RSI(%d)", s.state.Period) }
+
+func (s *StreamingRSI) Update(candle OHLCV) (float64, bool) {
+	st := &s.state
+	if !isNewCandle(st.LastTimestamp, candle) {
+		return s.Value()
+	}
+	first := st.LastTimestamp.IsZero()
+	st.LastTimestamp = candle.Timestamp
+
+	price := candle.ExtractPrice(st.PriceType)
+	if first {
+		st.PrevPrice = price
+		return s.Value()
+	}
+
+	change := price - st.PrevPrice
+	st.PrevPrice = price
+	gain, loss := max(change, 0), max(-change, 0)
+
+	st.Changes++
+	period := float64(st.Period)
+	switch {
+	case st.Changes < st.Period:
+		st.AvgGain += gain
+		st.AvgLoss += loss
+	case st.Changes == st.Period:
+		st.AvgGain = (st.AvgGain + gain) / period
+		st.AvgLoss = (st.AvgLoss + loss) / period
+	default:
+		st.AvgGain = (st.AvgGain*(period-1) + gain) / period
+		st.AvgLoss = (st.AvgLoss*(period-1) + loss) / period
+	}
+	return s.Value()
+}
+
+func (s *StreamingRSI) Value() (float64, bool) {
+	st := s.state
+	if st.Changes < st.Period {
+		return 0, false
+	}
+	rs := st.AvgGain / st.AvgLoss
+	if st.AvgLoss == 0 {
+		rs = 100 // Matches CalculateRSI
+	}
+	return 100 - (100 / (1 + rs)), true
+}
+
+func (s *StreamingRSI) Snapshot() ([]byte, error) { return marshalStream("rsi", s.state) }
+
+func (s *StreamingRSI) Restore(snapshot []byte) error {
+	var state StreamingRSIState
+	if err := unmarshalStream(snapshot, "rsi", &state); err != nil {
+		return err
+	}
+	if state.Period <= 0 || state.Changes < 0 {
+		return invalidParameter("inconsistent RSI snapshot")
+	}
+	s.state = state
+	return nil
+}
+
+// StreamingOBVState is the persisted state of a StreamingOBV
+type StreamingOBVState struct {
+	OBV           float64   `json:"obv"`
+	PrevClose     float64   `json:"prev_close"`
+	LastTimestamp time.Time `json:"last_timestamp"`
+}
+
+// StreamingOBV is a running On-Balance Volume total that starts at the first candle's volume and counts
+// every later candle. The batch OBV of CalculateVolumeAnalysis also starts at the first volume but only
+// counts the candles from its first result on, so the two differ by a constant: the signed volumes of the
+// warm-up candles. Their changes, and so OBV trends and divergences, match.
+type StreamingOBV struct {
+	state StreamingOBVState
+}
+
+// NewStreamingOBV creates a streaming OBV
+func NewStreamingOBV() *StreamingOBV {
+	return &StreamingOBV{}
+}
+
+func (s *StreamingOBV) Name() string { return "OBV" }
+
+func (s *StreamingOBV) Update(candle OHLCV) (float64, bool) {
+	st := &s.state
+	if !isNewCandle(st.LastTimestamp, candle) {
+		return s.Value()
+	}
+	first := st.LastTimestamp.IsZero()
+	st.LastTimestamp = candle.Timestamp
+
+	switch {
+	case first:
+		st.OBV = candle.Volume
+	case candle.Close > st.PrevClose:
+		st.OBV += candle.Volume
+	case candle.Close < st.PrevClose:
+		st.OBV -= candle.Volume
+	}
+	st.PrevClose = candle.Close
+	return s.Value()
+}
+
+func (s *StreamingOBV) Value() (float64, bool) {
+	return s.state.OBV, !s.state.LastTimestamp.IsZero()
+}
+
+func (s *StreamingOBV) Snapshot() ([]byte, error) { return marshalStream("obv", s.state) }
+
+func (s *StreamingOBV) Restore(snapshot []byte) error {
+	var state StreamingOBVState
+	if err := unmarshalStream(snapshot, "obv", &state); err != nil {
+		return err
+	}
+	s.state = state
+	return nil
+}
@@ -0,0 +1,43 @@
+package techindicators
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestStreamingOBVMatchesBatchChanges(t *testing.T) {
+	dataset := syntheticCandles(t, 200)
+	results, err := CalculateVolumeAnalysis(dataset, 20, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	obv := NewStreamingOBV()
+	streamed := make([]float64, len(dataset))
+	for i, candle := range dataset {
+		streamed[i], _ = obv.Update(candle)
+	}
+
+	offset := len(dataset) - len(results) // Dataset index of the first batch result
+	difference := streamed[offset] - results[0].OBV
+	for i, r := range results {
+		assertClose(t, "OBV offset", i, streamed[offset+i]-r.OBV, difference, math.Abs(r.OBV)+r.Volume)
+	}
+}
+
+func TestStreamingSMARestoreRejectsCount(t *testing.T) {
+	sma, err := NewStreamingSMA(3, ClosePrice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, count := range []int{-1, 4} {
+		snapshot, err := marshalStream("sma", StreamingSMAState{Period: 3, Window: make([]float64, 3), Count: count})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sma.Restore(snapshot); !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("Restore with count %d error = %v; want ErrInvalidParameter", count, err)
+		}
+	}
+}
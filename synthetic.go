@@ -0,0 +1,212 @@
+package techindicators
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// SyntheticScenario selects the price process of GenerateOHLCV
+type SyntheticScenario string
+
+const (
+	GBMScenario             SyntheticScenario = "gbm"           // Geometric Brownian motion with constant drift and volatility
+	RegimeSwitchingScenario SyntheticScenario = "regime"        // Markov switching between market regimes (bull, bear, sideways by default)
+	PumpAndDumpScenario     SyntheticScenario = "pump_and_dump" // Quiet accumulation, a high-volume pump, a crash, and an illiquid tail
+)
+
+// MarketRegime is one state of the regime-switching scenario. Drift and Volatility are per candle log-return
+// parameters; VolumeMultiplier scales the base volume.
+type MarketRegime struct {
+	Name             string  `json:"name"`
+	Drift            float64 `json:"drift"`
+	Volatility       float64 `json:"volatility"`
+	VolumeMultiplier float64 `json:"volume_multiplier"`
+}
+
+// defaultRegimes are used when SyntheticConfig.Regimes is empty
+var defaultRegimes = []MarketRegime{
+	{Name: "bull", Drift: 0.004, Volatility: 0.02, VolumeMultiplier: 1.5},
+	{Name: "bear", Drift: -0.004, Volatility: 0.03, VolumeMultiplier: 1.2},
+	{Name: "sideways", Drift: 0, Volatility: 0.01, VolumeMultiplier: 0.7},
+}
+
+// SyntheticConfig configures GenerateOHLCV. Zero fields take the defaults in brackets.
+type SyntheticConfig struct {
+	Scenario   SyntheticScenario `json:"scenario"`    // [gbm]
+	Candles    int               `json:"candles"`     // Number of candles to generate (required)
+	Start      time.Time         `json:"start"`       // Open time of the first candle [2024-01-01 UTC]
+	Interval   time.Duration     `json:"interval"`    // [1 hour]
+	StartPrice float64           `json:"start_price"` // [1]
+	Drift      float64           `json:"drift"`       // Per candle log-return drift for gbm [0]
+	Volatility float64           `json:"volatility"`  // Per candle log-return standard deviation for gbm and pump_and_dump [0.02]
+	BaseVolume float64           `json:"base_volume"` // Typical volume of a quiet candle [1000]
+	Seed       int64             `json:"seed"`        // Equal seeds give equal candles
+
+	Regimes         []MarketRegime `json:"regimes"`           // [bull, bear, sideways]
+	SwitchChance    float64        `json:"switch_chance"`     // Chance per candle of moving to another regime [0.02]
+	PumpStart       int            `json:"pump_start"`        // Candle where the pump begins [60% of Candles]
+	PumpLength      int            `json:"pump_length"`       // [10]
+	PumpGain        float64        `json:"pump_gain"`         // Rise over the pump, 3 = +300% [3]
+	DumpLength      int            `json:"dump_length"`       // [4]
+	DumpLoss        float64        `json:"dump_loss"`         // Fraction of the peak lost over the dump [0.85]
+	PumpVolumeSpike float64        `json:"pump_volume_spike"` // Volume multiplier during the pump and dump [8]
+}
+
+// withDefaults fills zero fields
+func (c SyntheticConfig) withDefaults() SyntheticConfig {
+	if c.Scenario == "" {
+		c.Scenario = GBMScenario
+	}
+	if c.Start.IsZero() {
+		c.Start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if c.Interval == 0 {
+		c.Interval = time.Hour
+	}
+	if c.StartPrice == 0 {
+		c.StartPrice = 1
+	}
+	if c.Volatility == 0 {
+		c.Volatility = 0.02
+	}
+	if c.BaseVolume == 0 {
+		c.BaseVolume = 1000
+	}
+	if len(c.Regimes) == 0 {
+		c.Regimes = defaultRegimes
+	}
+	if c.SwitchChance == 0 {
+		c.SwitchChance = 0.02
+	}
+	if c.PumpLength == 0 {
+		c.PumpLength = 10
+	}
+	if c.PumpStart == 0 {
+		c.PumpStart = c.Candles * 6 / 10
+	}
+	if c.PumpGain == 0 {
+		c.PumpGain = 3
+	}
+	if c.DumpLength == 0 {
+		c.DumpLength = 4
+	}
+	if c.DumpLoss == 0 {
+		c.DumpLoss = 0.85
+	}
+	if c.PumpVolumeSpike == 0 {
+		c.PumpVolumeSpike = 8
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c SyntheticConfig) validate() error {
+	switch {
+	case c.Candles <= 0:
+		return invalidParameter("candles must be greater than 0, got %d", c.Candles)
+	case c.Interval <= 0:
+		return invalidParameter("interval must be positive, got %s", c.Interval)
+	case c.StartPrice <= 0 || math.IsNaN(c.StartPrice) || math.IsInf(c.StartPrice, 0):
+		return invalidPrice("start price must be positive and finite, got %v", c.StartPrice)
+	case c.Volatility < 0 || c.BaseVolume < 0:
+		return invalidParameter("volatility and base volume must not be negative")
+	case c.SwitchChance < 0 || c.SwitchChance > 1:
+		return invalidParameter("switch chance must be between 0 and 1, got %v", c.SwitchChance)
+	case c.PumpStart < 0 || c.PumpLength < 0 || c.DumpLength < 0:
+		return invalidParameter("pump start and pump/dump lengths must not be negative")
+	case c.PumpGain <= -1 || c.DumpLoss < 0 || c.DumpLoss >= 1:
+		return invalidParameter("pump gain must be above -1 and dump loss between 0 and 1")
+	}
+	for _, regime := range c.Regimes {
+		if regime.Volatility < 0 || regime.VolumeMultiplier < 0 {
+			return invalidParameter("regime %q has a negative volatility or volume multiplier", regime.Name)
+		}
+	}
+	switch c.Scenario {
+	case GBMScenario, RegimeSwitchingScenario, PumpAndDumpScenario:
+		return nil
+	}
+	return invalidParameter("unknown synthetic scenario %q", c.Scenario)
+}
+
+// GenerateOHLCV produces synthetic candles for testing strategies without market data. Each candle opens at
+// the previous close; its wicks and volume grow with the size of the move, so volume-based indicators react
+// as they would on real data. Output is deterministic for a given configuration and seed.
+func GenerateOHLCV(config SyntheticConfig) ([]OHLCV, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(config.Seed))
+	regime := rng.Intn(len(config.Regimes))
+	price := config.StartPrice
+
+	candles := make([]OHLCV, config.Candles)
+	for i := range candles {
+		drift, volatility, volume := config.Drift, config.Volatility, 1.0
+
+		switch config.Scenario {
+		case RegimeSwitchingScenario:
+			if len(config.Regimes) > 1 && rng.Float64() < config.SwitchChance {
+				next := rng.Intn(len(config.Regimes) - 1)
+				if next >= regime {
+					next++
+				}
+				regime = next
+			}
+			r := config.Regimes[regime]
+			drift, volatility, volume = r.Drift, r.Volatility, r.VolumeMultiplier
+
+		case PumpAndDumpScenario:
+			drift, volatility, volume = pumpAndDumpPhase(config, i)
+		}
+
+		ret := drift + volatility*rng.NormFloat64()
+		open := price
+		price *= math.Exp(ret)
+
+		// Wicks extend past the body by a half-normal share of the candle's volatility
+		high := math.Max(open, price) * math.Exp(math.Abs(rng.NormFloat64())*volatility/2)
+		low := math.Min(open, price) * math.Exp(-math.Abs(rng.NormFloat64())*volatility/2)
+
+		// Log-normal noise, scaled up on large moves relative to the regime's volatility
+		move := 0.0
+		if volatility > 0 {
+			move = math.Abs(ret-drift) / volatility
+		}
+		volume *= config.BaseVolume * math.Exp(0.3*rng.NormFloat64()) * (1 + 0.5*move)
+
+		candles[i] = OHLCV{
+			Timestamp: config.Start.Add(time.Duration(i) * config.Interval),
+			Open:      open,
+			High:      high,
+			Low:       low,
+			Close:     price,
+			Volume:    volume,
+		}
+	}
+	return candles, nil
+}
+
+// pumpAndDumpPhase returns the drift, volatility, and volume multiplier of candle i in the pump-and-dump scenario
+func pumpAndDumpPhase(config SyntheticConfig, i int) (drift, volatility, volume float64) {
+	pumpEnd := config.PumpStart + config.PumpLength
+	dumpEnd := pumpEnd + config.DumpLength
+
+	switch {
+	case i < config.PumpStart:
+		// Quiet accumulation with a slight upward bias
+		return 0.0005, config.Volatility * 0.5, 0.6
+	case i < pumpEnd:
+		// Volume ramps up towards the top
+		progress := float64(i-config.PumpStart+1) / float64(config.PumpLength)
+		return math.Log1p(config.PumpGain) / float64(config.PumpLength), config.Volatility * 2, 1 + (config.PumpVolumeSpike-1)*progress
+	case i < dumpEnd:
+		return math.Log1p(-config.DumpLoss) / float64(config.DumpLength), config.Volatility * 3, config.PumpVolumeSpike
+	default:
+		// Liquidity has left: slow bleed on thin volume
+		return -0.002, config.Volatility * 0.7, 0.2
+	}
+}
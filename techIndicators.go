@@ -1,63 +1,204 @@
 package techindicators
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
+	"sync"
+	"time"
 )
 
 // UltimateMemecoinAnalysis combines all indicators with volume confirmation
 type UltimateMemecoinAnalysis struct {
 	Technical     CombinedTechnicalAnalysis `json:"technical"`
 	Volume        VolumeStrategy            `json:"volume"`
-	FinalSignal   string                    `json:"final_signal"`
+	FinalSignal   Signal                    `json:"final_signal"`
 	Confidence    string                    `json:"confidence"`
 	RiskLevel     string                    `json:"risk_level"`
 	RugPullRisk   string                    `json:"rug_pull_risk"`  // low, medium, high, extreme
 	VolumeConfirm bool                      `json:"volume_confirm"` // true if volume confirms signal
+
+	ConfidenceScore float64 `json:"confidence_score"` // 0-1 scale
+	RiskScore       float64 `json:"risk_score"`       // 0-1 scale, higher is riskier
+	SignalStrength  float64 `json:"signal_strength"`  // 0-100 scale, 0 strongest sell, 50 neutral, 100 strongest buy
+
+	RugPullScore   float64         `json:"rug_pull_score"` // 0-1 scale from AssessRugPullRisk
+	RugPullReasons []RugPullReason `json:"rug_pull_reasons,omitempty"`
+
+	// Untradeable conditions from DetectUntradeable, ApplyMarketContext, or ApplyRugPullAssessment
+	Honeypot           bool                `json:"honeypot"`
+	Illiquid           bool                `json:"illiquid"`
+	TradabilityReasons []TradabilityReason `json:"tradability_reasons,omitempty"`
+
+	Manipulation *ManipulationReport `json:"manipulation,omitempty"` // Set when the candles carry trade counts or quotes
+	Liquidation  *LiquidationCascade `json:"liquidation,omitempty"`  // Set when the latest candles were a liquidation cascade
+
+	YoungToken *YoungTokenMode `json:"young_token,omitempty"` // Set when AnalysisConfig.YoungToken shrank the periods
+
+	Timeframes *MultiTimeframeAnalysis `json:"timeframes,omitempty"` // Set when ConfirmTimeframes or HigherTimeframe is configured
 }
 
 // UltimateAnalysis provides the most comprehensive memecoin analysis
 func UltimateAnalysis(dataset []OHLCV, smaPeriod, bbPeriod, rsiPeriod, vmaPeriod int, bbMultiplier float64) (UltimateMemecoinAnalysis, error) {
-	// Get technical analysis
-	technical, err := ComprehensiveAnalysis(dataset, smaPeriod, bbPeriod, rsiPeriod, bbMultiplier, ClosePrice)
+	return UltimateAnalysisWithConfig(dataset, AnalysisConfig{
+		SMAPeriod:    smaPeriod,
+		BBPeriod:     bbPeriod,
+		BBMultiplier: bbMultiplier,
+		RSIPeriod:    rsiPeriod,
+		VMAPeriod:    vmaPeriod,
+		PriceType:    ClosePrice,
+	})
+}
+
+// UltimateAnalysisWithConfig provides the ultimate memecoin analysis using an AnalysisConfig
+func UltimateAnalysisWithConfig(dataset []OHLCV, config AnalysisConfig) (UltimateMemecoinAnalysis, error) {
+	return UltimateAnalysisContext(context.Background(), dataset, config)
+}
+
+// UltimateAnalysisContext is UltimateAnalysisWithConfig with cancellation: it returns ctx.Err()
+// if the context is done before or after the technical indicators are computed
+func UltimateAnalysisContext(ctx context.Context, dataset []OHLCV, config AnalysisConfig) (UltimateMemecoinAnalysis, error) {
+	// Young tokens: shorter periods instead of insufficient data
+	var young *YoungTokenMode
+	if config.YoungToken && len(dataset) < config.UltimateMinCandles() {
+		var err error
+		if config, young, err = youngTokenConfig(config, len(dataset)); err != nil {
+			return UltimateMemecoinAnalysis{}, err
+		}
+	}
+
+	config = config.withDefaults()
+	cache, err := newConfiguredCache(dataset, config)
 	if err != nil {
 		return UltimateMemecoinAnalysis{}, err
 	}
 
-	// Get volume analysis
-	volume, err := AnalyzeVolumeStrategy(dataset, vmaPeriod, 5)
+	analysis, err := ultimateAnalysis(ctx, cache, config)
 	if err != nil {
 		return UltimateMemecoinAnalysis{}, err
 	}
 
+	// Forced liquidations get their own signal instead of a breakdown or breakout
+	cascades, err := DetectLiquidationCascades(cache.dataset, config.Liquidation)
+	if err == nil {
+		applyLiquidation(&analysis, cascades, len(cache.dataset), config.Liquidation)
+	} else if !errors.Is(err, ErrInsufficientData{}) {
+		return UltimateMemecoinAnalysis{}, err
+	}
+
+	// Honeypot and illiquidity patterns in the candles
+	tradability, err := DetectUntradeable(cache.dataset, config.Tradability)
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+	applyTradability(&analysis, tradability)
+
+	// Price moves on a thin order book, when the source reports order flow
+	manipulation, err := DetectManipulation(cache.dataset, config.Manipulation)
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+	if manipulation.Covered > 0 {
+		applyManipulation(&analysis, manipulation)
+	}
+
+	// Holder concentration, whale activity, and liquidity removal
+	if config.Fundamentals != nil || len(config.Liquidity) > 0 {
+		inputs := RugPullInputs{Fundamentals: config.Fundamentals, Liquidity: config.Liquidity}
+		if end, ok := datasetEnd(cache.dataset); ok {
+			inputs.At = end.Add(-time.Nanosecond) // Samples before the latest candle closes
+		} else {
+			inputs.At = cache.dataset[len(cache.dataset)-1].Timestamp
+		}
+		assessment, err := AssessRugPullRisk(analysis, inputs, config.RugPull)
+		if err != nil {
+			return UltimateMemecoinAnalysis{}, err
+		}
+		ApplyRugPullAssessment(&analysis, assessment)
+	}
+
+	// Higher-timeframe confirmation
+	if len(config.ConfirmTimeframes) > 0 || len(config.HigherTimeframe) > 0 {
+		timeframes, err := confirmationTimeframes(ctx, cache.dataset, config)
+		if err != nil {
+			return UltimateMemecoinAnalysis{}, err
+		}
+		analysis.Timeframes = &timeframes
+		confirmWithTimeframes(&analysis, timeframes)
+	}
+
+	if young != nil {
+		applyYoungToken(&analysis, young)
+	}
+
+	analysis.SignalStrength = signalStrength(analysis)
+	return analysis, nil
+}
+
+// ultimateAnalysis combines the technical and volume analyses over the cached series
+func ultimateAnalysis(ctx context.Context, cache *analysisCache, config AnalysisConfig) (UltimateMemecoinAnalysis, error) {
+	// Run the volume analysis alongside the technical analysis
+	var volume VolumeStrategy
+	var volumeErr error
+	var wg sync.WaitGroup
+	if !config.SkipVolume {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			volume, volumeErr = analyzeVolumeStrategy(cache, config.VMAPeriod, config.VROCPeriod)
+		}()
+	}
+
+	// Get technical analysis
+	technical, err := comprehensiveAnalysis(ctx, cache, config)
+	wg.Wait()
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+
+	if config.SkipVolume {
+		return UltimateMemecoinAnalysis{
+			Technical:       technical,
+			FinalSignal:     technical.FinalSignal,
+			Confidence:      technical.Confidence,
+			RiskLevel:       technical.RiskLevel,
+			RugPullRisk:     "low",
+			ConfidenceScore: technical.ConfidenceScore,
+			RiskScore:       technical.RiskScore,
+		}, nil
+	}
+
+	if volumeErr != nil {
+		return UltimateMemecoinAnalysis{}, volumeErr
+	}
+
 	// Check volume confirmation
 	volumeConfirm := false
 	switch {
-	case (technical.FinalSignal == "STRONG BUY" || technical.FinalSignal == "BUY") &&
-		(volume.Signal == "strong_buy" || volume.Signal == "buy" || volume.Signal == "accumulate"):
+	case technical.FinalSignal.IsBullish() && volume.Signal.IsBullish():
 		volumeConfirm = true
-	case (technical.FinalSignal == "STRONG SELL" || technical.FinalSignal == "SELL") &&
-		(volume.Signal == "strong_sell" || volume.Signal == "sell" || volume.Signal == "distribute"):
+	case technical.FinalSignal.IsBearish() && volume.Signal.IsBearish():
 		volumeConfirm = true
-	case technical.FinalSignal == "WAIT" && volume.VolumeRatio < 1.0:
+	case technical.FinalSignal == SignalWait && volume.VolumeRatio < 1.0:
 		volumeConfirm = true
 	}
-
-	// Assess rug pull risk
-	rugPullRisk := "low"
-	switch {
-	case volume.Signal == "strong_sell" && volume.AccumulationSignal.Type == "distribution" &&
-		technical.RSISignal == "strong_sell" && volume.VolumeRatio > 3.0:
-		rugPullRisk = "extreme"
-	case volume.AccumulationSignal.Type == "distribution" && technical.FinalSignal == "STRONG SELL":
-		rugPullRisk = "high"
-	case volume.Signal == "distribute" || (volume.VolumeRatio > 2.0 && technical.FinalSignal == "SELL"):
-		rugPullRisk = "medium"
+	if volume.Quality.WashTrading {
+		volumeConfirm = false // Wash-traded volume confirms nothing
 	}
 
 	// Adjust final signal based on volume confirmation
 	finalSignal := technical.FinalSignal
 	confidence := technical.Confidence
 	riskLevel := technical.RiskLevel
+	confidenceScore := technical.ConfidenceScore
+	riskScore := technical.RiskScore
+
+	if volumeConfirm {
+		confidenceScore += 0.15
+	} else {
+		confidenceScore -= 0.2
+	}
 
 	if volumeConfirm {
 		// Volume confirms technical signal - increase confidence
@@ -70,22 +211,30 @@ func UltimateAnalysis(dataset []OHLCV, smaPeriod, bbPeriod, rsiPeriod, vmaPeriod
 		// Volume doesn't confirm - decrease confidence and adjust signal
 		if confidence == "HIGH" {
 			confidence = "MEDIUM"
-			if finalSignal == "STRONG BUY" {
-				finalSignal = "BUY"
-			} else if finalSignal == "STRONG SELL" {
-				finalSignal = "SELL"
+			if finalSignal == SignalStrongBuy {
+				finalSignal = SignalBuy
+			} else if finalSignal == SignalStrongSell {
+				finalSignal = SignalSell
 			}
 		} else if confidence == "MEDIUM" {
 			confidence = "LOW"
-			finalSignal = "HOLD"
+			finalSignal = SignalHold
 		}
 	}
 
 	// Special cases for volume signals
-	if volume.Signal == "low_volume_alert" {
-		finalSignal = "SUSPICIOUS"
+	if volume.Signal == SignalLowVolumeAlert {
+		finalSignal = SignalSuspicious
 		confidence = "LOW"
 		riskLevel = "HIGH"
+		confidenceScore = math.Min(confidenceScore, 0.2)
+		riskScore = math.Max(riskScore, 0.8)
+	}
+
+	// Assess rug pull risk from the candle patterns
+	rugPull, err := AssessRugPullRisk(UltimateMemecoinAnalysis{Technical: technical, Volume: volume}, RugPullInputs{}, config.RugPull)
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
 	}
 
 	return UltimateMemecoinAnalysis{
@@ -94,97 +243,324 @@ func UltimateAnalysis(dataset []OHLCV, smaPeriod, bbPeriod, rsiPeriod, vmaPeriod
 		FinalSignal:   finalSignal,
 		Confidence:    confidence,
 		RiskLevel:     riskLevel,
-		RugPullRisk:   rugPullRisk,
+		RugPullRisk:   rugPull.Level,
 		VolumeConfirm: volumeConfirm,
+
+		ConfidenceScore: clampScore(confidenceScore),
+		RiskScore:       clampScore(math.Max(riskScore, rugPull.Score)),
+		RugPullScore:    rugPull.Score,
+		RugPullReasons:  rugPull.Reasons,
 	}, nil
 }
 
 // ComprehensiveAnalysis combines all indicators for ultimate trading decisions
 func ComprehensiveAnalysis(dataset []OHLCV, smaPeriod, bbPeriod, rsiPeriod int, bbMultiplier float64, priceType PriceType) (CombinedTechnicalAnalysis, error) {
+	return ComprehensiveAnalysisWithConfig(dataset, AnalysisConfig{
+		SMAPeriod:    smaPeriod,
+		BBPeriod:     bbPeriod,
+		BBMultiplier: bbMultiplier,
+		RSIPeriod:    rsiPeriod,
+		PriceType:    priceType,
+	})
+}
+
+// ComprehensiveAnalysisWithConfig combines the configured indicators for trading decisions
+func ComprehensiveAnalysisWithConfig(dataset []OHLCV, config AnalysisConfig) (CombinedTechnicalAnalysis, error) {
+	return ComprehensiveAnalysisContext(context.Background(), dataset, config)
+}
+
+// ComprehensiveAnalysisContext is ComprehensiveAnalysisWithConfig with cancellation: the context is checked
+// around the concurrently computed built-in indicators and before each user-registered vote
+func ComprehensiveAnalysisContext(ctx context.Context, dataset []OHLCV, config AnalysisConfig) (CombinedTechnicalAnalysis, error) {
+	cache, err := newConfiguredCache(dataset, config)
+	if err != nil {
+		return CombinedTechnicalAnalysis{}, err
+	}
+	return comprehensiveAnalysis(ctx, cache, config)
+}
+
+// comprehensiveAnalysis runs the comprehensive analysis with indicator series shared through the cache
+func comprehensiveAnalysis(ctx context.Context, cache *analysisCache, config AnalysisConfig) (CombinedTechnicalAnalysis, error) {
+	dataset := cache.dataset
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return CombinedTechnicalAnalysis{}, err
+	}
+
+	priceType := config.PriceType
+
+	if err := ctx.Err(); err != nil {
+		return CombinedTechnicalAnalysis{}, err
+	}
+
+	// The built-in indicators are independent, so compute them concurrently
+	var wg sync.WaitGroup
+
 	// SMA Analysis
-	isAboveSMA, _ := IsPriceAboveSMA(dataset, smaPeriod, priceType)
-	smaCross, _ := SMACrossover(dataset, smaPeriod/2, smaPeriod, priceType)
-
-	smaSignal := "neutral"
-	if isAboveSMA && smaCross == "bullish_crossover" {
-		smaSignal = "strong_bullish"
-	} else if !isAboveSMA && smaCross == "bearish_crossover" {
-		smaSignal = "strong_bearish"
-	} else if isAboveSMA {
-		smaSignal = "bullish"
-	} else {
-		smaSignal = "bearish"
+	var smaSignal Signal
+	if !config.SkipSMA {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			smaSignal = smaTrendSignal(cache, config.SMAPeriod, priceType)
+		}()
 	}
 
 	// Bollinger Bands Analysis
-	bbStrategy, _ := AnalyzeBollingerStrategy(dataset, bbPeriod, bbMultiplier, priceType)
+	var bbStrategy BollingerStrategy
+	if !config.SkipBollinger {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bbStrategy, _ = analyzeBollingerStrategy(cache, config.BBPeriod, config.BBMultiplier, priceType)
+		}()
+	}
 
 	// RSI Analysis
-	rsiStrategy, _ := AnalyzeRSIStrategy(dataset, rsiPeriod, priceType)
-
-	// Combine signals
-	signals := []string{smaSignal, bbStrategy.Signal, rsiStrategy.Signal}
-	bullishCount := 0
-	bearishCount := 0
-
-	for _, signal := range signals {
-		switch {
-		case signal == "strong_buy" || signal == "buy" || signal == "bullish" || signal == "strong_bullish":
-			bullishCount++
-		case signal == "strong_sell" || signal == "sell" || signal == "bearish" || signal == "strong_bearish":
-			bearishCount++
+	var rsiStrategy RSIStrategy
+	if !config.SkipRSI {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsiStrategy, _ = analyzeRSIStrategy(cache, config.RSIPeriod, priceType)
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return CombinedTechnicalAnalysis{}, err
+	}
+
+	// Regime switching: trend-following votes lead in trends, mean-reversion votes in ranges
+	smaWeight, bbSignal, rsiSignal := config.SMAWeight, bbStrategy.Signal, rsiStrategy.Signal
+	var regime TrendRegime
+	if config.RegimeSwitching {
+		classified, err := classifyRegime(dataset, config.Regime, cache.sma, cache.bollinger)
+		if err == nil {
+			regime = classified.Regime
+			smaWeight, bbSignal, rsiSignal = regimeVotes(config, regime, smaWeight, bbSignal, rsiSignal)
 		}
 	}
 
-	// Final decision logic
-	finalSignal := "HOLD"
-	confidence := "LOW"
-	riskLevel := "MEDIUM"
+	// Collect votes in a fixed order so results do not depend on scheduling
+	aggregator := NewSignalAggregator(config.BuyVotes, config.StrongVotes)
+	if !config.SkipSMA {
+		aggregator.Add("sma", smaSignal, smaWeight)
+	}
+	if !config.SkipBollinger {
+		aggregator.Add("bollinger", bbSignal, config.BollingerWeight)
+	}
+	if !config.SkipRSI {
+		aggregator.Add("rsi", rsiSignal, config.RSIWeight)
+	}
 
-	switch {
-	case bullishCount >= 3:
-		finalSignal = "STRONG BUY"
-		confidence = "HIGH"
-		riskLevel = "LOW"
-	case bullishCount >= 2:
-		finalSignal = "BUY"
-		confidence = "MEDIUM"
-		riskLevel = "LOW"
-	case bearishCount >= 3:
-		finalSignal = "STRONG SELL"
-		confidence = "HIGH"
-		riskLevel = "HIGH"
-	case bearishCount >= 2:
-		finalSignal = "SELL"
-		confidence = "MEDIUM"
-		riskLevel = "MEDIUM"
-	case bbStrategy.Signal == "wait_for_breakout":
-		finalSignal = "WAIT"
+	// Funding and open interest up to the latest candle; too few samples vote neutral
+	var derivatives *DerivativesAnalysis
+	if config.Derivatives != nil {
+		data := *config.Derivatives
+		if end, ok := datasetEnd(dataset); ok {
+			data = data.Until(end)
+		}
+		derivativesSignal := SignalNeutral
+		if analyzed, err := AnalyzeDerivatives(data, config.DerivativesConfig); err == nil {
+			derivatives = &analyzed
+			derivativesSignal = analyzed.Signal
+		}
+		aggregator.Add("derivatives", derivativesSignal, config.DerivativesConfig.withDefaults().Weight)
+	}
+
+	// Additional user-registered votes
+	var extraSignals map[string]Signal
+	for _, vote := range config.ExtraVotes {
+		if err := ctx.Err(); err != nil {
+			return CombinedTechnicalAnalysis{}, err
+		}
+
+		signal, err := vote.Vote(dataset)
+		if err != nil {
+			return CombinedTechnicalAnalysis{}, fmt.Errorf("error in %s vote: %w", vote.Name, err)
+		}
+
+		if extraSignals == nil {
+			extraSignals = make(map[string]Signal)
+		}
+		extraSignals[vote.Name] = signal
+		aggregator.Add(vote.Name, signal, vote.Weight)
+	}
+
+	// Combine signals as weighted votes
+	aggregated, err := aggregator.Aggregate()
+	if err != nil {
+		return CombinedTechnicalAnalysis{}, err
+	}
+
+	finalSignal := aggregated.Signal
+	confidence := aggregated.Confidence
+	riskLevel := aggregated.RiskLevel
+	confidenceScore := aggregated.ConfidenceScore
+	riskScore := aggregated.RiskScore
+
+	// Without a majority, a squeeze means waiting for the breakout
+	if finalSignal == SignalHold && bbStrategy.Signal == SignalWaitForBreakout {
+		finalSignal = SignalWait
 		confidence = "HIGH"
 		riskLevel = "LOW"
+		confidenceScore = 0.7
+		riskScore = 0.3
+	}
+
+	// A volatile market without a trend carries at least elevated risk
+	if regime == RegimeVolatile {
+		riskScore = math.Max(riskScore, 0.7)
 	}
 
 	// Adjust for extreme conditions
 	if rsiStrategy.Condition == RSIExtremeHigh && bbStrategy.Position == AboveUpperBand {
-		finalSignal = "STRONG SELL"
+		finalSignal = SignalStrongSell
 		confidence = "HIGH"
 		riskLevel = "HIGH"
+		confidenceScore = 0.9
+		riskScore = 0.9
 	} else if rsiStrategy.Condition == RSIExtremeLow && bbStrategy.Position == BelowLowerBand {
-		finalSignal = "STRONG BUY"
+		finalSignal = SignalStrongBuy
 		confidence = "HIGH"
 		riskLevel = "LOW"
+		confidenceScore = 0.9
+		riskScore = 0.2
 	}
 
 	return CombinedTechnicalAnalysis{
 		SMASignal:       smaSignal,
 		BollingerSignal: bbStrategy.Signal,
 		RSISignal:       rsiStrategy.Signal,
+		ExtraSignals:    extraSignals,
+		WeightedScore:   aggregated.Score,
+		Breakdown:       aggregated.Breakdown,
 		FinalSignal:     finalSignal,
 		Confidence:      confidence,
 		RiskLevel:       riskLevel,
+		ConfidenceScore: clampScore(confidenceScore),
+		RiskScore:       clampScore(riskScore),
+		Regime:          regime,
+		Derivatives:     derivatives,
 	}, nil
 }
 
+// regimeVotes adapts the built-in votes to the market regime. In a trend the SMA crossover vote counts
+// double and Bollinger or RSI votes against the trend (overbought in an uptrend, oversold in a downtrend)
+// become holds. In a range the crossover vote, prone to whipsaws, is dropped as long as a mean-reversion
+// vote remains. Volatile markets keep the votes unchanged.
+func regimeVotes(config AnalysisConfig, regime TrendRegime, smaWeight float64, bbSignal, rsiSignal Signal) (float64, Signal, Signal) {
+	direction := 0
+	switch regime {
+	case RegimeTrendingUp:
+		direction = 1
+	case RegimeTrendingDown:
+		direction = -1
+	case RegimeRanging:
+		if !config.SkipBollinger || !config.SkipRSI {
+			smaWeight = 0
+		}
+		return smaWeight, bbSignal, rsiSignal
+	default:
+		return smaWeight, bbSignal, rsiSignal
+	}
+
+	if bbSignal.Direction() == -direction {
+		bbSignal = SignalHold
+	}
+	if rsiSignal.Direction() == -direction {
+		rsiSignal = SignalHold
+	}
+	return 2 * smaWeight, bbSignal, rsiSignal
+}
+
+// smaTrendSignal classifies the trend from the price position relative to the SMA and the half-period crossover.
+// Both checks read the cached SMA series; a check that cannot be computed counts as neither above nor crossing.
+func smaTrendSignal(cache *analysisCache, period int, priceType PriceType) Signal {
+	return movingAverageTrendSignal(cache.dataset, period, priceType, cache.sma)
+}
+
+// movingAverageTrendSignal applies the smaTrendSignal rules to any moving average series source
+func movingAverageTrendSignal(dataset []OHLCV, period int, priceType PriceType, average func(int, PriceType) ([]SMAResult, error)) Signal {
+	isAboveSMA := false
+	slowSMA, err := average(period, priceType)
+	if err == nil && len(slowSMA) > 0 {
+		isAboveSMA = dataset[len(dataset)-1].ExtractPrice(ClosePrice) > slowSMA[len(slowSMA)-1].Value
+	}
+
+	smaCross := SignalNoSignal
+	if fast := period / 2; fast < period && len(dataset) >= period+1 {
+		fastSMA, fastErr := average(fast, priceType)
+		if err == nil && fastErr == nil {
+			smaCross = smaCrossoverFromSeries(fastSMA, slowSMA)
+		}
+	}
+
+	switch {
+	case isAboveSMA && smaCross == SignalBullishCrossover:
+		return SignalStrongBullish
+	case !isAboveSMA && smaCross == SignalBearishCrossover:
+		return SignalStrongBearish
+	case isAboveSMA:
+		return SignalBullish
+	default:
+		return SignalBearish
+	}
+}
+
+// applyTradability sets the honeypot and illiquid flags; a flagged token gets the suspicious signal
+// with low confidence and high risk, as trades may not fill at the charted prices
+func applyTradability(analysis *UltimateMemecoinAnalysis, report TradabilityReport) {
+	analysis.Honeypot = analysis.Honeypot || report.Honeypot
+	analysis.Illiquid = analysis.Illiquid || report.Illiquid
+	analysis.TradabilityReasons = append(analysis.TradabilityReasons, report.Reasons...)
+	if !report.Honeypot && !report.Illiquid {
+		return
+	}
+
+	analysis.FinalSignal = SignalSuspicious
+	analysis.Confidence = "LOW"
+	analysis.RiskLevel = "HIGH"
+	analysis.ConfidenceScore = math.Min(analysis.ConfidenceScore, 0.2)
+	analysis.RiskScore = math.Max(analysis.RiskScore, 0.8)
+}
+
+// applyManipulation attaches the manipulation report; suspected manipulation gets the suspicious signal
+// with low confidence and high risk, as the charted moves were not made by real demand
+func applyManipulation(analysis *UltimateMemecoinAnalysis, report ManipulationReport) {
+	analysis.Manipulation = &report
+	if !report.Suspected {
+		return
+	}
+
+	analysis.FinalSignal = SignalSuspicious
+	analysis.Confidence = "LOW"
+	analysis.RiskLevel = "HIGH"
+	analysis.ConfidenceScore = math.Min(analysis.ConfidenceScore, 0.2)
+	analysis.RiskScore = math.Max(analysis.RiskScore, 0.8)
+}
+
+// rugPullRiskScore maps a rug pull risk label to a 0-1 score
+func rugPullRiskScore(risk string) float64 {
+	switch risk {
+	case "extreme":
+		return 1.0
+	case "high":
+		return 0.75
+	case "medium":
+		return 0.5
+	default:
+		return 0.0
+	}
+}
+
+// clampScore bounds a score to the 0-1 range
+func clampScore(score float64) float64 {
+	return math.Max(0, math.Min(1, score))
+}
+
 // Example usage for memecoin trading
 func exampleUsage() {
 	// Example dataset - would need to be converted from [][]string to []OHLCV
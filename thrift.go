@@ -0,0 +1,284 @@
+package techindicators
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Thrift compact protocol type ids, used for Parquet metadata
+const (
+	thriftTrue     = 1
+	thriftFalse    = 2
+	thriftByte     = 3
+	thriftI16      = 4
+	thriftI32      = 5
+	thriftI64      = 6
+	thriftDouble   = 7
+	thriftBinary   = 8
+	thriftListType = 9
+	thriftSet      = 10
+	thriftMap      = 11
+	thriftStruct   = 12
+)
+
+// thriftWriter encodes Thrift compact protocol structs
+type thriftWriter struct {
+	buf    bytes.Buffer
+	lastID int16
+	stack  []int16
+}
+
+func (w *thriftWriter) varint(v uint64) {
+	w.buf.Write(binary.AppendUvarint(nil, v))
+}
+
+func (w *thriftWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *thriftWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.zigzag(int64(id))
+	}
+	w.lastID = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.zigzag(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.zigzag(v)
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.fieldHeader(id, thriftBinary)
+	w.rawBinary(s)
+}
+
+// rawBinary writes a string without a field header, e.g. as a list element
+func (w *thriftWriter) rawBinary(s string) {
+	w.varint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *thriftWriter) listBegin(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftListType)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xF0 | elemType)
+		w.varint(uint64(size))
+	}
+}
+
+func (w *thriftWriter) structBegin(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.elemStructBegin()
+}
+
+func (w *thriftWriter) structEnd() {
+	w.elemStructEnd()
+}
+
+// elemStructBegin starts a struct without a field header, e.g. as a list element
+func (w *thriftWriter) elemStructBegin() {
+	w.stack = append(w.stack, w.lastID)
+	w.lastID = 0
+}
+
+func (w *thriftWriter) elemStructEnd() {
+	w.stop()
+	w.lastID = w.stack[len(w.stack)-1]
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+// stop terminates the current struct
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
+
+// thriftReader decodes Thrift compact protocol into generic values: structs become map[int16]any,
+// lists and sets []any, integers int64, binaries []byte, doubles float64, and booleans bool
+type thriftReader struct {
+	data  []byte
+	pos   int
+	depth int
+}
+
+func newThriftReader(data []byte) *thriftReader {
+	return &thriftReader{data: data}
+}
+
+func (r *thriftReader) byte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, fmt.Errorf("unexpected end of thrift data")
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *thriftReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid thrift varint")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *thriftReader) zigzag() (int64, error) {
+	v, err := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *thriftReader) readStruct() (map[int16]any, error) {
+	if r.depth++; r.depth > 64 {
+		return nil, fmt.Errorf("thrift nesting too deep")
+	}
+	defer func() { r.depth-- }()
+
+	fields := make(map[int16]any)
+	var lastID int16
+	for {
+		header, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if header == 0 {
+			return fields, nil
+		}
+
+		typ := header & 0x0F
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := r.zigzag()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		lastID = id
+
+		switch typ {
+		case thriftTrue:
+			fields[id] = true
+		case thriftFalse:
+			fields[id] = false
+		default:
+			if fields[id], err = r.readValue(typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) (any, error) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		b, err := r.byte() // Booleans inside collections take a byte
+		return b == thriftTrue, err
+	case thriftByte:
+		b, err := r.byte()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftDouble:
+		if r.pos+8 > len(r.data) {
+			return nil, fmt.Errorf("unexpected end of thrift data")
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(r.data[r.pos:]))
+		r.pos += 8
+		return v, nil
+	case thriftBinary:
+		length, err := r.uvarint()
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(len(r.data)-r.pos) {
+			return nil, fmt.Errorf("thrift binary exceeds data")
+		}
+		v := r.data[r.pos : r.pos+int(length)]
+		r.pos += int(length)
+		return v, nil
+	case thriftListType, thriftSet:
+		header, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, elemType := uint64(header>>4), header&0x0F
+		if size == 15 {
+			if size, err = r.uvarint(); err != nil {
+				return nil, err
+			}
+		}
+		if size > uint64(len(r.data)-r.pos) {
+			return nil, fmt.Errorf("thrift list exceeds data")
+		}
+		list := make([]any, size)
+		for i := range list {
+			if list[i], err = r.readValue(elemType); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case thriftMap:
+		size, err := r.uvarint()
+		if err != nil || size == 0 {
+			return map[any]any{}, err
+		}
+		types, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if size > uint64(len(r.data)-r.pos) {
+			return nil, fmt.Errorf("thrift map exceeds data")
+		}
+		m := make(map[any]any, size)
+		for i := uint64(0); i < size; i++ {
+			key, err := r.readValue(types >> 4)
+			if err != nil {
+				return nil, err
+			}
+			value, err := r.readValue(types & 0x0F)
+			if err != nil {
+				return nil, err
+			}
+			if b, ok := key.([]byte); ok {
+				key = string(b)
+			}
+			m[key] = value
+		}
+		return m, nil
+	case thriftStruct:
+		return r.readStruct()
+	default:
+		return nil, fmt.Errorf("unknown thrift type %d", typ)
+	}
+}
+
+// thriftInt returns a decoded integer field, or 0 when absent
+func thriftInt(v any) int64 {
+	i, _ := v.(int64)
+	return i
+}
+
+// thriftBytes returns a decoded binary field, or nil when absent
+func thriftBytes(v any) []byte {
+	b, _ := v.([]byte)
+	return b
+}
+
+// thriftList returns a decoded list field, or nil when absent
+func thriftList(v any) []any {
+	l, _ := v.([]any)
+	return l
+}
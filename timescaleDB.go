@@ -0,0 +1,177 @@
+package techindicators
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// TimescaleWriter writes candles and indicator series to TimescaleDB (or plain PostgreSQL) through
+// database/sql, so any Postgres driver works (pgx's stdlib adapter, lib/pq). Rows are upserted, so
+// re-writing an overlapping window updates the forming candle instead of failing.
+type TimescaleWriter struct {
+	DB              *sql.DB
+	CandleTable     string // Default "ohlcv"
+	IndicatorTable  string // Default "indicator_values"
+	SkipHypertables bool   // Create plain tables in EnsureSchema, for PostgreSQL without the Timescale extension
+}
+
+// NewTimescaleWriter returns a writer using the default table names
+func NewTimescaleWriter(db *sql.DB) *TimescaleWriter {
+	return &TimescaleWriter{DB: db}
+}
+
+// tables returns the configured table names, quoted as identifiers
+func (w *TimescaleWriter) tables() (candles, indicators string) {
+	candles, indicators = w.CandleTable, w.IndicatorTable
+	if candles == "" {
+		candles = "ohlcv"
+	}
+	if indicators == "" {
+		indicators = "indicator_values"
+	}
+	return quoteIdentifier(candles), quoteIdentifier(indicators)
+}
+
+// EnsureSchema creates the tables (and hypertables partitioned on time) when they do not exist
+func (w *TimescaleWriter) EnsureSchema(ctx context.Context) error {
+	candles, indicators := w.tables()
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS ` + candles + ` (
+			time     TIMESTAMPTZ NOT NULL,
+			symbol   TEXT NOT NULL,
+			interval TEXT NOT NULL,
+			open     DOUBLE PRECISION,
+			high     DOUBLE PRECISION,
+			low      DOUBLE PRECISION,
+			close    DOUBLE PRECISION,
+			volume   DOUBLE PRECISION,
+			PRIMARY KEY (symbol, interval, time)
+		)`,
+		`CREATE TABLE IF NOT EXISTS ` + indicators + ` (
+			time       TIMESTAMPTZ NOT NULL,
+			symbol     TEXT NOT NULL,
+			interval   TEXT NOT NULL,
+			indicator  TEXT NOT NULL,
+			params     TEXT NOT NULL,
+			value      DOUBLE PRECISION,
+			components JSONB,
+			PRIMARY KEY (symbol, interval, indicator, params, time)
+		)`,
+	}
+	if !w.SkipHypertables {
+		statements = append(statements,
+			`SELECT create_hypertable('`+candles+`', 'time', if_not_exists => TRUE)`,
+			`SELECT create_hypertable('`+indicators+`', 'time', if_not_exists => TRUE)`,
+		)
+	}
+
+	for _, statement := range statements {
+		if _, err := w.DB.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("timescaledb: %w", err)
+		}
+	}
+	return nil
+}
+
+// WriteCandles upserts the candles in one transaction
+func (w *TimescaleWriter) WriteCandles(ctx context.Context, symbol string, interval time.Duration, candles []OHLCV) error {
+	table, _ := w.tables()
+	query := `INSERT INTO ` + table + ` (time, symbol, interval, open, high, low, close, volume)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (symbol, interval, time) DO UPDATE SET
+			open = EXCLUDED.open, high = EXCLUDED.high, low = EXCLUDED.low,
+			close = EXCLUDED.close, volume = EXCLUDED.volume`
+
+	name := FormatInterval(interval)
+	return w.inTransaction(ctx, query, len(candles), func(i int) []any {
+		c := candles[i]
+		return []any{c.Timestamp.UTC(), symbol, name, sqlFloat(c.Open), sqlFloat(c.High), sqlFloat(c.Low), sqlFloat(c.Close), sqlFloat(c.Volume)}
+	})
+}
+
+// WriteIndicator upserts the points in one transaction; components are stored as a JSON object
+func (w *TimescaleWriter) WriteIndicator(ctx context.Context, symbol string, interval time.Duration, indicator string, params IndicatorParams, points []Point) error {
+	_, table := w.tables()
+	query := `INSERT INTO ` + table + ` (time, symbol, interval, indicator, params, value, components)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (symbol, interval, indicator, params, time) DO UPDATE SET
+			value = EXCLUDED.value, components = EXCLUDED.components`
+
+	name, paramString := FormatInterval(interval), formatIndicatorParams(params)
+	encoded := make([]any, len(points))
+	for i, point := range points {
+		components := make(map[string]float64, len(point.Components))
+		for key, value := range point.Components {
+			if !math.IsNaN(value) && !math.IsInf(value, 0) {
+				components[key] = value
+			}
+		}
+		if len(components) == 0 {
+			continue
+		}
+		raw, err := json.Marshal(components)
+		if err != nil {
+			return err
+		}
+		encoded[i] = string(raw)
+	}
+
+	return w.inTransaction(ctx, query, len(points), func(i int) []any {
+		p := points[i]
+		return []any{p.Timestamp.UTC(), symbol, name, indicator, paramString, sqlFloat(p.Value), encoded[i]}
+	})
+}
+
+// inTransaction executes the prepared query once per row and commits, rolling back on the first error
+func (w *TimescaleWriter) inTransaction(ctx context.Context, query string, rows int, args func(i int) []any) error {
+	if w.DB == nil {
+		return invalidParameter("timescaledb writer has no database")
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	tx, err := w.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("timescaledb: %w", err)
+	}
+	defer tx.Rollback()
+
+	statement, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("timescaledb: %w", err)
+	}
+	defer statement.Close()
+
+	for i := 0; i < rows; i++ {
+		if _, err := statement.ExecContext(ctx, args(i)...); err != nil {
+			return fmt.Errorf("timescaledb: row %d: %w", i, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("timescaledb: %w", err)
+	}
+	return nil
+}
+
+// sqlFloat maps NaN and infinities to NULL
+func sqlFloat(value float64) any {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+	return value
+}
+
+// quoteIdentifier quotes a Postgres identifier, allowing schema-qualified names such as "market.ohlcv"
+func quoteIdentifier(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+	}
+	return strings.Join(parts, ".")
+}
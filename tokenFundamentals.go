@@ -0,0 +1,92 @@
+package techindicators
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// TokenFundamentals is on-chain holder data for a token: how concentrated the supply is and what the
+// largest wallets have been doing. Zero fields are treated as unknown.
+type TokenFundamentals struct {
+	Address         string          `json:"address"`
+	Holders         int             `json:"holders"`
+	TopHoldersShare float64         `json:"top_holders_share"` // Share of supply held by the top 10 holders, 0-1
+	DeployerShare   float64         `json:"deployer_share"`    // Share of supply still held by the deployer wallet, 0-1
+	LargeTransfers  []LargeTransfer `json:"large_transfers"`   // Recent whale transfers, any order
+	UpdatedAt       time.Time       `json:"updated_at"`        // Reference time for the transfer window [now]
+}
+
+// LargeTransfer is a token transfer above the provider's whale threshold
+type LargeTransfer struct {
+	Timestamp    time.Time `json:"timestamp"`
+	From         string    `json:"from"`
+	To           string    `json:"to"`
+	Share        float64   `json:"share"` // Share of supply moved, 0-1
+	AmountUSD    float64   `json:"amount_usd"`
+	FromDeployer bool      `json:"from_deployer"`
+}
+
+// FundamentalsProvider fetches token fundamentals from an on-chain data service (a chain indexer, an
+// explorer API, or an RPC node) so they can be fed into the analysis
+type FundamentalsProvider interface {
+	Name() string
+	TokenFundamentals(ctx context.Context, address string) (TokenFundamentals, error)
+}
+
+// validate checks that the shares are within 0-1
+func (f TokenFundamentals) validate() error {
+	shares := []float64{f.TopHoldersShare, f.DeployerShare}
+	for _, transfer := range f.LargeTransfers {
+		shares = append(shares, transfer.Share)
+	}
+	for _, share := range shares {
+		if share < 0 || share > 1 || math.IsNaN(share) {
+			return invalidParameter("holder and transfer shares must be between 0 and 1, got %v", share)
+		}
+	}
+	if f.Holders < 0 {
+		return invalidParameter("holders must not be negative, got %d", f.Holders)
+	}
+	return nil
+}
+
+// recentTransfers returns the summed supply share of the large transfers within the window, and whether
+// the deployer moved tokens within it
+func (f TokenFundamentals) recentTransfers(window time.Duration) (share float64, deployer bool) {
+	at := f.UpdatedAt
+	if at.IsZero() {
+		at = time.Now()
+	}
+	for _, transfer := range f.LargeTransfers {
+		if transfer.Timestamp.After(at) || at.Sub(transfer.Timestamp) > window {
+			continue
+		}
+		share += transfer.Share
+		deployer = deployer || transfer.FromDeployer
+	}
+	return share, deployer
+}
+
+// ApplyFundamentals raises the rug pull risk of an ultimate analysis using holder concentration, deployer
+// holdings, and recent whale transfers, assessed with DefaultRugPullRiskConfig. Like ApplyMarketContext the
+// risk is only ever raised, and RiskScore and RiskLevel follow it.
+func ApplyFundamentals(analysis *UltimateMemecoinAnalysis, fundamentals TokenFundamentals) error {
+	assessment, err := AssessRugPullRisk(*analysis, RugPullInputs{Fundamentals: &fundamentals}, DefaultRugPullRiskConfig())
+	if err != nil {
+		return err
+	}
+	ApplyRugPullAssessment(analysis, assessment)
+	return nil
+}
+
+// UltimateAnalysisWithFundamentals fetches the token's fundamentals from the provider and runs
+// UltimateAnalysisContext with them as config.Fundamentals
+func UltimateAnalysisWithFundamentals(ctx context.Context, dataset []OHLCV, config AnalysisConfig, provider FundamentalsProvider, address string) (UltimateMemecoinAnalysis, error) {
+	fundamentals, err := provider.TokenFundamentals(ctx, address)
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+	config.Fundamentals = &fundamentals
+	return UltimateAnalysisContext(ctx, dataset, config)
+}
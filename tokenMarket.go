@@ -0,0 +1,46 @@
+package techindicators
+
+import "time"
+
+// TokenMarketData is on-chain market context for a token that candles alone do not show
+type TokenMarketData struct {
+	Address      string    `json:"address"`
+	Price        float64   `json:"price"`
+	LiquidityUSD float64   `json:"liquidity_usd"` // Pooled liquidity across DEX pools
+	Volume24hUSD float64   `json:"volume_24h_usd"`
+	MarketCapUSD float64   `json:"market_cap_usd"`
+	Trades24h    int       `json:"trades_24h"`
+	Buys24h      int       `json:"buys_24h"`
+	Sells24h     int       `json:"sells_24h"`
+	Holders      int       `json:"holders"`
+	LastTrade    time.Time `json:"last_trade"`
+}
+
+// Liquidity thresholds of DefaultRugPullRiskConfig
+const (
+	thinLiquidityUSD     = 50_000 // Below this a single wallet can move or drain the pool
+	criticalLiquidityUSD = 10_000
+	volumeLiquidityRatio = 20 // 24h volume this many times the liquidity suggests wash trading
+	honeypotMinBuys      = 20 // Buys without any sell above this count suggest sells are blocked
+)
+
+// ApplyMarketContext raises the rug pull risk of an ultimate analysis using liquidity and trade counts:
+// thin or critical liquidity, volume far above liquidity, and buys with no sells (a honeypot pattern).
+// It assesses the market data with DefaultRugPullRiskConfig together with the analysis' candle patterns;
+// use AssessRugPullRisk and ApplyRugPullAssessment for other thresholds or more inputs.
+// The risk is only ever raised, and RiskScore and RiskLevel follow it.
+func ApplyMarketContext(analysis *UltimateMemecoinAnalysis, market TokenMarketData) {
+	assessment, err := AssessRugPullRisk(*analysis, RugPullInputs{Market: &market}, DefaultRugPullRiskConfig())
+	if err != nil {
+		return // The default configuration is valid
+	}
+	ApplyRugPullAssessment(analysis, assessment)
+}
+
+// higherRugPullRisk returns the more severe of two rug pull risk levels
+func higherRugPullRisk(a, b string) string {
+	if rugPullRiskScore(b) > rugPullRiskScore(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,155 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// TradabilityCode identifies a candle pattern that suggests a token cannot be traded normally
+type TradabilityCode string
+
+const (
+	TradabilityDeadVolume         TradabilityCode = "dead_volume"          // Many candles with zero or near-zero volume
+	TradabilityDriftWithoutVolume TradabilityCode = "drift_without_volume" // Price moving on candles without volume
+	TradabilityNoSells            TradabilityCode = "no_sells"             // No red candle in the window while price rose
+	TradabilityOneSidedWicks      TradabilityCode = "one_sided_wicks"      // Candles almost never trade below their open
+)
+
+// TradabilityReason is one detected pattern of a tradability check
+type TradabilityReason struct {
+	Code   TradabilityCode `json:"code"`
+	Detail string          `json:"detail"`
+}
+
+// TradabilityReport is the result of DetectUntradeable
+type TradabilityReport struct {
+	Honeypot bool                `json:"honeypot"` // Buys go through but sells apparently do not
+	Illiquid bool                `json:"illiquid"` // Too little volume for the price to be meaningful
+	Reasons  []TradabilityReason `json:"reasons,omitempty"`
+}
+
+// TradabilityConfig configures DetectUntradeable. Zero fields take the defaults in brackets.
+type TradabilityConfig struct {
+	Window         int     `json:"window"`           // Latest candles examined [20]
+	NearZeroVolume float64 `json:"near_zero_volume"` // Volume counted as dead, as a share of the dataset's median volume [0.05]
+	DeadShare      float64 `json:"dead_share"`       // Share of dead-volume candles in the window that makes a token illiquid [0.3]
+	DriftMove      float64 `json:"drift_move"`       // Close-to-open move of a dead-volume candle that counts as drift [0.005]
+	DriftCandles   int     `json:"drift_candles"`    // Drifting dead-volume candles that make a token illiquid [3]
+	OneSidedShare  float64 `json:"one_sided_share"`  // Share of candles without a lower wick for the one-sided pattern [0.8]
+}
+
+// DefaultTradabilityConfig returns the thresholds UltimateAnalysis uses by default
+func DefaultTradabilityConfig() TradabilityConfig {
+	return TradabilityConfig{
+		Window:         20,
+		NearZeroVolume: 0.05,
+		DeadShare:      0.3,
+		DriftMove:      0.005,
+		DriftCandles:   3,
+		OneSidedShare:  0.8,
+	}
+}
+
+// withDefaults fills zero fields
+func (c TradabilityConfig) withDefaults() TradabilityConfig {
+	defaults := DefaultTradabilityConfig()
+	if c.Window == 0 {
+		c.Window = defaults.Window
+	}
+	if c.NearZeroVolume == 0 {
+		c.NearZeroVolume = defaults.NearZeroVolume
+	}
+	if c.DeadShare == 0 {
+		c.DeadShare = defaults.DeadShare
+	}
+	if c.DriftMove == 0 {
+		c.DriftMove = defaults.DriftMove
+	}
+	if c.DriftCandles == 0 {
+		c.DriftCandles = defaults.DriftCandles
+	}
+	if c.OneSidedShare == 0 {
+		c.OneSidedShare = defaults.OneSidedShare
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c TradabilityConfig) validate() error {
+	switch {
+	case c.Window < 2:
+		return invalidPeriod("tradability window must be at least 2, got %d", c.Window)
+	case c.NearZeroVolume < 0 || c.DriftMove < 0 || c.DriftCandles < 0:
+		return invalidParameter("tradability thresholds must not be negative")
+	case c.DeadShare < 0 || c.DeadShare > 1 || c.OneSidedShare < 0 || c.OneSidedShare > 1:
+		return invalidParameter("tradability shares must be between 0 and 1")
+	}
+	return nil
+}
+
+// DetectUntradeable checks the latest candles for signs that a token cannot be traded normally.
+// A token is illiquid when many candles have (near-)zero volume or the price keeps drifting without
+// volume, and a honeypot when the price rose with no red candle and almost no lower wicks, the chart of
+// buys going through while sells fail. Datasets shorter than the window are examined as a whole.
+func DetectUntradeable(dataset []OHLCV, config TradabilityConfig) (TradabilityReport, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return TradabilityReport{}, err
+	}
+	if len(dataset) < 2 {
+		return TradabilityReport{}, ErrInsufficientData{Need: 2, Have: len(dataset)}
+	}
+
+	volumes := make([]float64, 0, len(dataset))
+	for _, candle := range dataset {
+		if !math.IsNaN(candle.Volume) {
+			volumes = append(volumes, candle.Volume)
+		}
+	}
+	sort.Float64s(volumes)
+	deadVolume := config.NearZeroVolume * percentile(volumes, 50)
+
+	window := dataset[max(0, len(dataset)-config.Window):]
+	var dead, drifting, red, oneSided int
+	for _, candle := range window {
+		if candle.Volume <= deadVolume {
+			dead++
+			if candle.Open > 0 && math.Abs(candle.Close/candle.Open-1) >= config.DriftMove {
+				drifting++
+			}
+		}
+		if candle.Close < candle.Open {
+			red++
+		}
+		if candle.Low >= math.Min(candle.Open, candle.Close) {
+			oneSided++
+		}
+	}
+
+	var report TradabilityReport
+	add := func(code TradabilityCode, format string, args ...any) {
+		report.Reasons = append(report.Reasons, TradabilityReason{Code: code, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	n := float64(len(window))
+	if float64(dead) >= config.DeadShare*n {
+		add(TradabilityDeadVolume, "%d of %d candles without volume", dead, len(window))
+		report.Illiquid = true
+	}
+	if drifting >= config.DriftCandles && config.DriftCandles > 0 {
+		add(TradabilityDriftWithoutVolume, "price moved on %d candles without volume", drifting)
+		report.Illiquid = true
+	}
+
+	rising := window[len(window)-1].Close > window[0].Open
+	noSells := red == 0 && rising
+	if noSells {
+		add(TradabilityNoSells, "no red candle in %d candles", len(window))
+	}
+	if float64(oneSided) >= config.OneSidedShare*n {
+		add(TradabilityOneSidedWicks, "%d of %d candles without a lower wick", oneSided, len(window))
+		report.Honeypot = noSells
+	}
+	return report, nil
+}
@@ -0,0 +1,199 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TradeAlert is an order instruction derived from an analysis, in the shape TradingView webhook bots consume
+type TradeAlert struct {
+	Symbol     string    `json:"symbol"`
+	Action     string    `json:"action"` // buy or sell
+	Price      float64   `json:"price"`
+	StopLoss   float64   `json:"stop_loss"`
+	TakeProfit float64   `json:"take_profit"`
+	Signal     Signal    `json:"signal"`
+	Confidence float64   `json:"confidence"` // Analysis confidence score, 0-1
+	Time       time.Time `json:"time"`
+}
+
+// TradeAlertOptions controls the stop and target levels of NewTradeAlert
+type TradeAlertOptions struct {
+	StopPercent float64 // Stop distance as a fraction of the price (default 0.05)
+	RewardRisk  float64 // Take-profit distance as a multiple of the stop distance (default 2)
+	StrongOnly  bool    // Alert only on STRONG BUY/STRONG SELL
+}
+
+// NewTradeAlert turns an analysis into a trade alert at the given price. The stop sits StopPercent away
+// on the losing side and the take-profit RewardRisk times that distance on the winning side.
+// ok is false when the final signal is not a buy or sell.
+func NewTradeAlert(symbol string, price float64, at time.Time, analysis UltimateMemecoinAnalysis, opts TradeAlertOptions) (alert TradeAlert, ok bool, err error) {
+	if price <= 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return TradeAlert{}, false, invalidPrice("alert price must be positive and finite, got %v", price)
+	}
+	if opts.StopPercent == 0 {
+		opts.StopPercent = 0.05
+	}
+	if opts.RewardRisk == 0 {
+		opts.RewardRisk = 2
+	}
+	if opts.StopPercent < 0 || opts.StopPercent >= 1 {
+		return TradeAlert{}, false, invalidParameter("stop percent must be between 0 and 1, got %v", opts.StopPercent)
+	}
+	if opts.RewardRisk < 0 {
+		return TradeAlert{}, false, invalidParameter("reward/risk must not be negative, got %v", opts.RewardRisk)
+	}
+
+	signal := analysis.FinalSignal
+	if signal != SignalBuy && signal != SignalStrongBuy && signal != SignalSell && signal != SignalStrongSell {
+		return TradeAlert{}, false, nil
+	}
+	if opts.StrongOnly && !signal.IsStrong() {
+		return TradeAlert{}, false, nil
+	}
+
+	distance := price * opts.StopPercent
+	alert = TradeAlert{
+		Symbol:     symbol,
+		Action:     "buy",
+		Price:      price,
+		StopLoss:   price - distance,
+		TakeProfit: price + distance*opts.RewardRisk,
+		Signal:     signal,
+		Confidence: analysis.ConfidenceScore,
+		Time:       at,
+	}
+	if signal.Direction() < 0 {
+		alert.Action = "sell"
+		alert.StopLoss = price + distance
+		alert.TakeProfit = math.Max(0, price-distance*opts.RewardRisk)
+	}
+	return alert, true, nil
+}
+
+// AlertFormat selects the webhook payload layout of FormatTradeAlert
+type AlertFormat string
+
+const (
+	AlertFormatGeneric       AlertFormat = "generic"       // JSON with ticker, action, price, stop, take_profit
+	AlertFormatTradersPost   AlertFormat = "traderspost"   // TradersPost JSON with stopLoss/takeProfit objects
+	AlertFormat3Commas       AlertFormat = "3commas"       // 3Commas signal bot JSON (enter_long, exit_long, ...)
+	AlertFormatPineConnector AlertFormat = "pineconnector" // PineConnector comma-separated command
+)
+
+// AlertAccount holds the bot credentials some formats embed in the payload
+type AlertAccount struct {
+	ID       string // PineConnector license ID or 3Commas bot UUID
+	Secret   string // 3Commas webhook secret
+	Exchange string // 3Commas tv_exchange, e.g. BINANCE
+	Shorts   bool   // Sell alerts open shorts instead of closing longs (3Commas and PineConnector)
+}
+
+// FormatTradeAlert renders the alert as a webhook body in the given format
+func FormatTradeAlert(alert TradeAlert, format AlertFormat, account AlertAccount) ([]byte, error) {
+	switch format {
+	case AlertFormatGeneric, "":
+		return json.Marshal(map[string]any{
+			"ticker":      alert.Symbol,
+			"action":      alert.Action,
+			"price":       alert.Price,
+			"stop":        alert.StopLoss,
+			"take_profit": alert.TakeProfit,
+			"signal":      alert.Signal,
+			"confidence":  alert.Confidence,
+			"time":        alert.Time.UTC().Format(time.RFC3339),
+		})
+
+	case AlertFormatTradersPost:
+		return json.Marshal(map[string]any{
+			"ticker":     alert.Symbol,
+			"action":     alert.Action,
+			"price":      alert.Price,
+			"time":       alert.Time.UTC().Format(time.RFC3339),
+			"stopLoss":   map[string]any{"type": "stop", "stopPrice": alert.StopLoss},
+			"takeProfit": map[string]any{"limitPrice": alert.TakeProfit},
+		})
+
+	case AlertFormat3Commas:
+		if account.ID == "" || account.Secret == "" {
+			return nil, invalidParameter("3commas alerts need the bot UUID and secret")
+		}
+		action := "enter_long"
+		if alert.Action == "sell" {
+			action = "exit_long"
+			if account.Shorts {
+				action = "enter_short"
+			}
+		}
+		return json.Marshal(map[string]any{
+			"secret":        account.Secret,
+			"max_lag":       "300",
+			"timestamp":     alert.Time.UTC().Format(time.RFC3339),
+			"trigger_price": strconv.FormatFloat(alert.Price, 'f', -1, 64),
+			"tv_exchange":   account.Exchange,
+			"tv_instrument": alert.Symbol,
+			"action":        action,
+			"bot_uuid":      account.ID,
+		})
+
+	case AlertFormatPineConnector:
+		if account.ID == "" {
+			return nil, invalidParameter("pineconnector alerts need the license ID")
+		}
+		command := "buy"
+		if alert.Action == "sell" {
+			command = "closelong"
+			if account.Shorts {
+				command = "sell"
+			}
+		}
+		fields := []string{account.ID, command, alert.Symbol}
+		if command != "closelong" {
+			fields = append(fields,
+				"sl="+strconv.FormatFloat(alert.StopLoss, 'f', -1, 64),
+				"tp="+strconv.FormatFloat(alert.TakeProfit, 'f', -1, 64))
+		}
+		return []byte(strings.Join(fields, ",")), nil
+
+	default:
+		return nil, invalidParameter("unknown alert format %q", format)
+	}
+}
+
+// WebhookAlerter posts trade alerts to an execution bot's webhook
+type WebhookAlerter struct {
+	URL        string
+	HTTPClient *http.Client
+	Format     AlertFormat
+	Account    AlertAccount
+	Options    TradeAlertOptions
+}
+
+// Send posts an alert for the analysis at the given price; HOLD and other non-trade signals send nothing
+func (w *WebhookAlerter) Send(ctx context.Context, symbol string, price float64, analysis UltimateMemecoinAnalysis) error {
+	alert, ok, err := NewTradeAlert(symbol, price, time.Now(), analysis, w.Options)
+	if err != nil || !ok {
+		return err
+	}
+	body, err := FormatTradeAlert(alert, w.Format, w.Account)
+	if err != nil {
+		return err
+	}
+
+	contentType := "application/json"
+	if w.Format == AlertFormatPineConnector {
+		contentType = "text/plain"
+	}
+	return postBody(ctx, w.HTTPClient, "webhook", w.URL, contentType, body)
+}
+
+// String describes the alert in one line, e.g. for logs
+func (a TradeAlert) String() string {
+	return fmt.Sprintf("%s %s @ %g (stop %g, take profit %g)", strings.ToUpper(a.Action), a.Symbol, a.Price, a.StopLoss, a.TakeProfit)
+}
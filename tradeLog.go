@@ -0,0 +1,226 @@
+package techindicators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JournalEntry records the signal produced at one candle
+type JournalEntry struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Signal          Signal    `json:"signal"`
+	Price           float64   `json:"price"`            // Close price when the signal was produced
+	ConfidenceScore float64   `json:"confidence_score"` // 0-1 scale, 0 if unknown
+}
+
+// TradeLog is a journal of signals and executions that can be summarized into a PerformanceReport.
+// Signals are expected once per candle in time order so later entries give the forward price.
+// It is safe for concurrent use.
+type TradeLog struct {
+	mu         sync.Mutex
+	entries    []JournalEntry
+	executions []Execution
+}
+
+// NewTradeLog creates an empty trade log
+func NewTradeLog() *TradeLog {
+	return &TradeLog{}
+}
+
+// RecordSignal appends a signal entry
+func (l *TradeLog) RecordSignal(entry JournalEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+// RecordExecution appends a fill, e.g. one returned by PaperTrader.OnCandle
+func (l *TradeLog) RecordExecution(execution Execution) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.executions = append(l.executions, execution)
+}
+
+// Entries returns a copy of the signal entries
+func (l *TradeLog) Entries() []JournalEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]JournalEntry(nil), l.entries...)
+}
+
+// Executions returns a copy of the recorded fills
+func (l *TradeLog) Executions() []Execution {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Execution(nil), l.executions...)
+}
+
+// JournalUltimateAnalysis replays UltimateAnalysisWithConfig over the dataset, recording the signal at every
+// candle from start onward using only the candles up to it. Candles without enough history are skipped.
+func JournalUltimateAnalysis(ctx context.Context, dataset []OHLCV, config AnalysisConfig, start int) (*TradeLog, error) {
+	if len(dataset) == 0 {
+		return nil, ErrEmptyDataset
+	}
+	if start < 0 || start >= len(dataset) {
+		return nil, invalidParameter("start must be between 0 and %d", len(dataset)-1)
+	}
+
+	log := NewTradeLog()
+	for i := start; i < len(dataset); i++ {
+		analysis, err := UltimateAnalysisContext(ctx, dataset[:i+1], config)
+		if err != nil {
+			if errors.Is(err, ErrInsufficientData{}) {
+				continue
+			}
+			return nil, err
+		}
+
+		log.RecordSignal(JournalEntry{
+			Timestamp:       dataset[i].Timestamp,
+			Signal:          analysis.FinalSignal,
+			Price:           dataset[i].Close,
+			ConfidenceScore: analysis.ConfidenceScore,
+		})
+	}
+
+	return log, nil
+}
+
+// SignalStats summarizes how one signal type performed
+type SignalStats struct {
+	Signal           Signal  `json:"signal"`
+	Count            int     `json:"count"`
+	Evaluated        int     `json:"evaluated"`          // Occurrences with a price horizon entries later
+	Hits             int     `json:"hits"`               // Bullish signals followed by a higher price, bearish by a lower one
+	HitRate          float64 `json:"hit_rate"`           // Hits / Evaluated; 0 for non-directional signals
+	AvgForwardReturn float64 `json:"avg_forward_return"` // Mean price change over the horizon
+}
+
+// PerformanceReport is the aggregate performance of a TradeLog
+type PerformanceReport struct {
+	From    time.Time     `json:"from"`
+	To      time.Time     `json:"to"`
+	Horizon int           `json:"horizon"` // Entries ahead used to judge each signal
+	Signals int           `json:"signals"`
+	ByType  []SignalStats `json:"by_type"` // Sorted by count, most frequent first
+
+	Trades        int     `json:"trades"` // Completed sells
+	WinningTrades int     `json:"winning_trades"`
+	WinRate       float64 `json:"win_rate"`
+	RealizedPnL   float64 `json:"realized_pnl"`
+	TotalFees     float64 `json:"total_fees"`
+}
+
+// Report summarizes the log, judging each signal by the price horizon entries later
+func (l *TradeLog) Report(horizon int) (PerformanceReport, error) {
+	if horizon <= 0 {
+		return PerformanceReport{}, invalidPeriod("horizon must be greater than 0")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.entries) == 0 && len(l.executions) == 0 {
+		return PerformanceReport{}, noResults("trade log")
+	}
+
+	report := PerformanceReport{Horizon: horizon, Signals: len(l.entries)}
+	if len(l.entries) > 0 {
+		report.From = l.entries[0].Timestamp
+		report.To = l.entries[len(l.entries)-1].Timestamp
+	}
+
+	stats := make(map[Signal]*SignalStats)
+	returnSums := make(map[Signal]float64)
+	for i, entry := range l.entries {
+		s, ok := stats[entry.Signal]
+		if !ok {
+			s = &SignalStats{Signal: entry.Signal}
+			stats[entry.Signal] = s
+		}
+		s.Count++
+
+		if i+horizon >= len(l.entries) || entry.Price == 0 {
+			continue
+		}
+		forward := l.entries[i+horizon].Price/entry.Price - 1
+		s.Evaluated++
+		returnSums[entry.Signal] += forward
+		if float64(entry.Signal.Direction())*forward > 0 {
+			s.Hits++
+		}
+	}
+
+	for signal, s := range stats {
+		if s.Evaluated > 0 {
+			s.AvgForwardReturn = returnSums[signal] / float64(s.Evaluated)
+			if signal.Direction() != 0 {
+				s.HitRate = float64(s.Hits) / float64(s.Evaluated)
+			}
+		}
+		report.ByType = append(report.ByType, *s)
+	}
+	sort.Slice(report.ByType, func(a, b int) bool {
+		if report.ByType[a].Count != report.ByType[b].Count {
+			return report.ByType[a].Count > report.ByType[b].Count
+		}
+		return report.ByType[a].Signal < report.ByType[b].Signal
+	})
+
+	for _, execution := range l.executions {
+		report.TotalFees += execution.Fee
+		if execution.Side != "sell" {
+			continue
+		}
+		report.Trades++
+		report.RealizedPnL += execution.PnL
+		if execution.PnL > 0 {
+			report.WinningTrades++
+		}
+	}
+	if report.Trades > 0 {
+		report.WinRate = float64(report.WinningTrades) / float64(report.Trades)
+	}
+
+	return report, nil
+}
+
+// JSON renders the report as indented JSON
+func (r PerformanceReport) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// String renders the report as plain text
+func (r PerformanceReport) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Performance report: %d signals", r.Signals)
+	if !r.From.IsZero() {
+		fmt.Fprintf(&b, " from %s to %s", r.From.Format(time.RFC3339), r.To.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, " (horizon %d)\n", r.Horizon)
+
+	if len(r.ByType) > 0 {
+		fmt.Fprintf(&b, "\n%-20s %7s %9s %9s %12s\n", "SIGNAL", "COUNT", "EVALUATED", "HIT RATE", "AVG RETURN")
+		for _, s := range r.ByType {
+			hitRate := "-"
+			if s.Signal.Direction() != 0 && s.Evaluated > 0 {
+				hitRate = fmt.Sprintf("%.1f%%", s.HitRate*100)
+			}
+			fmt.Fprintf(&b, "%-20s %7d %9d %9s %11.2f%%\n", s.Signal.Label(), s.Count, s.Evaluated, hitRate, s.AvgForwardReturn*100)
+		}
+	}
+
+	if r.Trades > 0 || r.TotalFees > 0 {
+		fmt.Fprintf(&b, "\nTrades: %d, win rate %.1f%%, realized PnL %.4f, fees %.4f\n",
+			r.Trades, r.WinRate*100, r.RealizedPnL, r.TotalFees)
+	}
+
+	return b.String()
+}
@@ -12,6 +12,11 @@ type OHLCV struct {
 	Low       float64   `json:"low"`
 	Close     float64   `json:"close"`
 	Volume    float64   `json:"volume"`
+
+	// Optional order flow, zero when the source does not provide it
+	Trades int     `json:"trades,omitempty"` // Number of trades in the candle
+	Bid    float64 `json:"bid,omitempty"`    // Best bid at the close
+	Ask    float64 `json:"ask,omitempty"`    // Best ask at the close
 }
 
 // ExtractPrice extracts the specified price type from OHLCV data
@@ -29,7 +34,14 @@ func (o OHLCV) ExtractPrice(priceType PriceType) float64 {
 		return (o.High + o.Low + o.Close) / 3
 	case WeightedPrice:
 		return (o.High + o.Low + 2*o.Close) / 4
-	default:
-		return o.Close // Default to close price
+	case MedianPrice:
+		return (o.High + o.Low) / 2
+	case OHLC4Price:
+		return (o.Open + o.High + o.Low + o.Close) / 4
+	}
+
+	if fn, ok := customPrice(priceType); ok {
+		return fn(o)
 	}
+	return o.Close // Default to close price
 }
@@ -0,0 +1,126 @@
+package techindicators
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ViolationType identifies a dataset consistency problem found by ValidateOHLCV
+type ViolationType string
+
+const (
+	NonMonotonicTimestamp ViolationType = "non_monotonic_timestamp" // Timestamp not after the previous candle
+	HighBelowBody         ViolationType = "high_below_body"         // High < max(Open, Close)
+	LowAboveBody          ViolationType = "low_above_body"          // Low > min(Open, Close)
+	NegativeVolume        ViolationType = "negative_volume"         // Volume < 0
+	IrregularSpacing      ViolationType = "irregular_spacing"       // Gap to the previous candle differs from the usual spacing
+)
+
+// Violation describes one problem with one candle
+type Violation struct {
+	Index     int           `json:"index"`
+	Timestamp time.Time     `json:"timestamp"`
+	Type      ViolationType `json:"type"`
+	Message   string        `json:"message"`
+}
+
+// ValidationReport summarizes the checks run by ValidateOHLCV
+type ValidationReport struct {
+	Candles    int           `json:"candles"`
+	Spacing    time.Duration `json:"spacing"` // Most common gap between consecutive candles
+	Violations []Violation   `json:"violations"`
+}
+
+// Valid reports whether no violations were found
+func (r ValidationReport) Valid() bool {
+	return len(r.Violations) == 0
+}
+
+// Count returns the number of violations of the given type
+func (r ValidationReport) Count(violationType ViolationType) int {
+	count := 0
+	for _, v := range r.Violations {
+		if v.Type == violationType {
+			count++
+		}
+	}
+	return count
+}
+
+// Err returns nil for a valid dataset, otherwise an ErrInvalidDataset describing the first violation
+func (r ValidationReport) Err() error {
+	if r.Valid() {
+		return nil
+	}
+	first := r.Violations[0]
+	return fmt.Errorf("%w: %d violations, first at index %d: %s", ErrInvalidDataset, len(r.Violations), first.Index, first.Message)
+}
+
+// ValidateOHLCV checks a dataset before any indicator runs: strictly increasing timestamps,
+// High >= max(Open, Close), Low <= min(Open, Close), non-negative volume, and consistent candle spacing.
+// The expected spacing is the most common positive gap between consecutive candles.
+func ValidateOHLCV(dataset []OHLCV) ValidationReport {
+	report := ValidationReport{Candles: len(dataset)}
+	report.Spacing = dominantSpacing(dataset)
+
+	add := func(i int, violationType ViolationType, format string, args ...any) {
+		report.Violations = append(report.Violations, Violation{
+			Index:     i,
+			Timestamp: dataset[i].Timestamp,
+			Type:      violationType,
+			Message:   fmt.Sprintf(format, args...),
+		})
+	}
+
+	for i, candle := range dataset {
+		if i > 0 {
+			gap := candle.Timestamp.Sub(dataset[i-1].Timestamp)
+			switch {
+			case gap <= 0:
+				add(i, NonMonotonicTimestamp, "timestamp %s is not after %s", candle.Timestamp.Format(time.RFC3339), dataset[i-1].Timestamp.Format(time.RFC3339))
+			case report.Spacing > 0 && gap != report.Spacing:
+				add(i, IrregularSpacing, "gap of %s, expected %s", gap, report.Spacing)
+			}
+		}
+
+		if bodyHigh := max(candle.Open, candle.Close); candle.High < bodyHigh {
+			add(i, HighBelowBody, "high %g is below max(open, close) %g", candle.High, bodyHigh)
+		}
+
+		if bodyLow := min(candle.Open, candle.Close); candle.Low > bodyLow {
+			add(i, LowAboveBody, "low %g is above min(open, close) %g", candle.Low, bodyLow)
+		}
+
+		if candle.Volume < 0 {
+			add(i, NegativeVolume, "negative volume %g", candle.Volume)
+		}
+	}
+
+	return report
+}
+
+// dominantSpacing returns the most common positive gap between consecutive candles (the smallest on ties)
+func dominantSpacing(dataset []OHLCV) time.Duration {
+	counts := make(map[time.Duration]int)
+	for i := 1; i < len(dataset); i++ {
+		if gap := dataset[i].Timestamp.Sub(dataset[i-1].Timestamp); gap > 0 {
+			counts[gap]++
+		}
+	}
+
+	gaps := make([]time.Duration, 0, len(counts))
+	for gap := range counts {
+		gaps = append(gaps, gap)
+	}
+	sort.Slice(gaps, func(a, b int) bool { return gaps[a] < gaps[b] })
+
+	var spacing time.Duration
+	best := 0
+	for _, gap := range gaps {
+		if counts[gap] > best {
+			spacing, best = gap, counts[gap]
+		}
+	}
+	return spacing
+}
@@ -0,0 +1,226 @@
+package techindicators
+
+import (
+	"math"
+	"time"
+)
+
+// VolatilityResult represents an annualized volatility estimate
+type VolatilityResult struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"` // Annualized volatility (0.8 = 80%)
+}
+
+// validateVolatilityParams checks the common inputs of the volatility estimators
+func validateVolatilityParams(dataset []OHLCV, window int, annualization float64, required int) error {
+	if len(dataset) == 0 {
+		return ErrEmptyDataset
+	}
+
+	if window <= 1 {
+		return invalidPeriod("window must be greater than 1")
+	}
+
+	if annualization <= 0 {
+		return invalidParameter("annualization factor must be greater than 0")
+	}
+
+	if len(dataset) < required {
+		return ErrInsufficientData{Need: required, Have: len(dataset)}
+	}
+
+	return nil
+}
+
+// CalculateRollingVolatility calculates annualized close-to-close volatility from log returns.
+// annualization is the number of candles per year (365 for daily crypto candles, 8760 for hourly).
+func CalculateRollingVolatility(dataset []OHLCV, window int, annualization float64) ([]VolatilityResult, error) {
+	if err := validateVolatilityParams(dataset, window, annualization, window+1); err != nil {
+		return nil, err
+	}
+
+	logReturns, err := LogReturnsFromOHLCV(dataset, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]VolatilityResult, 0, len(logReturns)-window+1)
+
+	for i := window - 1; i < len(logReturns); i++ {
+		windowReturns := logReturns[i-window+1 : i+1]
+		sd := stdDev(windowReturns, average(windowReturns))
+
+		results = append(results, VolatilityResult{
+			Timestamp: dataset[i+1].Timestamp, // i+1 because returns are offset by 1
+			Value:     sd * math.Sqrt(annualization),
+		})
+	}
+
+	return results, nil
+}
+
+// CalculateParkinsonVolatility calculates annualized volatility using the Parkinson high/low estimator
+func CalculateParkinsonVolatility(dataset []OHLCV, window int, annualization float64) ([]VolatilityResult, error) {
+	if err := validateVolatilityParams(dataset, window, annualization, window); err != nil {
+		return nil, err
+	}
+
+	// Squared log high/low ranges
+	ranges := make([]float64, len(dataset))
+	for i, candle := range dataset {
+		if candle.High <= 0 || candle.Low <= 0 {
+			return nil, invalidPrice("non-positive high/low price at index %d", i)
+		}
+		hl := math.Log(candle.High / candle.Low)
+		ranges[i] = hl * hl
+	}
+
+	factor := 1 / (4 * math.Ln2)
+	results := make([]VolatilityResult, 0, len(dataset)-window+1)
+
+	for i := window - 1; i < len(dataset); i++ {
+		variance := factor * average(ranges[i-window+1:i+1])
+
+		results = append(results, VolatilityResult{
+			Timestamp: dataset[i].Timestamp,
+			Value:     math.Sqrt(variance * annualization),
+		})
+	}
+
+	return results, nil
+}
+
+// CalculateGarmanKlassVolatility calculates annualized volatility using the Garman-Klass OHLC estimator
+func CalculateGarmanKlassVolatility(dataset []OHLCV, window int, annualization float64) ([]VolatilityResult, error) {
+	if err := validateVolatilityParams(dataset, window, annualization, window); err != nil {
+		return nil, err
+	}
+
+	// Per-candle variance terms
+	terms := make([]float64, len(dataset))
+	for i, candle := range dataset {
+		if candle.High <= 0 || candle.Low <= 0 || candle.Open <= 0 || candle.Close <= 0 {
+			return nil, invalidPrice("non-positive price at index %d", i)
+		}
+		hl := math.Log(candle.High / candle.Low)
+		co := math.Log(candle.Close / candle.Open)
+		terms[i] = 0.5*hl*hl - (2*math.Ln2-1)*co*co
+	}
+
+	results := make([]VolatilityResult, 0, len(dataset)-window+1)
+
+	for i := window - 1; i < len(dataset); i++ {
+		variance := average(terms[i-window+1 : i+1])
+		if variance < 0 {
+			variance = 0 // Can dip below zero on candles with tiny ranges
+		}
+
+		results = append(results, VolatilityResult{
+			Timestamp: dataset[i].Timestamp,
+			Value:     math.Sqrt(variance * annualization),
+		})
+	}
+
+	return results, nil
+}
+
+// GetLatestVolatility returns the most recent close-to-close annualized volatility
+func GetLatestVolatility(dataset []OHLCV, window int, annualization float64) (float64, error) {
+	results, err := CalculateRollingVolatility(dataset, window, annualization)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(results) == 0 {
+		return 0, noResults("volatility")
+	}
+
+	return results[len(results)-1].Value, nil
+}
+
+// VolatilityRegime labels a candle's volatility relative to its recent history
+type VolatilityRegime string
+
+const (
+	LowVolatility    VolatilityRegime = "low"    // Realized vol in the bottom quartile of the lookback
+	NormalVolatility VolatilityRegime = "normal" // Realized vol between the 25th and 75th percentile
+	HighVolatility   VolatilityRegime = "high"   // Realized vol in the top quartile of the lookback
+)
+
+// VolatilityRegimeResult represents the volatility regime of a single candle
+type VolatilityRegimeResult struct {
+	Timestamp  time.Time        `json:"timestamp"`
+	Volatility float64          `json:"volatility"` // Rolling realized volatility (not annualized)
+	Percentile float64          `json:"percentile"` // Percentile rank (0-100) of volatility within the lookback
+	Regime     VolatilityRegime `json:"regime"`
+}
+
+// DetectVolatilityRegimes labels each candle low/normal/high volatility by the percentile rank of its
+// rolling realized volatility (window returns) among the previous lookback volatility readings
+func DetectVolatilityRegimes(dataset []OHLCV, window, lookback int) ([]VolatilityRegimeResult, error) {
+	if lookback < 2 {
+		return nil, invalidPeriod("lookback must be at least 2")
+	}
+
+	vols, err := CalculateRollingVolatility(dataset, window, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vols) < lookback {
+		return nil, ErrInsufficientData{Need: window + lookback, Have: len(dataset)}
+	}
+
+	results := make([]VolatilityRegimeResult, 0, len(vols)-lookback+1)
+
+	for i := lookback - 1; i < len(vols); i++ {
+		current := vols[i].Value
+		rank := percentileRank(vols[i-lookback+1:i+1], current)
+
+		results = append(results, VolatilityRegimeResult{
+			Timestamp:  vols[i].Timestamp,
+			Volatility: current,
+			Percentile: rank,
+			Regime:     regimeFromPercentile(rank),
+		})
+	}
+
+	return results, nil
+}
+
+// GetCurrentVolatilityRegime returns the volatility regime of the latest candle
+func GetCurrentVolatilityRegime(dataset []OHLCV, window, lookback int) (VolatilityRegimeResult, error) {
+	results, err := DetectVolatilityRegimes(dataset, window, lookback)
+	if err != nil {
+		return VolatilityRegimeResult{}, err
+	}
+
+	if len(results) == 0 {
+		return VolatilityRegimeResult{}, noResults("volatility regimes")
+	}
+
+	return results[len(results)-1], nil
+}
+
+// regimeFromPercentile maps a volatility percentile rank to a regime
+func regimeFromPercentile(rank float64) VolatilityRegime {
+	switch {
+	case rank <= 25:
+		return LowVolatility
+	case rank >= 75:
+		return HighVolatility
+	default:
+		return NormalVolatility
+	}
+}
+
+// percentileRank returns the percentage (0-100) of readings that are less than or equal to value
+func percentileRank(readings []VolatilityResult, value float64) float64 {
+	count := 0
+	for _, reading := range readings {
+		if reading.Value <= value {
+			count++
+		}
+	}
+	return 100 * float64(count) / float64(len(readings))
+}
@@ -1,29 +1,38 @@
 package techindicators
 
 import (
-	"errors"
-	"fmt"
+	"slices"
+	"time"
 )
 
 // CombinedTechnicalAnalysis integrates SMA, Bollinger Bands, and RSI
 type CombinedTechnicalAnalysis struct {
-	SMASignal       string `json:"sma_signal"`
-	BollingerSignal string `json:"bollinger_signal"`
-	RSISignal       string `json:"rsi_signal"`
-	FinalSignal     string `json:"final_signal"`
-	Confidence      string `json:"confidence"`
-	RiskLevel       string `json:"risk_level"`
+	SMASignal       Signal  `json:"sma_signal"`
+	BollingerSignal Signal  `json:"bollinger_signal"`
+	RSISignal       Signal  `json:"rsi_signal"`
+	FinalSignal     Signal  `json:"final_signal"`
+	Confidence      string  `json:"confidence"`
+	RiskLevel       string  `json:"risk_level"`
+	ConfidenceScore float64 `json:"confidence_score"` // 0-1 scale
+	RiskScore       float64 `json:"risk_score"`       // 0-1 scale, higher is riskier
+
+	ExtraSignals  map[string]Signal `json:"extra_signals,omitempty"` // Signals from user-registered votes
+	WeightedScore float64           `json:"weighted_score"`          // Bullish minus bearish weight share, -1 to 1
+	Breakdown     []Contribution    `json:"breakdown,omitempty"`     // Per-indicator votes from the SignalAggregator
+	Regime        TrendRegime       `json:"regime,omitempty"`        // Set when AnalysisConfig.RegimeSwitching is on
+
+	Derivatives *DerivativesAnalysis `json:"derivatives,omitempty"` // Set when AnalysisConfig.Derivatives has enough samples
 }
 
 // VolumeResult represents volume analysis result
 type VolumeResult struct {
-	Timestamp string  `json:"timestamp"`
-	Volume    float64 `json:"volume"`
-	VMA       float64 `json:"vma"`  // Volume Moving Average
-	OBV       float64 `json:"obv"`  // On-Balance Volume
-	VPT       float64 `json:"vpt"`  // Volume Price Trend
-	VROC      float64 `json:"vroc"` // Volume Rate of Change
-	ADL       float64 `json:"adl"`  // Accumulation/Distribution Line
+	Timestamp time.Time `json:"timestamp"`
+	Volume    float64   `json:"volume"`
+	VMA       float64   `json:"vma"`  // Volume Moving Average
+	OBV       float64   `json:"obv"`  // On-Balance Volume
+	VPT       float64   `json:"vpt"`  // Volume Price Trend
+	VROC      float64   `json:"vroc"` // Volume Rate of Change
+	ADL       float64   `json:"adl"`  // Accumulation/Distribution Line
 }
 
 // VolumeSignal represents volume-based trading signals
@@ -36,12 +45,33 @@ type VolumeSignal struct {
 
 // CalculateVolumeAnalysis performs comprehensive volume analysis
 func CalculateVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]VolumeResult, error) {
+	return AppendVolumeAnalysis(nil, dataset, vmaPeriod, vrocPeriod)
+}
+
+// AppendVolumeAnalysis appends the volume analysis series for the dataset to dst and returns the extended slice
+func AppendVolumeAnalysis(dst []VolumeResult, dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]VolumeResult, error) {
+	return AppendVolumeAnalysisWithPolicy(dst, dataset, vmaPeriod, vrocPeriod, SkipMissing)
+}
+
+// CalculateVolumeAnalysisWithPolicy performs volume analysis with an explicit policy for degenerate candles:
+// a zero high-low range (ADL), a zero previous close (VPT), and a zero base volume (VROC).
+// SkipMissing leaves the term out as CalculateVolumeAnalysis does; PropagateNaN makes it NaN.
+func CalculateVolumeAnalysisWithPolicy(dataset []OHLCV, vmaPeriod, vrocPeriod int, policy MissingValuePolicy) ([]VolumeResult, error) {
+	return AppendVolumeAnalysisWithPolicy(nil, dataset, vmaPeriod, vrocPeriod, policy)
+}
+
+// AppendVolumeAnalysisWithPolicy is AppendVolumeAnalysis with an explicit missing value policy
+func AppendVolumeAnalysisWithPolicy(dst []VolumeResult, dataset []OHLCV, vmaPeriod, vrocPeriod int, policy MissingValuePolicy) ([]VolumeResult, error) {
+	if err := policy.validate(); err != nil {
+		return dst, err
+	}
+
 	if len(dataset) == 0 {
-		return nil, errors.New("dataset is empty")
+		return dst, ErrEmptyDataset
 	}
 
 	if vmaPeriod <= 0 || vrocPeriod <= 0 {
-		return nil, errors.New("periods must be greater than 0")
+		return dst, invalidPeriod("periods must be greater than 0")
 	}
 
 	maxPeriod := vmaPeriod
@@ -50,17 +80,22 @@ func CalculateVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]Volu
 	}
 
 	if len(dataset) <= maxPeriod {
-		return nil, fmt.Errorf("insufficient data: need more than %d candles", maxPeriod)
+		return dst, ErrInsufficientData{Need: maxPeriod + 1, Have: len(dataset)}
 	}
 
-	var results []VolumeResult
+	results := slices.Grow(dst, len(dataset)-maxPeriod)
 	var obv, vpt, adl float64 // Running totals
 
-	// Extract initial data
-	volumes := make([]float64, len(dataset))
-	closes := make([]float64, len(dataset))
-	highs := make([]float64, len(dataset))
-	lows := make([]float64, len(dataset))
+	// Extract initial data into pooled scratch buffers
+	volumesBuf, closesBuf := getFloats(len(dataset)), getFloats(len(dataset))
+	highsBuf, lowsBuf := getFloats(len(dataset)), getFloats(len(dataset))
+	defer func() {
+		putFloats(volumesBuf)
+		putFloats(closesBuf)
+		putFloats(highsBuf)
+		putFloats(lowsBuf)
+	}()
+	volumes, closes, highs, lows := *volumesBuf, *closesBuf, *highsBuf, *lowsBuf
 
 	for i, candle := range dataset {
 		volumes[i] = candle.Volume
@@ -74,12 +109,19 @@ func CalculateVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]Volu
 	vpt = volumes[0]
 	adl = volumes[0]
 
+	// Running volume sum for the VMA window
+	vmaSum := 0.0
+
 	// Calculate indicators for each period
 	for i := maxPeriod; i < len(dataset); i++ {
-		// Volume Moving Average (VMA)
-		vmaSum := 0.0
-		for j := i - vmaPeriod + 1; j <= i; j++ {
-			vmaSum += volumes[j]
+		// Volume Moving Average (VMA): slide the window by one candle
+		if start := i - vmaPeriod + 1; i == maxPeriod || rebuildWindow(start, vmaPeriod) || nonFinite(volumes[start-1]) {
+			vmaSum = 0
+			for j := start; j <= i; j++ {
+				vmaSum += volumes[j]
+			}
+		} else {
+			vmaSum += volumes[i] - volumes[start-1]
 		}
 		vma := vmaSum / float64(vmaPeriod)
 
@@ -97,12 +139,24 @@ func CalculateVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]Volu
 		if i > 0 && closes[i-1] != 0 {
 			priceChange := (closes[i] - closes[i-1]) / closes[i-1]
 			vpt += volumes[i] * priceChange
+		} else if i > 0 {
+			term, err := missingTerm(policy, "zero previous close", i)
+			if err != nil {
+				return dst, err
+			}
+			vpt += term
 		}
 
 		// Volume Rate of Change (VROC)
 		vroc := 0.0
 		if i >= vrocPeriod && volumes[i-vrocPeriod] != 0 {
 			vroc = ((volumes[i] - volumes[i-vrocPeriod]) / volumes[i-vrocPeriod]) * 100
+		} else if i >= vrocPeriod {
+			term, err := missingTerm(policy, "zero base volume", i)
+			if err != nil {
+				return dst, err
+			}
+			vroc = term
 		}
 
 		// Accumulation/Distribution Line (ADL)
@@ -110,10 +164,16 @@ func CalculateVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) ([]Volu
 			moneyFlowMultiplier := ((closes[i] - lows[i]) - (highs[i] - closes[i])) / (highs[i] - lows[i])
 			moneyFlowVolume := moneyFlowMultiplier * volumes[i]
 			adl += moneyFlowVolume
+		} else {
+			term, err := missingTerm(policy, "zero high-low range", i)
+			if err != nil {
+				return dst, err
+			}
+			adl += term
 		}
 
 		results = append(results, VolumeResult{
-			Timestamp: dataset[i].Timestamp.Format("2006-01-02T15:04:05Z"),
+			Timestamp: dataset[i].Timestamp,
 			Volume:    volumes[i],
 			VMA:       vma,
 			OBV:       obv,
@@ -134,7 +194,7 @@ func GetLatestVolumeAnalysis(dataset []OHLCV, vmaPeriod, vrocPeriod int) (Volume
 	}
 
 	if len(results) == 0 {
-		return VolumeResult{}, errors.New("no volume results calculated")
+		return VolumeResult{}, noResults("volume analysis")
 	}
 
 	return results[len(results)-1], nil
@@ -147,6 +207,11 @@ func DetectVolumeBreakout(dataset []OHLCV, vmaPeriod int, multiplier float64) (V
 		return VolumeSignal{}, err
 	}
 
+	return volumeBreakoutFromLatest(dataset, latest, multiplier), nil
+}
+
+// volumeBreakoutFromLatest classifies the latest volume reading against its moving average
+func volumeBreakoutFromLatest(dataset []OHLCV, latest VolumeResult, multiplier float64) VolumeSignal {
 	// Compare current volume with moving average
 	volumeRatio := latest.Volume / latest.VMA
 
@@ -190,7 +255,7 @@ func DetectVolumeBreakout(dataset []OHLCV, vmaPeriod int, multiplier float64) (V
 		signal.Trend = "neutral"
 	}
 
-	return signal, nil
+	return signal
 }
 
 // DetectAccumulationDistribution analyzes money flow patterns
@@ -204,8 +269,13 @@ func DetectAccumulationDistribution(dataset []OHLCV, lookback int) (VolumeSignal
 		return VolumeSignal{}, err
 	}
 
+	return accumulationFromResults(results, lookback), nil
+}
+
+// accumulationFromResults classifies money flow from the ADL slope over the last lookback volume results
+func accumulationFromResults(results []VolumeResult, lookback int) VolumeSignal {
 	if len(results) < lookback {
-		return VolumeSignal{Type: "insufficient_data"}, nil
+		return VolumeSignal{Type: "insufficient_data"}
 	}
 
 	// Analyze recent ADL trend
@@ -259,7 +329,7 @@ func DetectAccumulationDistribution(dataset []OHLCV, lookback int) (VolumeSignal
 		signal.Confidence = 0.3
 	}
 
-	return signal, nil
+	return signal
 }
 
 // VolumeStrategy provides comprehensive volume analysis
@@ -269,34 +339,64 @@ type VolumeStrategy struct {
 	AccumulationSignal VolumeSignal `json:"accumulation_signal"`
 	VolumeRatio        float64      `json:"volume_ratio"` // Current volume / VMA
 	OBVTrend           string       `json:"obv_trend"`    // rising, falling, sideways
-	Signal             string       `json:"signal"`       // buy, sell, hold, alert
+	Signal             Signal       `json:"signal"`       // buy, sell, hold, alert
+
+	// Wash-trading check with DefaultVolumeQualityConfig; zero when too few candles have volume.
+	// The breakout and accumulation confidences are scaled by its score.
+	Quality VolumeQuality `json:"quality"`
+
+	// OBV and ADL divergences confirmed within the latest candles. A bearish one, price up on a declining
+	// line, is a distribution warning and makes an otherwise quiet signal SignalDistribute.
+	Divergences         []VolumeDivergence `json:"divergences,omitempty"`
+	DistributionWarning bool               `json:"distribution_warning"`
+}
+
+// volumeStrategyMinCandles is the dataset length analyzeVolumeStrategy needs: its own series plus the
+// VROC-5 breakout and VMA-10/VROC-5 accumulation series
+func volumeStrategyMinCandles(vmaPeriod, vrocPeriod int) int {
+	return max(vmaPeriod, vrocPeriod, 10) + 1
 }
 
 // AnalyzeVolumeStrategy provides complete volume analysis for trading decisions
 func AnalyzeVolumeStrategy(dataset []OHLCV, vmaPeriod, vrocPeriod int) (VolumeStrategy, error) {
+	return analyzeVolumeStrategy(newAnalysisCache(dataset), vmaPeriod, vrocPeriod)
+}
+
+// analyzeVolumeStrategy runs the volume strategy on cached volume series.
+// The breakout check uses a VROC period of 5 and accumulation uses VMA-10/VROC-5, which share the
+// strategy's own series whenever the periods match.
+func analyzeVolumeStrategy(cache *analysisCache, vmaPeriod, vrocPeriod int) (VolumeStrategy, error) {
+	dataset := cache.dataset
+
 	// Get current volume analysis
-	current, err := GetLatestVolumeAnalysis(dataset, vmaPeriod, vrocPeriod)
+	results, err := cache.volume(vmaPeriod, vrocPeriod)
 	if err != nil {
 		return VolumeStrategy{}, err
 	}
 
+	if len(results) == 0 {
+		return VolumeStrategy{}, noResults("volume analysis")
+	}
+	current := results[len(results)-1]
+
 	// Detect volume breakout
-	breakoutSignal, err := DetectVolumeBreakout(dataset, vmaPeriod, 2.0)
+	breakoutResults, err := cache.volume(vmaPeriod, 5)
 	if err != nil {
 		return VolumeStrategy{}, err
 	}
+	breakoutSignal := volumeBreakoutFromLatest(dataset, breakoutResults[len(breakoutResults)-1], 2.0)
 
 	// Detect accumulation/distribution
-	accumSignal, err := DetectAccumulationDistribution(dataset, 10)
+	accumResults, err := cache.volume(10, 5)
 	if err != nil {
 		return VolumeStrategy{}, err
 	}
+	accumSignal := accumulationFromResults(accumResults, 10)
 
 	// Calculate volume ratio
 	volumeRatio := current.Volume / current.VMA
 
 	// Determine OBV trend
-	results, _ := CalculateVolumeAnalysis(dataset, vmaPeriod, vrocPeriod)
 	obvTrend := "sideways"
 	if len(results) >= 3 {
 		recent := results[len(results)-3:]
@@ -307,23 +407,39 @@ func AnalyzeVolumeStrategy(dataset []OHLCV, vmaPeriod, vrocPeriod int) (VolumeSt
 		}
 	}
 
+	// Price swings the volume lines do not confirm
+	divergences := recentVolumeDivergences(dataset, results)
+	distributionWarning := false
+	for _, d := range divergences {
+		distributionWarning = distributionWarning || d.Type == "bearish"
+	}
+
 	// Generate trading signal
-	signal := "hold"
+	signal := SignalHold
 	switch {
 	case breakoutSignal.Type == "breakout" && breakoutSignal.Trend == "bullish" && accumSignal.Type == "accumulation":
-		signal = "strong_buy"
+		signal = SignalStrongBuy
 	case breakoutSignal.Type == "breakout" && breakoutSignal.Trend == "bearish" && accumSignal.Type == "distribution":
-		signal = "strong_sell"
+		signal = SignalStrongSell
 	case breakoutSignal.Type == "breakout" && breakoutSignal.Trend == "bullish":
-		signal = "buy"
+		signal = SignalBuy
 	case breakoutSignal.Type == "breakout" && breakoutSignal.Trend == "bearish":
-		signal = "sell"
+		signal = SignalSell
 	case accumSignal.Type == "accumulation" && obvTrend == "rising":
-		signal = "accumulate"
+		signal = SignalAccumulate
 	case accumSignal.Type == "distribution" && obvTrend == "falling":
-		signal = "distribute"
+		signal = SignalDistribute
+	case distributionWarning:
+		signal = SignalDistribute // Price up on declining OBV or ADL
 	case volumeRatio < 0.5:
-		signal = "low_volume_alert" // Potentially fake moves
+		signal = SignalLowVolumeAlert // Potentially fake moves
+	}
+
+	// Distrust the volume signals of likely wash-traded tokens
+	quality, err := AssessVolumeQuality(dataset, DefaultVolumeQualityConfig())
+	if err == nil {
+		breakoutSignal.Confidence *= quality.Score
+		accumSignal.Confidence *= quality.Score
 	}
 
 	return VolumeStrategy{
@@ -333,5 +449,9 @@ func AnalyzeVolumeStrategy(dataset []OHLCV, vmaPeriod, vrocPeriod int) (VolumeSt
 		VolumeRatio:        volumeRatio,
 		OBVTrend:           obvTrend,
 		Signal:             signal,
+		Quality:            quality,
+
+		Divergences:         divergences,
+		DistributionWarning: distributionWarning,
 	}, nil
 }
@@ -0,0 +1,32 @@
+package techindicators
+
+import "testing"
+
+func TestCalculateVolumeAnalysisVMAMatchesNaive(t *testing.T) {
+	dataset := syntheticCandles(t, benchmarkCandles)
+	for _, vmaPeriod := range []int{3, 20, 50} {
+		results, err := CalculateVolumeAnalysis(dataset, vmaPeriod, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		offset := len(dataset) - len(results) // Dataset index of the first result
+		for i, r := range results {
+			sum := 0.0
+			for _, candle := range dataset[offset+i-vmaPeriod+1 : offset+i+1] {
+				sum += candle.Volume
+			}
+			want := sum / float64(vmaPeriod)
+			assertClose(t, "VMA", i, r.VMA, want, want)
+		}
+	}
+}
+
+func BenchmarkCalculateVolumeAnalysis(b *testing.B) {
+	dataset := syntheticCandles(b, benchmarkCandles)
+	b.ResetTimer()
+	for range b.N {
+		if _, err := CalculateVolumeAnalysis(dataset, 20, 5); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package techindicators
+
+import "slices"
+
+// VolumeDivergence is a divergence between the price and a cumulative volume line
+type VolumeDivergence struct {
+	Line string `json:"line"` // obv or adl
+	Divergence
+}
+
+// DetectVolumeDivergences finds the regular divergences between the price swings and the swings of the
+// On-Balance Volume and Accumulation/Distribution lines. A bearish one, price up on a declining line, means
+// the rally is being sold into; a bullish one means the decline is being bought. Results are ordered by
+// the candle of their end pivot, OBV first on a tie.
+func DetectVolumeDivergences(dataset []OHLCV, config DivergenceConfig) ([]VolumeDivergence, error) {
+	results, err := CalculateVolumeAnalysis(dataset, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	return volumeDivergencesFromResults(dataset, results, config)
+}
+
+// volumeDivergencesFromResults detects the OBV and ADL divergences of an already computed volume series
+func volumeDivergencesFromResults(dataset []OHLCV, results []VolumeResult, config DivergenceConfig) ([]VolumeDivergence, error) {
+	obv := make([]float64, len(results))
+	adl := make([]float64, len(results))
+	for i, r := range results {
+		obv[i], adl[i] = r.OBV, r.ADL
+	}
+
+	config.Hidden = false
+	var divergences []VolumeDivergence
+	for _, line := range []struct {
+		name   string
+		values []float64
+	}{{"obv", obv}, {"adl", adl}} {
+		found, err := DetectDivergences(dataset, line.values, config)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range found {
+			divergences = append(divergences, VolumeDivergence{Line: line.name, Divergence: d})
+		}
+	}
+
+	slices.SortStableFunc(divergences, func(a, b VolumeDivergence) int { return a.PriceEnd.Index - b.PriceEnd.Index })
+	return divergences, nil
+}
+
+// recentVolumeDivergences returns the volume divergences of the latest candles for the volume strategy:
+// those whose end pivot is within three pivot strengths of the latest candle, so the swing was confirmed
+// only a few candles ago. Only the candles the default spans can reach are scanned.
+func recentVolumeDivergences(dataset []OHLCV, results []VolumeResult) []VolumeDivergence {
+	config := DefaultDivergenceConfig()
+	window := min(len(results), config.MaxSpan+4*config.PivotStrength+config.Tolerance)
+	divergences, err := volumeDivergencesFromResults(dataset, results[len(results)-window:], config)
+	if err != nil {
+		return nil // Too few candles for a confirmed swing
+	}
+
+	first := len(dataset) - 1 - 3*config.PivotStrength
+	var recent []VolumeDivergence
+	for _, d := range divergences {
+		if d.PriceEnd.Index >= first {
+			recent = append(recent, d)
+		}
+	}
+	return recent
+}
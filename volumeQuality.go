@@ -0,0 +1,192 @@
+package techindicators
+
+import (
+	"fmt"
+	"math"
+)
+
+// VolumeQualityCode identifies a wash-trading pattern found by AssessVolumeQuality
+type VolumeQualityCode string
+
+const (
+	VolumeRangeMismatch VolumeQualityCode = "range_mismatch" // Volume does not grow with the candle range
+	VolumeRepeatedSizes VolumeQualityCode = "repeated_sizes" // Consecutive candles with near-identical volume
+	VolumeVROCAnomaly   VolumeQualityCode = "vroc_anomaly"   // Volume jumps that leave the price flat
+)
+
+// VolumeQualityReason is one detected pattern with its 0-1 component score
+type VolumeQualityReason struct {
+	Code   VolumeQualityCode `json:"code"`
+	Score  float64           `json:"score"`
+	Detail string            `json:"detail"`
+}
+
+// VolumeQuality scores how organic the traded volume looks
+type VolumeQuality struct {
+	Score       float64               `json:"score"`        // 0-1, 1 is organic volume
+	WashTrading bool                  `json:"wash_trading"` // Volume signals should be distrusted
+	Reasons     []VolumeQualityReason `json:"reasons,omitempty"`
+}
+
+// VolumeQualityConfig configures AssessVolumeQuality. Zero fields take the defaults in brackets.
+type VolumeQualityConfig struct {
+	Window          int     `json:"window"`            // Latest candles examined [50]
+	MinCorrelation  float64 `json:"min_correlation"`   // Volume-range correlation that scores 1; its negative scores 0 [0.3]
+	RepeatTolerance float64 `json:"repeat_tolerance"`  // Relative volume difference counted as a repeat [0.01]
+	MaxRepeatShare  float64 `json:"max_repeat_share"`  // Share of repeats that scores 0 [0.3]
+	VROCPeriod      int     `json:"vroc_period"`       // [5]
+	VROCSpike       float64 `json:"vroc_spike"`        // VROC (percent) that counts as a volume jump [200]
+	FlatMove        float64 `json:"flat_move"`         // Close-to-open move below which the price counts as flat [0.005]
+	MaxAnomalyShare float64 `json:"max_anomaly_share"` // Share of flat volume jumps that scores 0 [0.2]
+	WashThreshold   float64 `json:"wash_threshold"`    // Score below which the volume is flagged as wash trading [0.5]
+}
+
+// DefaultVolumeQualityConfig returns the thresholds AnalyzeVolumeStrategy uses
+func DefaultVolumeQualityConfig() VolumeQualityConfig {
+	return VolumeQualityConfig{
+		Window:          50,
+		MinCorrelation:  0.3,
+		RepeatTolerance: 0.01,
+		MaxRepeatShare:  0.3,
+		VROCPeriod:      5,
+		VROCSpike:       200,
+		FlatMove:        0.005,
+		MaxAnomalyShare: 0.2,
+		WashThreshold:   0.5,
+	}
+}
+
+// withDefaults fills zero fields
+func (c VolumeQualityConfig) withDefaults() VolumeQualityConfig {
+	defaults := DefaultVolumeQualityConfig()
+	if c.Window == 0 {
+		c.Window = defaults.Window
+	}
+	if c.VROCPeriod == 0 {
+		c.VROCPeriod = defaults.VROCPeriod
+	}
+	if c.MinCorrelation == 0 {
+		c.MinCorrelation = defaults.MinCorrelation
+	}
+	if c.RepeatTolerance == 0 {
+		c.RepeatTolerance = defaults.RepeatTolerance
+	}
+	if c.MaxRepeatShare == 0 {
+		c.MaxRepeatShare = defaults.MaxRepeatShare
+	}
+	if c.VROCSpike == 0 {
+		c.VROCSpike = defaults.VROCSpike
+	}
+	if c.FlatMove == 0 {
+		c.FlatMove = defaults.FlatMove
+	}
+	if c.MaxAnomalyShare == 0 {
+		c.MaxAnomalyShare = defaults.MaxAnomalyShare
+	}
+	if c.WashThreshold == 0 {
+		c.WashThreshold = defaults.WashThreshold
+	}
+	return c
+}
+
+// validate checks the configuration after defaults are applied
+func (c VolumeQualityConfig) validate() error {
+	switch {
+	case c.Window < 3 || c.VROCPeriod < 1:
+		return invalidPeriod("window must be at least 3 and VROC period at least 1")
+	case c.MinCorrelation <= 0 || c.MinCorrelation > 1:
+		return invalidParameter("min correlation must be in (0, 1], got %v", c.MinCorrelation)
+	case c.RepeatTolerance < 0 || c.VROCSpike < 0 || c.FlatMove < 0:
+		return invalidParameter("volume quality thresholds must not be negative")
+	case c.MaxRepeatShare <= 0 || c.MaxRepeatShare > 1 || c.MaxAnomalyShare <= 0 || c.MaxAnomalyShare > 1:
+		return invalidParameter("max shares must be in (0, 1]")
+	case c.WashThreshold < 0 || c.WashThreshold > 1:
+		return invalidParameter("wash threshold must be between 0 and 1, got %v", c.WashThreshold)
+	}
+	return nil
+}
+
+// AssessVolumeQuality scores the latest candles for signs of wash trading: organic volume grows with the
+// candle range, varies from candle to candle, and moves the price when it jumps. Each check scores 0-1, the
+// quality score is their product, and checks scoring below 0.5 are listed as reasons. Candles with missing
+// prices or volume are ignored.
+func AssessVolumeQuality(dataset []OHLCV, config VolumeQualityConfig) (VolumeQuality, error) {
+	config = config.withDefaults()
+	if err := config.validate(); err != nil {
+		return VolumeQuality{}, err
+	}
+	if len(dataset) == 0 {
+		return VolumeQuality{}, ErrEmptyDataset
+	}
+
+	start := max(0, len(dataset)-config.Window)
+	var logVolumes, ranges []float64
+	var repeats, jumps, flatJumps, pairs int
+	for i := start; i < len(dataset); i++ {
+		candle := dataset[i]
+		if !validVolumeCandle(candle) {
+			continue
+		}
+		if candle.Volume > 0 {
+			logVolumes = append(logVolumes, math.Log(candle.Volume))
+			ranges = append(ranges, (candle.High-candle.Low)/candle.Close)
+		}
+
+		if i > 0 && validVolumeCandle(dataset[i-1]) && dataset[i-1].Volume > 0 {
+			pairs++
+			if math.Abs(candle.Volume-dataset[i-1].Volume) <= config.RepeatTolerance*dataset[i-1].Volume {
+				repeats++
+			}
+		}
+
+		if j := i - config.VROCPeriod; j >= 0 && validVolumeCandle(dataset[j]) && dataset[j].Volume > 0 {
+			jumps++
+			vroc := (candle.Volume - dataset[j].Volume) / dataset[j].Volume * 100
+			if vroc > config.VROCSpike && candle.Open > 0 && math.Abs(candle.Close/candle.Open-1) < config.FlatMove {
+				flatJumps++
+			}
+		}
+	}
+
+	if len(logVolumes) < 3 {
+		return VolumeQuality{}, ErrInsufficientData{Need: 3, Have: len(logVolumes)}
+	}
+
+	quality := VolumeQuality{Score: 1}
+	check := func(code VolumeQualityCode, score float64, detail string) {
+		score = clampScore(score)
+		quality.Score *= score
+		if score < 0.5 {
+			quality.Reasons = append(quality.Reasons, VolumeQualityReason{Code: code, Score: score, Detail: detail})
+		}
+	}
+
+	correlation := pearson(logVolumes, ranges)
+	check(VolumeRangeMismatch, 0.5+correlation/(2*config.MinCorrelation),
+		fmt.Sprintf("volume-range correlation %.2f", correlation))
+
+	if pairs > 0 {
+		share := float64(repeats) / float64(pairs)
+		check(VolumeRepeatedSizes, 1-share/config.MaxRepeatShare,
+			fmt.Sprintf("%d of %d candles repeat the previous volume", repeats, pairs))
+	}
+
+	if jumps > 0 {
+		share := float64(flatJumps) / float64(jumps)
+		check(VolumeVROCAnomaly, 1-share/config.MaxAnomalyShare,
+			fmt.Sprintf("%d volume jumps above %.0f%% with a flat price", flatJumps, config.VROCSpike))
+	}
+
+	quality.WashTrading = quality.Score < config.WashThreshold
+	return quality, nil
+}
+
+// validVolumeCandle reports whether the candle has the prices and volume the quality checks read
+func validVolumeCandle(candle OHLCV) bool {
+	for _, value := range []float64{candle.High, candle.Low, candle.Close, candle.Open, candle.Volume} {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return false
+		}
+	}
+	return candle.Close > 0 && candle.Volume >= 0
+}
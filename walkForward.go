@@ -0,0 +1,158 @@
+package techindicators
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// StrategyFactory builds a strategy from parameters, e.g. an RSI strategy from {"rsi_period": 14}
+type StrategyFactory func(params IndicatorParams) Strategy
+
+// ComprehensiveStrategyFactory builds a ComprehensiveStrategy from the parameters
+// sma_period, bb_period, bb_multiplier, and rsi_period; missing keys keep the defaults
+func ComprehensiveStrategyFactory(params IndicatorParams) Strategy {
+	defaults := DefaultAnalysisConfig()
+	return ComprehensiveStrategy(NewAnalysisConfig(
+		WithSMAPeriod(params.Int("sma_period", defaults.SMAPeriod)),
+		WithBollinger(params.Int("bb_period", defaults.BBPeriod), params.Float("bb_multiplier", defaults.BBMultiplier)),
+		WithRSIPeriod(params.Int("rsi_period", defaults.RSIPeriod)),
+	))
+}
+
+// WalkForwardConfig controls a walk-forward evaluation
+type WalkForwardConfig struct {
+	TrainSize  int                          // Candles used to pick parameters
+	TestSize   int                          // Candles traded out of sample with the picked parameters
+	Step       int                          // Candles between window starts (defaults to TestSize)
+	Candidates []IndicatorParams            // Parameter sets to choose from in each training window
+	Objective  func(BacktestResult) float64 // Score maximized in training (defaults to total return)
+	FeeRate    float64
+}
+
+// WalkForwardWindow represents one train/test split
+type WalkForwardWindow struct {
+	TrainStart  time.Time       `json:"train_start"`
+	TestStart   time.Time       `json:"test_start"`
+	TestEnd     time.Time       `json:"test_end"`
+	Params      IndicatorParams `json:"params"` // Best candidate in the training window
+	InSample    BacktestResult  `json:"in_sample"`
+	OutOfSample BacktestResult  `json:"out_of_sample"`
+}
+
+// WalkForwardResult summarizes out-of-sample performance and how stable the parameter choice was
+type WalkForwardResult struct {
+	Windows               []WalkForwardWindow `json:"windows"`
+	OutOfSampleReturn     float64             `json:"out_of_sample_return"` // Compounded across test windows
+	MeanInSampleReturn    float64             `json:"mean_in_sample_return"`
+	MeanOutOfSampleReturn float64             `json:"mean_out_of_sample_return"`
+	StdOutOfSampleReturn  float64             `json:"std_out_of_sample_return"`
+	Efficiency            float64             `json:"efficiency"`          // Mean out-of-sample / mean in-sample return (0 if in-sample <= 0)
+	ProfitableWindows     float64             `json:"profitable_windows"`  // Share of test windows with a positive return
+	ParameterStability    float64             `json:"parameter_stability"` // Share of windows that picked the most common parameters
+}
+
+// WalkForward evaluates a strategy on rolling train/test windows: each training window picks the best
+// candidate parameters, which are then traded on the following unseen test window.
+func WalkForward(dataset []OHLCV, factory StrategyFactory, config WalkForwardConfig) (WalkForwardResult, error) {
+	return WalkForwardContext(context.Background(), dataset, factory, config)
+}
+
+// WalkForwardContext is WalkForward with cancellation
+func WalkForwardContext(ctx context.Context, dataset []OHLCV, factory StrategyFactory, config WalkForwardConfig) (WalkForwardResult, error) {
+	if len(dataset) == 0 {
+		return WalkForwardResult{}, ErrEmptyDataset
+	}
+	if factory == nil {
+		return WalkForwardResult{}, invalidParameter("strategy factory is required")
+	}
+	if config.TrainSize <= 0 || config.TestSize <= 0 {
+		return WalkForwardResult{}, invalidPeriod("train and test sizes must be greater than 0")
+	}
+	if len(config.Candidates) == 0 {
+		return WalkForwardResult{}, invalidParameter("at least one parameter candidate is required")
+	}
+	if need := config.TrainSize + config.TestSize; len(dataset) < need {
+		return WalkForwardResult{}, ErrInsufficientData{Need: need, Have: len(dataset)}
+	}
+
+	step := config.Step
+	if step <= 0 {
+		step = config.TestSize
+	}
+	objective := config.Objective
+	if objective == nil {
+		objective = func(r BacktestResult) float64 { return r.TotalReturn }
+	}
+
+	var result WalkForwardResult
+	for start := 0; start+config.TrainSize+config.TestSize <= len(dataset); start += step {
+		trainEnd := start + config.TrainSize
+		testEnd := trainEnd + config.TestSize
+
+		// Pick the candidate with the best in-sample objective
+		var best WalkForwardWindow
+		bestScore := math.Inf(-1)
+		for _, params := range config.Candidates {
+			inSample, err := BacktestContext(ctx, dataset[start:trainEnd], factory(params), BacktestConfig{FeeRate: config.FeeRate})
+			if err != nil {
+				return WalkForwardResult{}, err
+			}
+			if score := objective(inSample); score > bestScore {
+				bestScore = score
+				best = WalkForwardWindow{Params: params, InSample: inSample}
+			}
+		}
+
+		// Trade the test window; the training candles serve as warm-up history
+		outOfSample, err := BacktestContext(ctx, dataset[start:testEnd], factory(best.Params),
+			BacktestConfig{Start: config.TrainSize, FeeRate: config.FeeRate})
+		if err != nil {
+			return WalkForwardResult{}, err
+		}
+
+		best.TrainStart = dataset[start].Timestamp
+		best.TestStart = dataset[trainEnd].Timestamp
+		best.TestEnd = dataset[testEnd-1].Timestamp
+		best.OutOfSample = outOfSample
+		result.Windows = append(result.Windows, best)
+	}
+
+	// Summary statistics across windows
+	outOfSampleReturns := make([]float64, len(result.Windows))
+	inSampleReturns := make([]float64, len(result.Windows))
+	picks := make(map[string]int)
+	compounded := 1.0
+	profitable := 0
+
+	for i, window := range result.Windows {
+		outOfSampleReturns[i] = window.OutOfSample.TotalReturn
+		inSampleReturns[i] = window.InSample.TotalReturn
+		compounded *= 1 + window.OutOfSample.TotalReturn
+		if window.OutOfSample.TotalReturn > 0 {
+			profitable++
+		}
+		picks[fmt.Sprint(window.Params)]++
+	}
+
+	mostCommon := 0
+	for _, count := range picks {
+		mostCommon = max(mostCommon, count)
+	}
+
+	windows := float64(len(result.Windows))
+	result.OutOfSampleReturn = compounded - 1
+	result.MeanInSampleReturn = average(inSampleReturns)
+	result.MeanOutOfSampleReturn = average(outOfSampleReturns)
+	if len(outOfSampleReturns) >= 2 {
+		result.StdOutOfSampleReturn = stdDev(outOfSampleReturns, result.MeanOutOfSampleReturn)
+	}
+	if result.MeanInSampleReturn > 0 {
+		result.Efficiency = result.MeanOutOfSampleReturn / result.MeanInSampleReturn
+	}
+	result.ProfitableWindows = float64(profitable) / windows
+	result.ParameterStability = float64(mostCommon) / windows
+
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package techindicators
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Tick is a single trade
+type Tick struct {
+	Timestamp time.Time `json:"timestamp"`
+	Price     float64   `json:"price"`
+	Volume    float64   `json:"volume"`
+}
+
+// YoungTokenMode describes how the analysis adapted to a history too short for the configured periods
+type YoungTokenMode struct {
+	Candles       int    `json:"candles"`  // Candles or bars analyzed
+	Required      int    `json:"required"` // Candles the configured periods need
+	SMAPeriod     int    `json:"sma_period"`
+	BBPeriod      int    `json:"bb_period"`
+	RSIPeriod     int    `json:"rsi_period"`
+	VMAPeriod     int    `json:"vma_period"`
+	VROCPeriod    int    `json:"vroc_period"`
+	SkipBollinger bool   `json:"skip_bollinger"` // Too few candles for the Bollinger squeeze even at the shortest period
+	Bars          string `json:"bars,omitempty"` // "tick" when the candles are tick bars built from trades
+}
+
+// Shortest periods young token mode shrinks to
+const (
+	youngSMAPeriod  = 4 // Keeps a fast crossover period of 2
+	youngBBPeriod   = 5
+	youngRSIPeriod  = 5
+	youngVMAPeriod  = 5
+	youngVROCPeriod = 2
+)
+
+// TickBars groups consecutive trades into bars of ticksPerBar trades. The first bar takes the remainder so
+// the latest bars are complete. Bars take the timestamp of their first trade and record the trade count.
+func TickBars(ticks []Tick, ticksPerBar int) ([]OHLCV, error) {
+	if ticksPerBar <= 0 {
+		return nil, invalidPeriod("ticks per bar must be greater than 0")
+	}
+	if err := validateTicks(ticks); err != nil {
+		return nil, err
+	}
+
+	bars := make([]OHLCV, 0, len(ticks)/ticksPerBar+1)
+	start := 0
+	for end := len(ticks) % ticksPerBar; end <= len(ticks); end += ticksPerBar {
+		if end > start {
+			bars = append(bars, tickBar(ticks[start:end]))
+			start = end
+		}
+	}
+	return bars, nil
+}
+
+// VolumeBars groups consecutive trades into bars that close once they have traded volumePerBar. Bars take
+// the timestamp of their first trade and record the trade count; a trailing partial bar is left out.
+func VolumeBars(ticks []Tick, volumePerBar float64) ([]OHLCV, error) {
+	if volumePerBar <= 0 {
+		return nil, invalidParameter("volume per bar must be greater than 0")
+	}
+	if err := validateTicks(ticks); err != nil {
+		return nil, err
+	}
+
+	var bars []OHLCV
+	start, traded := 0, 0.0
+	for i, tick := range ticks {
+		traded += tick.Volume
+		if traded >= volumePerBar {
+			bars = append(bars, tickBar(ticks[start:i+1]))
+			start, traded = i+1, 0
+		}
+	}
+	return bars, nil
+}
+
+// validateTicks checks that trades are in time order with positive prices and non-negative volume
+func validateTicks(ticks []Tick) error {
+	if len(ticks) == 0 {
+		return ErrEmptyDataset
+	}
+	for i, tick := range ticks {
+		if !(tick.Price > 0) || math.IsInf(tick.Price, 0) {
+			return invalidPrice("trade %d has price %v", i, tick.Price)
+		}
+		if !(tick.Volume >= 0) {
+			return invalidParameter("trade %d has volume %v", i, tick.Volume)
+		}
+		if i > 0 && tick.Timestamp.Before(ticks[i-1].Timestamp) {
+			return invalidParameter("trades must be in time order")
+		}
+	}
+	return nil
+}
+
+// tickBar builds one bar from consecutive trades
+func tickBar(ticks []Tick) OHLCV {
+	bar := OHLCV{
+		Timestamp: ticks[0].Timestamp,
+		Open:      ticks[0].Price,
+		High:      ticks[0].Price,
+		Low:       ticks[0].Price,
+		Close:     ticks[len(ticks)-1].Price,
+		Trades:    len(ticks),
+	}
+	for _, tick := range ticks {
+		bar.High = math.Max(bar.High, tick.Price)
+		bar.Low = math.Min(bar.Low, tick.Price)
+		bar.Volume += tick.Volume
+	}
+	return bar
+}
+
+// youngTokenConfig shrinks the periods of config in proportion until the ultimate analysis fits the
+// candles, down to the shortest young token periods, then leaves out the Bollinger vote if that is still
+// not enough. Higher-timeframe confirmation is dropped, as a young token has no higher-timeframe history.
+func youngTokenConfig(config AnalysisConfig, candles int) (AnalysisConfig, *YoungTokenMode, error) {
+	full := config.withDefaults()
+	mode := &YoungTokenMode{Candles: candles, Required: full.UltimateMinCandles()}
+
+	young := config
+	young.ConfirmTimeframes, young.HigherTimeframe = nil, nil
+	for scale := float64(candles) / float64(mode.Required); ; scale *= 0.9 {
+		shortest := true
+		shrink := func(period, floor int) int {
+			if scaled := int(float64(period) * scale); scaled > floor {
+				shortest = false
+				return scaled
+			}
+			return min(period, floor)
+		}
+		young.SMAPeriod = shrink(full.SMAPeriod, youngSMAPeriod)
+		young.BBPeriod = shrink(full.BBPeriod, youngBBPeriod)
+		young.RSIPeriod = shrink(full.RSIPeriod, youngRSIPeriod)
+		young.VMAPeriod = shrink(full.VMAPeriod, youngVMAPeriod)
+		young.VROCPeriod = shrink(full.VROCPeriod, youngVROCPeriod)
+		if shortest || young.UltimateMinCandles() <= candles {
+			break
+		}
+	}
+	if young.UltimateMinCandles() > candles && !young.SkipBollinger && young.technicalIndicatorCount() > 1 {
+		young.SkipBollinger = true
+		mode.SkipBollinger = true
+	}
+	if err := young.CheckCandles(candles); err != nil {
+		return AnalysisConfig{}, nil, err
+	}
+
+	mode.SMAPeriod, mode.BBPeriod, mode.RSIPeriod = young.SMAPeriod, young.BBPeriod, young.RSIPeriod
+	mode.VMAPeriod, mode.VROCPeriod = young.VMAPeriod, young.VROCPeriod
+	return young, mode, nil
+}
+
+// applyYoungToken downgrades an analysis run with shrunken periods: strong signals become regular ones,
+// confidence is LOW, and the confidence score shrinks with the share of the required history available
+func applyYoungToken(analysis *UltimateMemecoinAnalysis, mode *YoungTokenMode) {
+	analysis.YoungToken = mode
+	switch analysis.FinalSignal {
+	case SignalStrongBuy:
+		analysis.FinalSignal = SignalBuy
+	case SignalStrongSell:
+		analysis.FinalSignal = SignalSell
+	}
+	analysis.Confidence = "LOW"
+	analysis.ConfidenceScore *= float64(mode.Candles) / float64(mode.Required)
+}
+
+// UltimateAnalysisFromTicks analyzes a token from its trades, for tokens too young to have enough time
+// candles. The trades are grouped into tick bars, twice as many as the configuration needs when there are
+// enough trades and one trade per bar otherwise; tick bars keep the volume varying from bar to bar, which
+// the volume checks rely on and volume bars would not. Young token mode applies when the bars are still
+// too few. The bars' equal trade counts are dropped so they do not read as thin-book manipulation.
+func UltimateAnalysisFromTicks(ctx context.Context, ticks []Tick, config AnalysisConfig) (UltimateMemecoinAnalysis, error) {
+	bars, err := TickBars(ticks, max(1, len(ticks)/(2*config.UltimateMinCandles())))
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+	for i := range bars {
+		bars[i].Trades = 0
+	}
+
+	config.YoungToken = true
+	analysis, err := UltimateAnalysisContext(ctx, bars, config)
+	if err != nil {
+		return UltimateMemecoinAnalysis{}, err
+	}
+	if analysis.YoungToken != nil {
+		analysis.YoungToken.Bars = "tick"
+	}
+	return analysis, nil
+}